@@ -20,17 +20,105 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gorilla/mux"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	Jwt_authScopes = "jwt_auth.Scopes"
 )
 
-// CardRequest defines model for CardRequest.
-type CardRequest struct {
+// Defines values for CardRequestCardType.
+const (
+	Basic          CardRequestCardType = "basic"
+	Cloze          CardRequestCardType = "cloze"
+	ImageOcclusion CardRequestCardType = "image_occlusion"
+	MultipleChoice CardRequestCardType = "multiple_choice"
+)
+
+// Defines values for AnswerCardParamsGrade.
+const (
+	Again AnswerCardParamsGrade = "again"
+	Easy  AnswerCardParamsGrade = "easy"
+	Good  AnswerCardParamsGrade = "good"
+	Hard  AnswerCardParamsGrade = "hard"
+)
+
+// Defines values for HoldCardParamsHold.
+const (
+	Bury    HoldCardParamsHold = "bury"
+	Suspend HoldCardParamsHold = "suspend"
+)
+
+// Defines values for ViewDeckParamsOrder.
+const (
+	Newest   ViewDeckParamsOrder = "newest"
+	Shuffled ViewDeckParamsOrder = "shuffled"
+	Weakest  ViewDeckParamsOrder = "weakest"
+)
+
+// Defines values for ViewDeckParamsDirection.
+const (
+	Both    ViewDeckParamsDirection = "both"
+	Reverse ViewDeckParamsDirection = "reverse"
+)
+
+// Defines values for ViewDeckParamsMode.
+const (
+	Typed ViewDeckParamsMode = "typed"
+)
+
+// CardPreview defines model for CardPreview.
+type CardPreview struct {
 	CardBack  *string `json:"card-back,omitempty"`
 	CardFront *string `json:"card-front,omitempty"`
-	DeckId    *string `json:"deck-id,omitempty"`
+}
+
+// CardRequest defines model for CardRequest.
+type CardRequest struct {
+	// BackMedia images or audio to attach to the back of the card
+	BackMedia *[]openapi_types.File `json:"back-media,omitempty"`
+	CardBack  *string               `json:"card-back,omitempty"`
+	CardFront *string               `json:"card-front,omitempty"`
+	CardType  *CardRequestCardType  `json:"card-type,omitempty"`
+
+	// Correct indexes into option of the correct options of a multiple choice card
+	Correct *[]string `json:"correct,omitempty"`
+	DeckId  *string   `json:"deck-id,omitempty"`
+
+	// FrontMedia images or audio to attach to the front of the card
+	FrontMedia *[]openapi_types.File `json:"front-media,omitempty"`
+
+	// MaskHeight height of each mask of an image occlusion card, in percent of the image's height
+	MaskHeight *[]string `json:"mask-height,omitempty"`
+
+	// MaskLabel label hidden under each mask of an image occlusion card, which is the card's answer
+	MaskLabel *[]string `json:"mask-label,omitempty"`
+
+	// MaskWidth width of each mask of an image occlusion card, in percent of the image's width
+	MaskWidth *[]string `json:"mask-width,omitempty"`
+
+	// MaskX left edge of each mask of an image occlusion card, in percent of the image's width
+	MaskX *[]string `json:"mask-x,omitempty"`
+
+	// MaskY top edge of each mask of an image occlusion card, in percent of the image's height
+	MaskY *[]string `json:"mask-y,omitempty"`
+
+	// OcclusionImage diagram of an image occlusion card
+	OcclusionImage *openapi_types.File `json:"occlusion-image,omitempty"`
+
+	// Option text of each option of a multiple choice card
+	Option *[]string `json:"option,omitempty"`
+
+	// Tags tags of the card, separated by commas or spaces
+	Tags *string `json:"tags,omitempty"`
+}
+
+// CardRequestCardType defines model for CardRequest.CardType.
+type CardRequestCardType string
+
+// ChoiceAnswer defines model for ChoiceAnswer.
+type ChoiceAnswer struct {
+	Option *[]string `json:"option,omitempty"`
 }
 
 // CreateGroup defines model for CreateGroup.
@@ -51,9 +139,32 @@ type DeckName struct {
 	DeckName string `json:"deck_name"`
 }
 
+// DeckSettings defines model for DeckSettings.
+type DeckSettings struct {
+	// LeechThreshold how many failures make a card a leech for a learner
+	LeechThreshold *string `json:"leech-threshold,omitempty"`
+	Scheduler      *string `json:"scheduler,omitempty"`
+
+	// SuspendLeeches suspend cards for a learner as they become leeches
+	SuspendLeeches  *string `json:"suspend-leeches,omitempty"`
+	TargetRetention *string `json:"target-retention,omitempty"`
+}
+
 // DocumentID defines model for DocumentID.
 type DocumentID = string
 
+// EditCard defines model for EditCard.
+type EditCard struct {
+	// CardBack back of a basic card
+	CardBack *string `json:"card-back,omitempty"`
+
+	// CardFront front of the card, or the note of a cloze card
+	CardFront *string `json:"card-front,omitempty"`
+
+	// Tags tags of the card separated by commas or spaces, which replace the tags it has
+	Tags *string `json:"tags,omitempty"`
+}
+
 // ErrorObject defines model for ErrorObject.
 type ErrorObject struct {
 	// Error A brief error message indicating an internal server error.
@@ -88,6 +199,28 @@ type GroupWithDecks struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// Leaderboard defines model for Leaderboard.
+type Leaderboard struct {
+	// ComputedAt when the members' sessions were last counted
+	ComputedAt time.Time          `json:"computed_at"`
+	Entries    []LeaderboardEntry `json:"entries"`
+	GroupId    string             `json:"group_id"`
+	Metric     string             `json:"metric"`
+	Window     string             `json:"window"`
+}
+
+// LeaderboardEntry defines model for LeaderboardEntry.
+type LeaderboardEntry struct {
+	Correct        int `json:"correct"`
+	PercentCorrect int `json:"percent_correct"`
+	Rank           int `json:"rank"`
+	Reviews        int `json:"reviews"`
+
+	// Streak how many days in a row up to today the member has studied the group's decks, counted in the member's time zone
+	Streak   int    `json:"streak"`
+	Username string `json:"username"`
+}
+
 // Login defines model for Login.
 type Login struct {
 	Password *string `json:"password,omitempty"`
@@ -101,6 +234,14 @@ type LoginResponseSchema struct {
 	TokenType   *string `json:"token_type,omitempty"`
 }
 
+// MediaRecord defines model for MediaRecord.
+type MediaRecord struct {
+	ContentType string `json:"content_type"`
+	Id          string `json:"id"`
+	Size        int64  `json:"size"`
+	Url         string `json:"url"`
+}
+
 // Register defines model for Register.
 type Register struct {
 	Password   string `json:"password"`
@@ -108,18 +249,66 @@ type Register struct {
 	Username   string `json:"username"`
 }
 
+// SessionRecord defines model for SessionRecord.
+type SessionRecord struct {
+	DeckId          string    `json:"deck_id"`
+	DeckName        string    `json:"deck_name"`
+	DurationSeconds int       `json:"duration_seconds"`
+	FinishedAt      time.Time `json:"finished_at"`
+	Id              string    `json:"id"`
+	Kind            *string   `json:"kind,omitempty"`
+	NumCorrect      int       `json:"num_correct"`
+	PercentCorrect  int       `json:"percent_correct"`
+	StartedAt       time.Time `json:"started_at"`
+	TotalCards      int       `json:"total_cards"`
+}
+
+// StudySettings defines model for StudySettings.
+type StudySettings struct {
+	// GoalKind what the daily goal counts, cards or minutes
+	GoalKind *string `json:"goal-kind,omitempty"`
+
+	// GoalTarget how many cards or minutes the user means to study each day, 0 for no goal
+	GoalTarget *string `json:"goal-target,omitempty"`
+
+	// TimeZone IANA name of the time zone the user's days are counted in, such as Europe/Paris
+	TimeZone *string `json:"time-zone,omitempty"`
+}
+
+// TypedAnswer defines model for TypedAnswer.
+type TypedAnswer struct {
+	Answer string `json:"answer"`
+}
+
+// Unsuspend defines model for Unsuspend.
+type Unsuspend struct {
+	CardId *[]string `json:"card_id,omitempty"`
+}
+
 // ConflictError defines model for ConflictError.
 type ConflictError = ErrorObject
 
+// GetGroupLeaderboard defines model for GetGroupLeaderboard.
+type GetGroupLeaderboard = Leaderboard
+
 // GetGroups defines model for GetGroups.
 type GetGroups = []GroupWithDecks
 
+// GetSessions defines model for GetSessions.
+type GetSessions = []SessionRecord
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = ErrorObject
 
 // LoginResponseBody defines model for LoginResponseBody.
 type LoginResponseBody = LoginResponseSchema
 
+// NotFound defines model for NotFound.
+type NotFound = ErrorObject
+
+// UploadMedia defines model for UploadMedia.
+type UploadMedia = MediaRecord
+
 // UserError defines model for UserError.
 type UserError = ErrorObject
 
@@ -132,6 +321,78 @@ type AddGroupRequest = GroupName
 // CreateDeckRequestBody defines model for CreateDeckRequestBody.
 type CreateDeckRequestBody = DeckName
 
+// AnswerCardParamsGrade defines parameters for AnswerCard.
+type AnswerCardParamsGrade string
+
+// BackOfCardParams defines parameters for BackOfCard.
+type BackOfCardParams struct {
+	// SessionId session the card is studied in; defaults to the user's session for the deck
+	SessionId *string `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// Reversed whether the card is studied back to front
+	Reversed *bool `form:"reversed,omitempty" json:"reversed,omitempty"`
+}
+
+// FrontOfCardParams defines parameters for FrontOfCard.
+type FrontOfCardParams struct {
+	// SessionId session the card is studied in; defaults to the user's session for the deck
+	SessionId *string `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// Reversed whether the card is studied back to front
+	Reversed *bool `form:"reversed,omitempty" json:"reversed,omitempty"`
+}
+
+// GroupLeaderboardTabParams defines parameters for GroupLeaderboardTab.
+type GroupLeaderboardTabParams struct {
+	// Window how far back to count study, one of week, month or all; defaults to week
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+
+	// Metric what to rank members by, one of reviews, accuracy or streak; defaults to reviews
+	Metric *string `form:"metric,omitempty" json:"metric,omitempty"`
+}
+
+// HistoryPageParams defines parameters for HistoryPage.
+type HistoryPageParams struct {
+	// DeckId only list sessions studying this deck
+	DeckId *string `form:"deck_id,omitempty" json:"deck_id,omitempty"`
+
+	// Offset number of sessions to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// HoldCardParamsHold defines parameters for HoldCard.
+type HoldCardParamsHold string
+
+// StudyTagPageParams defines parameters for StudyTagPage.
+type StudyTagPageParams struct {
+	// Tag tags to study, separated by commas
+	Tag string `form:"tag" json:"tag"`
+}
+
+// ViewDeckParams defines parameters for ViewDeck.
+type ViewDeckParams struct {
+	// Order order to study the cards in when a new session is started; an unfinished session keeps its order
+	Order *ViewDeckParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Direction direction to study the cards in when a new session is started; an unfinished session keeps its direction
+	Direction *ViewDeckParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Mode how cards are answered when a new session is started; an unfinished session keeps its mode
+	Mode *ViewDeckParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// Tag only study the deck's cards with every one of these tags, separated by commas
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// ViewDeckParamsOrder defines parameters for ViewDeck.
+type ViewDeckParamsOrder string
+
+// ViewDeckParamsDirection defines parameters for ViewDeck.
+type ViewDeckParamsDirection string
+
+// ViewDeckParamsMode defines parameters for ViewDeck.
+type ViewDeckParamsMode string
+
 // GetDecksForUserParams defines parameters for GetDecksForUser.
 type GetDecksForUserParams struct {
 	// From date to start lookup from
@@ -147,6 +408,15 @@ type GetDecksForUserParams struct {
 	Offset int `form:"offset" json:"offset"`
 }
 
+// GetGroupLeaderboardParams defines parameters for GetGroupLeaderboard.
+type GetGroupLeaderboardParams struct {
+	// Window how far back to count study, one of week, month or all; defaults to week
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+
+	// Metric what to rank members by, one of reviews, accuracy or streak; defaults to reviews
+	Metric *string `form:"metric,omitempty" json:"metric,omitempty"`
+}
+
 // GetGroupsParams defines parameters for GetGroups.
 type GetGroupsParams struct {
 	// From date to start lookup from
@@ -162,24 +432,65 @@ type GetGroupsParams struct {
 	Offset int `form:"offset" json:"offset"`
 }
 
+// UploadMediaMultipartBody defines parameters for UploadMedia.
+type UploadMediaMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// GetSessionsParams defines parameters for GetSessions.
+type GetSessionsParams struct {
+	// From date to start lookup from
+	From time.Time `form:"from" json:"from"`
+
+	// To date to end lookup
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit number of items to return from query
+	Limit int `form:"limit" json:"limit"`
+
+	// Offset number to start results from
+	Offset int `form:"offset" json:"offset"`
+
+	// DeckId only return sessions studying this deck
+	DeckId *string `form:"deck_id,omitempty" json:"deck_id,omitempty"`
+}
+
 // LoginFormdataRequestBody defines body for Login for application/x-www-form-urlencoded ContentType.
 type LoginFormdataRequestBody = Login
 
-// UpdateCardCorrectFormdataRequestBody defines body for UpdateCardCorrect for application/x-www-form-urlencoded ContentType.
-type UpdateCardCorrectFormdataRequestBody = CreateGroup
+// CardPreviewFormdataRequestBody defines body for CardPreview for application/x-www-form-urlencoded ContentType.
+type CardPreviewFormdataRequestBody = CardPreview
 
-// UpdateCardIncorrectFormdataRequestBody defines body for UpdateCardIncorrect for application/x-www-form-urlencoded ContentType.
-type UpdateCardIncorrectFormdataRequestBody = CreateGroup
+// AnswerCardChoiceFormdataRequestBody defines body for AnswerCardChoice for application/x-www-form-urlencoded ContentType.
+type AnswerCardChoiceFormdataRequestBody = ChoiceAnswer
 
 // CreateCardForDeckFormdataRequestBody defines body for CreateCardForDeck for application/x-www-form-urlencoded ContentType.
 type CreateCardForDeckFormdataRequestBody = CardRequest
 
+// CreateCardForDeckMultipartRequestBody defines body for CreateCardForDeck for multipart/form-data ContentType.
+type CreateCardForDeckMultipartRequestBody = CardRequest
+
 // CreateDeckJSONRequestBody defines body for CreateDeck for application/json ContentType.
 type CreateDeckJSONRequestBody = DeckName
 
 // CreateGroupFormdataRequestBody defines body for CreateGroup for application/x-www-form-urlencoded ContentType.
 type CreateGroupFormdataRequestBody = CreateGroup
 
+// UpdateDeckSettingsFormdataRequestBody defines body for UpdateDeckSettings for application/x-www-form-urlencoded ContentType.
+type UpdateDeckSettingsFormdataRequestBody = DeckSettings
+
+// EditCardFormdataRequestBody defines body for EditCard for application/x-www-form-urlencoded ContentType.
+type EditCardFormdataRequestBody = EditCard
+
+// UpdateStudySettingsFormdataRequestBody defines body for UpdateStudySettings for application/x-www-form-urlencoded ContentType.
+type UpdateStudySettingsFormdataRequestBody = StudySettings
+
+// AnswerCardTypedFormdataRequestBody defines body for AnswerCardTyped for application/x-www-form-urlencoded ContentType.
+type AnswerCardTypedFormdataRequestBody = TypedAnswer
+
+// UnsuspendCardsFormdataRequestBody defines body for UnsuspendCards for application/x-www-form-urlencoded ContentType.
+type UnsuspendCardsFormdataRequestBody = Unsuspend
+
 // RegisterFormdataRequestBody defines body for Register for application/x-www-form-urlencoded ContentType.
 type RegisterFormdataRequestBody = Register
 
@@ -189,6 +500,9 @@ type AddDeckJSONRequestBody = DeckName
 // AddGroupJSONRequestBody defines body for AddGroup for application/json ContentType.
 type AddGroupJSONRequestBody = GroupName
 
+// UploadMediaMultipartRequestBody defines body for UploadMedia for multipart/form-data ContentType.
+type UploadMediaMultipartRequestBody UploadMediaMultipartBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -273,21 +587,30 @@ type ClientInterface interface {
 
 	LoginWithFormdataBody(ctx context.Context, body LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetMedia request
+	GetMedia(ctx context.Context, mediaId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetCardsForDeck request
 	GetCardsForDeck(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UpdateCardCorrectWithBody request with any body
-	UpdateCardCorrectWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AnswerCard request
+	AnswerCard(ctx context.Context, sessionId string, grade AnswerCardParamsGrade, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BackOfCard request
+	BackOfCard(ctx context.Context, deckId string, cardId string, params *BackOfCardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateCardCorrectWithFormdataBody(ctx context.Context, sessionId string, body UpdateCardCorrectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CardPreviewWithBody request with any body
+	CardPreviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UpdateCardIncorrectWithBody request with any body
-	UpdateCardIncorrectWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CardPreviewWithFormdataBody(ctx context.Context, body CardPreviewFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateCardIncorrectWithFormdataBody(ctx context.Context, sessionId string, body UpdateCardIncorrectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CardHistoryPage request
+	CardHistoryPage(ctx context.Context, cardId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// BackOfCard request
-	BackOfCard(ctx context.Context, deckId string, cardId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AnswerCardChoiceWithBody request with any body
+	AnswerCardChoiceWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AnswerCardChoiceWithFormdataBody(ctx context.Context, sessionId string, body AnswerCardChoiceFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetCreateCardsForDeckContent request
 	GetCreateCardsForDeckContent(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -316,17 +639,84 @@ type ClientInterface interface {
 
 	CreateGroupWithFormdataBody(ctx context.Context, body CreateGroupFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeckSettingsPage request
+	DeckSettingsPage(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDeckSettingsWithBody request with any body
+	UpdateDeckSettingsWithBody(ctx context.Context, deckId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDeckSettingsWithFormdataBody(ctx context.Context, deckId string, body UpdateDeckSettingsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckStatsPage request
+	DeckStatsPage(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditCardPage request
+	EditCardPage(ctx context.Context, cardId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditCardWithBody request with any body
+	EditCardWithBody(ctx context.Context, cardId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditCardWithFormdataBody(ctx context.Context, cardId string, body EditCardFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FrontOfCard request
-	FrontOfCard(ctx context.Context, deckId string, cardId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	FrontOfCard(ctx context.Context, deckId string, cardId string, params *FrontOfCardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GroupLeaderboardTab request
+	GroupLeaderboardTab(ctx context.Context, groupId string, params *GroupLeaderboardTabParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GroupPage request
 	GroupPage(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// HistoryPage request
+	HistoryPage(ctx context.Context, params *HistoryPageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HoldCard request
+	HoldCard(ctx context.Context, sessionId string, hold HoldCardParamsHold, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// HomePage request
 	HomePage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RetryMissedCards request
+	RetryMissedCards(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReviewPage request
+	ReviewPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SessionSummaryPage request
+	SessionSummaryPage(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StudySettingsPage request
+	StudySettingsPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateStudySettingsWithBody request with any body
+	UpdateStudySettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateStudySettingsWithFormdataBody(ctx context.Context, body UpdateStudySettingsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StatsPage request
+	StatsPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StudyTagPage request
+	StudyTagPage(ctx context.Context, params *StudyTagPageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StudySessionPage request
+	StudySessionPage(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SuspendedCardsPage request
+	SuspendedCardsPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AnswerCardTypedWithBody request with any body
+	AnswerCardTypedWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AnswerCardTypedWithFormdataBody(ctx context.Context, sessionId string, body AnswerCardTypedFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnsuspendCardsWithBody request with any body
+	UnsuspendCardsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnsuspendCardsWithFormdataBody(ctx context.Context, body UnsuspendCardsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ViewDeck request
-	ViewDeck(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ViewDeck(ctx context.Context, deckId string, params *ViewDeckParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// VoteCard request
 	VoteCard(ctx context.Context, cardId string, direction string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -358,9 +748,18 @@ type ClientInterface interface {
 	// AddDeckToGroup request
 	AddDeckToGroup(ctx context.Context, groupId string, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetGroupLeaderboard request
+	GetGroupLeaderboard(ctx context.Context, groupId string, params *GetGroupLeaderboardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetGroups request
 	GetGroups(ctx context.Context, params *GetGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UploadMediaWithBody request with any body
+	UploadMediaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessions request
+	GetSessions(ctx context.Context, params *GetSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ServeStyles request
 	ServeStyles(ctx context.Context, path string, styleName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -413,6 +812,18 @@ func (c *Client) LoginWithFormdataBody(ctx context.Context, body LoginFormdataRe
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetMedia(ctx context.Context, mediaId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMediaRequest(c.Server, mediaId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetCardsForDeck(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetCardsForDeckRequest(c.Server, deckId)
 	if err != nil {
@@ -425,8 +836,32 @@ func (c *Client) GetCardsForDeck(ctx context.Context, deckId string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCardCorrectWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCardCorrectRequestWithBody(c.Server, sessionId, contentType, body)
+func (c *Client) AnswerCard(ctx context.Context, sessionId string, grade AnswerCardParamsGrade, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerCardRequest(c.Server, sessionId, grade)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BackOfCard(ctx context.Context, deckId string, cardId string, params *BackOfCardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBackOfCardRequest(c.Server, deckId, cardId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CardPreviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCardPreviewRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -437,8 +872,8 @@ func (c *Client) UpdateCardCorrectWithBody(ctx context.Context, sessionId string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCardCorrectWithFormdataBody(ctx context.Context, sessionId string, body UpdateCardCorrectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCardCorrectRequestWithFormdataBody(c.Server, sessionId, body)
+func (c *Client) CardPreviewWithFormdataBody(ctx context.Context, body CardPreviewFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCardPreviewRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -449,8 +884,8 @@ func (c *Client) UpdateCardCorrectWithFormdataBody(ctx context.Context, sessionI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCardIncorrectWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCardIncorrectRequestWithBody(c.Server, sessionId, contentType, body)
+func (c *Client) CardHistoryPage(ctx context.Context, cardId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCardHistoryPageRequest(c.Server, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -461,8 +896,8 @@ func (c *Client) UpdateCardIncorrectWithBody(ctx context.Context, sessionId stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCardIncorrectWithFormdataBody(ctx context.Context, sessionId string, body UpdateCardIncorrectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCardIncorrectRequestWithFormdataBody(c.Server, sessionId, body)
+func (c *Client) AnswerCardChoiceWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerCardChoiceRequestWithBody(c.Server, sessionId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -473,8 +908,8 @@ func (c *Client) UpdateCardIncorrectWithFormdataBody(ctx context.Context, sessio
 	return c.Client.Do(req)
 }
 
-func (c *Client) BackOfCard(ctx context.Context, deckId string, cardId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBackOfCardRequest(c.Server, deckId, cardId)
+func (c *Client) AnswerCardChoiceWithFormdataBody(ctx context.Context, sessionId string, body AnswerCardChoiceFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerCardChoiceRequestWithFormdataBody(c.Server, sessionId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -605,8 +1040,8 @@ func (c *Client) CreateGroupWithFormdataBody(ctx context.Context, body CreateGro
 	return c.Client.Do(req)
 }
 
-func (c *Client) FrontOfCard(ctx context.Context, deckId string, cardId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFrontOfCardRequest(c.Server, deckId, cardId)
+func (c *Client) DeckSettingsPage(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckSettingsPageRequest(c.Server, deckId)
 	if err != nil {
 		return nil, err
 	}
@@ -617,8 +1052,8 @@ func (c *Client) FrontOfCard(ctx context.Context, deckId string, cardId string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GroupPage(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGroupPageRequest(c.Server, groupID)
+func (c *Client) UpdateDeckSettingsWithBody(ctx context.Context, deckId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDeckSettingsRequestWithBody(c.Server, deckId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -629,8 +1064,8 @@ func (c *Client) GroupPage(ctx context.Context, groupID string, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) HomePage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHomePageRequest(c.Server)
+func (c *Client) UpdateDeckSettingsWithFormdataBody(ctx context.Context, deckId string, body UpdateDeckSettingsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDeckSettingsRequestWithFormdataBody(c.Server, deckId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -641,8 +1076,8 @@ func (c *Client) HomePage(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	return c.Client.Do(req)
 }
 
-func (c *Client) ViewDeck(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewViewDeckRequest(c.Server, deckId)
+func (c *Client) DeckStatsPage(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckStatsPageRequest(c.Server, deckId)
 	if err != nil {
 		return nil, err
 	}
@@ -653,8 +1088,8 @@ func (c *Client) ViewDeck(ctx context.Context, deckId string, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) VoteCard(ctx context.Context, cardId string, direction string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVoteCardRequest(c.Server, cardId, direction)
+func (c *Client) EditCardPage(ctx context.Context, cardId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditCardPageRequest(c.Server, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -665,8 +1100,8 @@ func (c *Client) VoteCard(ctx context.Context, cardId string, direction string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) RegistrationPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegistrationPageRequest(c.Server)
+func (c *Client) EditCardWithBody(ctx context.Context, cardId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditCardRequestWithBody(c.Server, cardId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -677,8 +1112,8 @@ func (c *Client) RegistrationPage(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequestWithBody(c.Server, contentType, body)
+func (c *Client) EditCardWithFormdataBody(ctx context.Context, cardId string, body EditCardFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditCardRequestWithFormdataBody(c.Server, cardId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -689,8 +1124,8 @@ func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) RegisterWithFormdataBody(ctx context.Context, body RegisterFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequestWithFormdataBody(c.Server, body)
+func (c *Client) FrontOfCard(ctx context.Context, deckId string, cardId string, params *FrontOfCardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFrontOfCardRequest(c.Server, deckId, cardId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -701,8 +1136,8 @@ func (c *Client) RegisterWithFormdataBody(ctx context.Context, body RegisterForm
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCardInput(ctx context.Context, cardNum int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCardInputRequest(c.Server, cardNum)
+func (c *Client) GroupLeaderboardTab(ctx context.Context, groupId string, params *GroupLeaderboardTabParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupLeaderboardTabRequest(c.Server, groupId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -713,8 +1148,8 @@ func (c *Client) GetCardInput(ctx context.Context, cardNum int, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddDeckWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddDeckRequestWithBody(c.Server, contentType, body)
+func (c *Client) GroupPage(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupPageRequest(c.Server, groupID)
 	if err != nil {
 		return nil, err
 	}
@@ -725,8 +1160,8 @@ func (c *Client) AddDeckWithBody(ctx context.Context, contentType string, body i
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddDeck(ctx context.Context, body AddDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddDeckRequest(c.Server, body)
+func (c *Client) HistoryPage(ctx context.Context, params *HistoryPageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoryPageRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -737,8 +1172,8 @@ func (c *Client) AddDeck(ctx context.Context, body AddDeckJSONRequestBody, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDecksForUser(ctx context.Context, params *GetDecksForUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDecksForUserRequest(c.Server, params)
+func (c *Client) HoldCard(ctx context.Context, sessionId string, hold HoldCardParamsHold, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHoldCardRequest(c.Server, sessionId, hold)
 	if err != nil {
 		return nil, err
 	}
@@ -749,8 +1184,8 @@ func (c *Client) GetDecksForUser(ctx context.Context, params *GetDecksForUserPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddGroupRequestWithBody(c.Server, contentType, body)
+func (c *Client) HomePage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHomePageRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -761,8 +1196,8 @@ func (c *Client) AddGroupWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddGroup(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddGroupRequest(c.Server, body)
+func (c *Client) RetryMissedCards(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryMissedCardsRequest(c.Server, sessionId)
 	if err != nil {
 		return nil, err
 	}
@@ -773,8 +1208,8 @@ func (c *Client) AddGroup(ctx context.Context, body AddGroupJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddDeckToGroup(ctx context.Context, groupId string, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddDeckToGroupRequest(c.Server, groupId, deckId)
+func (c *Client) ReviewPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewPageRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -785,8 +1220,8 @@ func (c *Client) AddDeckToGroup(ctx context.Context, groupId string, deckId stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetGroups(ctx context.Context, params *GetGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetGroupsRequest(c.Server, params)
+func (c *Client) SessionSummaryPage(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSessionSummaryPageRequest(c.Server, sessionId)
 	if err != nil {
 		return nil, err
 	}
@@ -797,8 +1232,8 @@ func (c *Client) GetGroups(ctx context.Context, params *GetGroupsParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) ServeStyles(ctx context.Context, path string, styleName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewServeStylesRequest(c.Server, path, styleName)
+func (c *Client) StudySettingsPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStudySettingsPageRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -809,252 +1244,340 @@ func (c *Client) ServeStyles(ctx context.Context, path string, styleName string,
 	return c.Client.Do(req)
 }
 
-// NewGetFaviconRequest generates requests for GetFavicon
-func NewGetFaviconRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateStudySettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateStudySettingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/favicon.ico")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateStudySettingsWithFormdataBody(ctx context.Context, body UpdateStudySettingsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateStudySettingsRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewLoginPageRequest generates requests for LoginPage
-func NewLoginPageRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) StatsPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStatsPageRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StudyTagPage(ctx context.Context, params *StudyTagPageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStudyTagPageRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewLoginRequestWithFormdataBody calls the generic Login builder with application/x-www-form-urlencoded body
-func NewLoginRequestWithFormdataBody(server string, body LoginFormdataRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+func (c *Client) StudySessionPage(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStudySessionPageRequest(c.Server, sessionId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewLoginRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SuspendedCardsPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSuspendedCardsPageRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AnswerCardTypedWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerCardTypedRequestWithBody(c.Server, sessionId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) AnswerCardTypedWithFormdataBody(ctx context.Context, sessionId string, body AnswerCardTypedFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerCardTypedRequestWithFormdataBody(c.Server, sessionId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetCardsForDeckRequest generates requests for GetCardsForDeck
-func NewGetCardsForDeckRequest(server string, deckId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+func (c *Client) UnsuspendCardsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnsuspendCardsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UnsuspendCardsWithFormdataBody(ctx context.Context, body UnsuspendCardsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnsuspendCardsRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/page/add-card/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ViewDeck(ctx context.Context, deckId string, params *ViewDeckParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewViewDeckRequest(c.Server, deckId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) VoteCard(ctx context.Context, cardId string, direction string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVoteCardRequest(c.Server, cardId, direction)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCardCorrectRequestWithFormdataBody calls the generic UpdateCardCorrect builder with application/x-www-form-urlencoded body
-func NewUpdateCardCorrectRequestWithFormdataBody(server string, sessionId string, body UpdateCardCorrectFormdataRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+func (c *Client) RegistrationPage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegistrationPageRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewUpdateCardCorrectRequestWithBody(server, sessionId, "application/x-www-form-urlencoded", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCardCorrectRequestWithBody generates requests for UpdateCardCorrect with any type of body
-func NewUpdateCardCorrectRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RegisterWithFormdataBody(ctx context.Context, body RegisterFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/page/answered-correct/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetCardInput(ctx context.Context, cardNum int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCardInputRequest(c.Server, cardNum)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) AddDeckWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDeckRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCardIncorrectRequestWithFormdataBody calls the generic UpdateCardIncorrect builder with application/x-www-form-urlencoded body
-func NewUpdateCardIncorrectRequestWithFormdataBody(server string, sessionId string, body UpdateCardIncorrectFormdataRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+func (c *Client) AddDeck(ctx context.Context, body AddDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDeckRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewUpdateCardIncorrectRequestWithBody(server, sessionId, "application/x-www-form-urlencoded", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCardIncorrectRequestWithBody generates requests for UpdateCardIncorrect with any type of body
-func NewUpdateCardIncorrectRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+func (c *Client) GetDecksForUser(ctx context.Context, params *GetDecksForUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDecksForUserRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/page/answered-incorrect/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddGroup(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) AddDeckToGroup(ctx context.Context, groupId string, deckId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDeckToGroupRequest(c.Server, groupId, deckId)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewBackOfCardRequest generates requests for BackOfCard
-func NewBackOfCardRequest(server string, deckId string, cardId string) (*http.Request, error) {
-	var err error
+func (c *Client) GetGroupLeaderboard(ctx context.Context, groupId string, params *GetGroupLeaderboardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGroupLeaderboardRequest(c.Server, groupId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) GetGroups(ctx context.Context, params *GetGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGroupsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+func (c *Client) UploadMediaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadMediaRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) GetSessions(ctx context.Context, params *GetSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
+func (c *Client) ServeStyles(ctx context.Context, path string, styleName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewServeStylesRequest(c.Server, path, styleName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetFaviconRequest generates requests for GetFavicon
+func NewGetFaviconRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/back-of-card/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/favicon.ico")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1072,23 +1595,16 @@ func NewBackOfCardRequest(server string, deckId string, cardId string) (*http.Re
 	return req, nil
 }
 
-// NewGetCreateCardsForDeckContentRequest generates requests for GetCreateCardsForDeckContent
-func NewGetCreateCardsForDeckContentRequest(server string, deckId string) (*http.Request, error) {
+// NewLoginPageRequest generates requests for LoginPage
+func NewLoginPageRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/create-cards-content/%s", pathParam0)
+	operationPath := fmt.Sprintf("/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1106,23 +1622,27 @@ func NewGetCreateCardsForDeckContentRequest(server string, deckId string) (*http
 	return req, nil
 }
 
-// NewGetCreateCardsForDeckPageRequest generates requests for GetCreateCardsForDeckPage
-func NewGetCreateCardsForDeckPageRequest(server string, deckId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+// NewLoginRequestWithFormdataBody calls the generic Login builder with application/x-www-form-urlencoded body
+func NewLoginRequestWithFormdataBody(server string, body LoginFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewLoginRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/create-cards/%s", pathParam0)
+	operationPath := fmt.Sprintf("/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1132,32 +1652,23 @@ func NewGetCreateCardsForDeckPageRequest(server string, deckId string) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewCreateCardForDeckRequestWithFormdataBody calls the generic CreateCardForDeck builder with application/x-www-form-urlencoded body
-func NewCreateCardForDeckRequestWithFormdataBody(server string, deckId string, body CreateCardForDeckFormdataRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewCreateCardForDeckRequestWithBody(server, deckId, "application/x-www-form-urlencoded", bodyReader)
+	return req, nil
 }
 
-// NewCreateCardForDeckRequestWithBody generates requests for CreateCardForDeck with any type of body
-func NewCreateCardForDeckRequestWithBody(server string, deckId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetMediaRequest generates requests for GetMedia
+func NewGetMediaRequest(server string, mediaId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "media_id", runtime.ParamLocationPath, mediaId)
 	if err != nil {
 		return nil, err
 	}
@@ -1167,7 +1678,7 @@ func NewCreateCardForDeckRequestWithBody(server string, deckId string, contentTy
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/create-cards/%s", pathParam0)
+	operationPath := fmt.Sprintf("/media/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1177,23 +1688,21 @@ func NewCreateCardForDeckRequestWithBody(server string, deckId string, contentTy
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCreateDeckPageRequest generates requests for CreateDeckPage
-func NewCreateDeckPageRequest(server string, groupId string) (*http.Request, error) {
+// NewGetCardsForDeckRequest generates requests for GetCardsForDeck
+func NewGetCardsForDeckRequest(server string, deckId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
 	if err != nil {
 		return nil, err
 	}
@@ -1203,7 +1712,7 @@ func NewCreateDeckPageRequest(server string, groupId string) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/create-deck/%s", pathParam0)
+	operationPath := fmt.Sprintf("/page/add-card/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1221,24 +1730,20 @@ func NewCreateDeckPageRequest(server string, groupId string) (*http.Request, err
 	return req, nil
 }
 
-// NewCreateDeckRequest calls the generic CreateDeck builder with application/json body
-func NewCreateDeckRequest(server string, groupId string, body CreateDeckJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewAnswerCardRequest generates requests for AnswerCard
+func NewAnswerCardRequest(server string, sessionId string, grade AnswerCardParamsGrade) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDeckRequestWithBody(server, groupId, "application/json", bodyReader)
-}
-
-// NewCreateDeckRequestWithBody generates requests for CreateDeck with any type of body
-func NewCreateDeckRequestWithBody(server string, groupId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "grade", runtime.ParamLocationPath, grade)
 	if err != nil {
 		return nil, err
 	}
@@ -1248,7 +1753,7 @@ func NewCreateDeckRequestWithBody(server string, groupId string, contentType str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/create-deck/%s", pathParam0)
+	operationPath := fmt.Sprintf("/page/answer/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1258,26 +1763,38 @@ func NewCreateDeckRequestWithBody(server string, groupId string, contentType str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCreateGroupPageRequest generates requests for CreateGroupPage
-func NewCreateGroupPageRequest(server string) (*http.Request, error) {
+// NewBackOfCardRequest generates requests for BackOfCard
+func NewBackOfCardRequest(server string, deckId string, cardId string, params *BackOfCardParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/create-group")
+	operationPath := fmt.Sprintf("/page/back-of-card/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1287,6 +1804,44 @@ func NewCreateGroupPageRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.SessionId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "session_id", runtime.ParamLocationQuery, *params.SessionId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Reversed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reversed", runtime.ParamLocationQuery, *params.Reversed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1295,19 +1850,19 @@ func NewCreateGroupPageRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateGroupRequestWithFormdataBody calls the generic CreateGroup builder with application/x-www-form-urlencoded body
-func NewCreateGroupRequestWithFormdataBody(server string, body CreateGroupFormdataRequestBody) (*http.Request, error) {
+// NewCardPreviewRequestWithFormdataBody calls the generic CardPreview builder with application/x-www-form-urlencoded body
+func NewCardPreviewRequestWithFormdataBody(server string, body CardPreviewFormdataRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	bodyStr, err := runtime.MarshalForm(body, nil)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewCreateGroupRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+	return NewCardPreviewRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// NewCreateGroupRequestWithBody generates requests for CreateGroup with any type of body
-func NewCreateGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCardPreviewRequestWithBody generates requests for CardPreview with any type of body
+func NewCardPreviewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1315,7 +1870,7 @@ func NewCreateGroupRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/create-group")
+	operationPath := fmt.Sprintf("/page/card-preview")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1335,20 +1890,13 @@ func NewCreateGroupRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
-// NewFrontOfCardRequest generates requests for FrontOfCard
-func NewFrontOfCardRequest(server string, deckId string, cardId string) (*http.Request, error) {
+// NewCardHistoryPageRequest generates requests for CardHistoryPage
+func NewCardHistoryPageRequest(server string, cardId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -1358,7 +1906,7 @@ func NewFrontOfCardRequest(server string, deckId string, cardId string) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/front-of-card/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/page/card/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1376,13 +1924,24 @@ func NewFrontOfCardRequest(server string, deckId string, cardId string) (*http.R
 	return req, nil
 }
 
-// NewGroupPageRequest generates requests for GroupPage
-func NewGroupPageRequest(server string, groupID string) (*http.Request, error) {
+// NewAnswerCardChoiceRequestWithFormdataBody calls the generic AnswerCardChoice builder with application/x-www-form-urlencoded body
+func NewAnswerCardChoiceRequestWithFormdataBody(server string, sessionId string, body AnswerCardChoiceFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewAnswerCardChoiceRequestWithBody(server, sessionId, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewAnswerCardChoiceRequestWithBody generates requests for AnswerCardChoice with any type of body
+func NewAnswerCardChoiceRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupID", runtime.ParamLocationPath, groupID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
 	if err != nil {
 		return nil, err
 	}
@@ -1392,7 +1951,7 @@ func NewGroupPageRequest(server string, groupID string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/page/choice-answer/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1402,24 +1961,33 @@ func NewGroupPageRequest(server string, groupID string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewHomePageRequest generates requests for HomePage
-func NewHomePageRequest(server string) (*http.Request, error) {
+// NewGetCreateCardsForDeckContentRequest generates requests for GetCreateCardsForDeckContent
+func NewGetCreateCardsForDeckContentRequest(server string, deckId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/home")
+	operationPath := fmt.Sprintf("/page/create-cards-content/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1437,8 +2005,8 @@ func NewHomePageRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewViewDeckRequest generates requests for ViewDeck
-func NewViewDeckRequest(server string, deckId string) (*http.Request, error) {
+// NewGetCreateCardsForDeckPageRequest generates requests for GetCreateCardsForDeckPage
+func NewGetCreateCardsForDeckPageRequest(server string, deckId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1453,7 +2021,7 @@ func NewViewDeckRequest(server string, deckId string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/view-deck/%s", pathParam0)
+	operationPath := fmt.Sprintf("/page/create-cards/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1471,20 +2039,24 @@ func NewViewDeckRequest(server string, deckId string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewVoteCardRequest generates requests for VoteCard
-func NewVoteCardRequest(server string, cardId string, direction string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
+// NewCreateCardForDeckRequestWithFormdataBody calls the generic CreateCardForDeck builder with application/x-www-form-urlencoded body
+func NewCreateCardForDeckRequestWithFormdataBody(server string, deckId string, body CreateCardForDeckFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewCreateCardForDeckRequestWithBody(server, deckId, "application/x-www-form-urlencoded", bodyReader)
+}
 
-	var pathParam1 string
+// NewCreateCardForDeckRequestWithBody generates requests for CreateCardForDeck with any type of body
+func NewCreateCardForDeckRequestWithBody(server string, deckId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "direction", runtime.ParamLocationPath, direction)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
 	if err != nil {
 		return nil, err
 	}
@@ -1494,7 +2066,7 @@ func NewVoteCardRequest(server string, cardId string, direction string) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/page/vote-card/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/page/create-cards/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1504,24 +2076,33 @@ func NewVoteCardRequest(server string, cardId string, direction string) (*http.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewRegistrationPageRequest generates requests for RegistrationPage
-func NewRegistrationPageRequest(server string) (*http.Request, error) {
+// NewCreateDeckPageRequest generates requests for CreateDeckPage
+func NewCreateDeckPageRequest(server string, groupId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/register")
+	operationPath := fmt.Sprintf("/page/create-deck/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1539,27 +2120,34 @@ func NewRegistrationPageRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewRegisterRequestWithFormdataBody calls the generic Register builder with application/x-www-form-urlencoded body
-func NewRegisterRequestWithFormdataBody(server string, body RegisterFormdataRequestBody) (*http.Request, error) {
+// NewCreateDeckRequest calls the generic CreateDeck builder with application/json body
+func NewCreateDeckRequest(server string, groupId string, body CreateDeckJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewRegisterRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDeckRequestWithBody(server, groupId, "application/json", bodyReader)
 }
 
-// NewRegisterRequestWithBody generates requests for Register with any type of body
-func NewRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateDeckRequestWithBody generates requests for CreateDeck with any type of body
+func NewCreateDeckRequestWithBody(server string, groupId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/register")
+	operationPath := fmt.Sprintf("/page/create-deck/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1579,23 +2167,16 @@ func NewRegisterRequestWithBody(server string, contentType string, body io.Reade
 	return req, nil
 }
 
-// NewGetCardInputRequest generates requests for GetCardInput
-func NewGetCardInputRequest(server string, cardNum int) (*http.Request, error) {
+// NewCreateGroupPageRequest generates requests for CreateGroupPage
+func NewCreateGroupPageRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "card-num", runtime.ParamLocationPath, cardNum)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/secure/api/v1/card-input/%s", pathParam0)
+	operationPath := fmt.Sprintf("/page/create-group")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1613,19 +2194,19 @@ func NewGetCardInputRequest(server string, cardNum int) (*http.Request, error) {
 	return req, nil
 }
 
-// NewAddDeckRequest calls the generic AddDeck builder with application/json body
-func NewAddDeckRequest(server string, body AddDeckJSONRequestBody) (*http.Request, error) {
+// NewCreateGroupRequestWithFormdataBody calls the generic CreateGroup builder with application/x-www-form-urlencoded body
+func NewCreateGroupRequestWithFormdataBody(server string, body CreateGroupFormdataRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	bodyStr, err := runtime.MarshalForm(body, nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddDeckRequestWithBody(server, "application/json", bodyReader)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewCreateGroupRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// NewAddDeckRequestWithBody generates requests for AddDeck with any type of body
-func NewAddDeckRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateGroupRequestWithBody generates requests for CreateGroup with any type of body
+func NewCreateGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1633,7 +2214,7 @@ func NewAddDeckRequestWithBody(server string, contentType string, body io.Reader
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/secure/api/v1/deck")
+	operationPath := fmt.Sprintf("/page/create-group")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1653,16 +2234,23 @@ func NewAddDeckRequestWithBody(server string, contentType string, body io.Reader
 	return req, nil
 }
 
-// NewGetDecksForUserRequest generates requests for GetDecksForUser
-func NewGetDecksForUserRequest(server string, params *GetDecksForUserParams) (*http.Request, error) {
+// NewDeckSettingsPageRequest generates requests for DeckSettingsPage
+func NewDeckSettingsPageRequest(server string, deckId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/secure/api/v1/decks")
+	operationPath := fmt.Sprintf("/page/deck-settings/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1672,93 +2260,78 @@ func NewGetDecksForUserRequest(server string, params *GetDecksForUserParams) (*h
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return req, nil
+}
 
-		if params.To != nil {
+// NewUpdateDeckSettingsRequestWithFormdataBody calls the generic UpdateDeckSettings builder with application/x-www-form-urlencoded body
+func NewUpdateDeckSettingsRequestWithFormdataBody(server string, deckId string, body UpdateDeckSettingsFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewUpdateDeckSettingsRequestWithBody(server, deckId, "application/x-www-form-urlencoded", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewUpdateDeckSettingsRequestWithBody generates requests for UpdateDeckSettings with any type of body
+func NewUpdateDeckSettingsRequestWithBody(server string, deckId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	operationPath := fmt.Sprintf("/page/deck-settings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewAddGroupRequest calls the generic AddGroup builder with application/json body
-func NewAddGroupRequest(server string, body AddGroupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddGroupRequestWithBody(server, "application/json", bodyReader)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// NewAddGroupRequestWithBody generates requests for AddGroup with any type of body
-func NewAddGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeckStatsPageRequest generates requests for DeckStatsPage
+func NewDeckStatsPageRequest(server string, deckId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/secure/api/v1/group")
+	operationPath := fmt.Sprintf("/page/deck-stats/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1768,30 +2341,66 @@ func NewAddGroupRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAddDeckToGroupRequest generates requests for AddDeckToGroup
-func NewAddDeckToGroupRequest(server string, groupId string, deckId string) (*http.Request, error) {
+// NewEditCardPageRequest generates requests for EditCardPage
+func NewEditCardPageRequest(server string, cardId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	operationPath := fmt.Sprintf("/page/edit-card/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditCardRequestWithFormdataBody calls the generic EditCard builder with application/x-www-form-urlencoded body
+func NewEditCardRequestWithFormdataBody(server string, cardId string, body EditCardFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewEditCardRequestWithBody(server, cardId, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewEditCardRequestWithBody generates requests for EditCard with any type of body
+func NewEditCardRequestWithBody(server string, cardId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -1801,7 +2410,7 @@ func NewAddDeckToGroupRequest(server string, groupId string, deckId string) (*ht
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/secure/api/v1/group/%s/deck/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/page/edit-card/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1811,24 +2420,40 @@ func NewAddDeckToGroupRequest(server string, groupId string, deckId string) (*ht
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetGroupsRequest generates requests for GetGroups
-func NewGetGroupsRequest(server string, params *GetGroupsParams) (*http.Request, error) {
+// NewFrontOfCardRequest generates requests for FrontOfCard
+func NewFrontOfCardRequest(server string, deckId string, cardId string, params *FrontOfCardParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/secure/api/v1/groups")
+	operationPath := fmt.Sprintf("/page/front-of-card/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1841,21 +2466,9 @@ func NewGetGroupsRequest(server string, params *GetGroupsParams) (*http.Request,
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if params.To != nil {
+		if params.SessionId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "session_id", runtime.ParamLocationQuery, *params.SessionId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1869,28 +2482,20 @@ func NewGetGroupsRequest(server string, params *GetGroupsParams) (*http.Request,
 
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+		if params.Reversed != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reversed", runtime.ParamLocationQuery, *params.Reversed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
@@ -1904,20 +2509,13 @@ func NewGetGroupsRequest(server string, params *GetGroupsParams) (*http.Request,
 	return req, nil
 }
 
-// NewServeStylesRequest generates requests for ServeStyles
-func NewServeStylesRequest(server string, path string, styleName string) (*http.Request, error) {
+// NewGroupLeaderboardTabRequest generates requests for GroupLeaderboardTab
+func NewGroupLeaderboardTabRequest(server string, groupId string, params *GroupLeaderboardTabParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "path", runtime.ParamLocationPath, path)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "style_name", runtime.ParamLocationPath, styleName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -1927,7 +2525,7 @@ func NewServeStylesRequest(server string, path string, styleName string) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/styles/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/page/group-leaderboard/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1937,1698 +2535,4454 @@ func NewServeStylesRequest(server string, path string, styleName string) (*http.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Window != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "window", runtime.ParamLocationQuery, *params.Window); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+		if params.Metric != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "metric", runtime.ParamLocationQuery, *params.Metric); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetFaviconWithResponse request
-	GetFaviconWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFaviconResponse, error)
+// NewGroupPageRequest generates requests for GroupPage
+func NewGroupPageRequest(server string, groupID string) (*http.Request, error) {
+	var err error
 
-	// LoginPageWithResponse request
-	LoginPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LoginPageResponse, error)
+	var pathParam0 string
 
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupID", runtime.ParamLocationPath, groupID)
+	if err != nil {
+		return nil, err
+	}
 
-	LoginWithFormdataBodyWithResponse(ctx context.Context, body LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCardsForDeckWithResponse request
-	GetCardsForDeckWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCardsForDeckResponse, error)
+	operationPath := fmt.Sprintf("/page/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateCardCorrectWithBodyWithResponse request with any body
-	UpdateCardCorrectWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardCorrectResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateCardCorrectWithFormdataBodyWithResponse(ctx context.Context, sessionId string, body UpdateCardCorrectFormdataRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardCorrectResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateCardIncorrectWithBodyWithResponse request with any body
-	UpdateCardIncorrectWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardIncorrectResponse, error)
+	return req, nil
+}
 
-	UpdateCardIncorrectWithFormdataBodyWithResponse(ctx context.Context, sessionId string, body UpdateCardIncorrectFormdataRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardIncorrectResponse, error)
+// NewHistoryPageRequest generates requests for HistoryPage
+func NewHistoryPageRequest(server string, params *HistoryPageParams) (*http.Request, error) {
+	var err error
 
-	// BackOfCardWithResponse request
-	BackOfCardWithResponse(ctx context.Context, deckId string, cardId string, reqEditors ...RequestEditorFn) (*BackOfCardResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCreateCardsForDeckContentWithResponse request
-	GetCreateCardsForDeckContentWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCreateCardsForDeckContentResponse, error)
+	operationPath := fmt.Sprintf("/page/history")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetCreateCardsForDeckPageWithResponse request
-	GetCreateCardsForDeckPageWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCreateCardsForDeckPageResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateCardForDeckWithBodyWithResponse request with any body
-	CreateCardForDeckWithBodyWithResponse(ctx context.Context, deckId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardForDeckResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	CreateCardForDeckWithFormdataBodyWithResponse(ctx context.Context, deckId string, body CreateCardForDeckFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateCardForDeckResponse, error)
+		if params.DeckId != nil {
 
-	// CreateDeckPageWithResponse request
-	CreateDeckPageWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*CreateDeckPageResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deck_id", runtime.ParamLocationQuery, *params.DeckId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateDeckWithBodyWithResponse request with any body
-	CreateDeckWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeckResponse, error)
+		}
 
-	CreateDeckWithResponse(ctx context.Context, groupId string, body CreateDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeckResponse, error)
+		if params.Offset != nil {
 
-	// CreateGroupPageWithResponse request
-	CreateGroupPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateGroupPageResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateGroupWithBodyWithResponse request with any body
-	CreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error)
+		}
 
-	CreateGroupWithFormdataBodyWithResponse(ctx context.Context, body CreateGroupFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// FrontOfCardWithResponse request
-	FrontOfCardWithResponse(ctx context.Context, deckId string, cardId string, reqEditors ...RequestEditorFn) (*FrontOfCardResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GroupPageWithResponse request
-	GroupPageWithResponse(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*GroupPageResponse, error)
+	return req, nil
+}
 
-	// HomePageWithResponse request
-	HomePageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HomePageResponse, error)
+// NewHoldCardRequest generates requests for HoldCard
+func NewHoldCardRequest(server string, sessionId string, hold HoldCardParamsHold) (*http.Request, error) {
+	var err error
 
-	// ViewDeckWithResponse request
-	ViewDeckWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*ViewDeckResponse, error)
+	var pathParam0 string
 
-	// VoteCardWithResponse request
-	VoteCardWithResponse(ctx context.Context, cardId string, direction string, reqEditors ...RequestEditorFn) (*VoteCardResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
 
-	// RegistrationPageWithResponse request
-	RegistrationPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RegistrationPageResponse, error)
+	var pathParam1 string
 
-	// RegisterWithBodyWithResponse request with any body
-	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "hold", runtime.ParamLocationPath, hold)
+	if err != nil {
+		return nil, err
+	}
 
-	RegisterWithFormdataBodyWithResponse(ctx context.Context, body RegisterFormdataRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCardInputWithResponse request
-	GetCardInputWithResponse(ctx context.Context, cardNum int, reqEditors ...RequestEditorFn) (*GetCardInputResponse, error)
+	operationPath := fmt.Sprintf("/page/hold-card/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AddDeckWithBodyWithResponse request with any body
-	AddDeckWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDeckResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AddDeckWithResponse(ctx context.Context, body AddDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDeckResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDecksForUserWithResponse request
-	GetDecksForUserWithResponse(ctx context.Context, params *GetDecksForUserParams, reqEditors ...RequestEditorFn) (*GetDecksForUserResponse, error)
+	return req, nil
+}
 
-	// AddGroupWithBodyWithResponse request with any body
-	AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+// NewHomePageRequest generates requests for HomePage
+func NewHomePageRequest(server string) (*http.Request, error) {
+	var err error
 
-	AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddDeckToGroupWithResponse request
-	AddDeckToGroupWithResponse(ctx context.Context, groupId string, deckId string, reqEditors ...RequestEditorFn) (*AddDeckToGroupResponse, error)
+	operationPath := fmt.Sprintf("/page/home")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetGroupsWithResponse request
-	GetGroupsWithResponse(ctx context.Context, params *GetGroupsParams, reqEditors ...RequestEditorFn) (*GetGroupsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ServeStylesWithResponse request
-	ServeStylesWithResponse(ctx context.Context, path string, styleName string, reqEditors ...RequestEditorFn) (*ServeStylesResponse, error)
-}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type GetFaviconResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetFaviconResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewRetryMissedCardsRequest generates requests for RetryMissedCards
+func NewRetryMissedCardsRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFaviconResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type LoginPageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/page/retry/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r LoginPageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginPageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type LoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LoginResponseBody
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewReviewPageRequest generates requests for ReviewPage
+func NewReviewPageRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCardsForDeckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/page/review")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCardsForDeckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCardsForDeckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateCardCorrectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCardCorrectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSessionSummaryPageRequest generates requests for SessionSummaryPage
+func NewSessionSummaryPageRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCardCorrectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateCardIncorrectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/page/session-summary/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateCardIncorrectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCardIncorrectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type BackOfCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r BackOfCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewStudySettingsPageRequest generates requests for StudySettingsPage
+func NewStudySettingsPageRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r BackOfCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/page/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetCreateCardsForDeckContentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCreateCardsForDeckContentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCreateCardsForDeckContentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdateStudySettingsRequestWithFormdataBody calls the generic UpdateStudySettings builder with application/x-www-form-urlencoded body
+func NewUpdateStudySettingsRequestWithFormdataBody(server string, body UpdateStudySettingsFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewUpdateStudySettingsRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-type GetCreateCardsForDeckPageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewUpdateStudySettingsRequestWithBody generates requests for UpdateStudySettings with any type of body
+func NewUpdateStudySettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetCreateCardsForDeckPageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCreateCardsForDeckPageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/page/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type CreateCardForDeckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateCardForDeckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCardForDeckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewStatsPageRequest generates requests for StatsPage
+func NewStatsPageRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDeckPageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/page/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateDeckPageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDeckPageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type CreateDeckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewStudyTagPageRequest generates requests for StudyTagPage
+func NewStudyTagPageRequest(server string, params *StudyTagPageParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CreateDeckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDeckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/page/study-tag")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type CreateGroupPageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateGroupPageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, params.Tag); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateGroupPageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewStudySessionPageRequest generates requests for StudySessionPage
+func NewStudySessionPageRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type FrontOfCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/page/study/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r FrontOfCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FrontOfCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GroupPageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GroupPageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSuspendedCardsPageRequest generates requests for SuspendedCardsPage
+func NewSuspendedCardsPageRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GroupPageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/page/suspended")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type HomePageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r HomePageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HomePageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAnswerCardTypedRequestWithFormdataBody calls the generic AnswerCardTyped builder with application/x-www-form-urlencoded body
+func NewAnswerCardTypedRequestWithFormdataBody(server string, sessionId string, body AnswerCardTypedFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewAnswerCardTypedRequestWithBody(server, sessionId, "application/x-www-form-urlencoded", bodyReader)
 }
 
-type ViewDeckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewAnswerCardTypedRequestWithBody generates requests for AnswerCardTyped with any type of body
+func NewAnswerCardTypedRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ViewDeckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ViewDeckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type VoteCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/page/typed-answer/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r VoteCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VoteCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type RegistrationPageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r RegistrationPageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegistrationPageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUnsuspendCardsRequestWithFormdataBody calls the generic UnsuspendCards builder with application/x-www-form-urlencoded body
+func NewUnsuspendCardsRequestWithFormdataBody(server string, body UnsuspendCardsFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewUnsuspendCardsRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-type RegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewUnsuspendCardsRequestWithBody generates requests for UnsuspendCards with any type of body
+func NewUnsuspendCardsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r RegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/page/unsuspend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetCardInputResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
-
-// Status returns HTTPResponse.Status
-func (r GetCardInputResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCardInputResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AddDeckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *UserError
-	JSON409      *ConflictError
-	JSON500      *InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r AddDeckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddDeckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewViewDeckRequest generates requests for ViewDeck
+func NewViewDeckRequest(server string, deckId string, params *ViewDeckParams) (*http.Request, error) {
+	var err error
 
-type GetDecksForUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetGroups
-	JSON400      *UserError
-	JSON500      *InternalServerError
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetDecksForUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDecksForUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type AddGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *UserError
-	JSON409      *ConflictError
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r AddGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/page/view-deck/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AddDeckToGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *UserError
-	JSON409      *ConflictError
-	JSON500      *InternalServerError
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r AddDeckToGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Order != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddDeckToGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetGroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetGroups
-	JSON400      *UserError
-	JSON500      *InternalServerError
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetGroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Direction != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetGroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "direction", runtime.ParamLocationQuery, *params.Direction); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type ServeStylesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r ServeStylesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Mode != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ServeStylesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-// GetFaviconWithResponse request returning *GetFaviconResponse
-func (c *ClientWithResponses) GetFaviconWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFaviconResponse, error) {
-	rsp, err := c.GetFavicon(ctx, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFaviconResponse(rsp)
+
+	return req, nil
 }
 
-// LoginPageWithResponse request returning *LoginPageResponse
-func (c *ClientWithResponses) LoginPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LoginPageResponse, error) {
-	rsp, err := c.LoginPage(ctx, reqEditors...)
+// NewVoteCardRequest generates requests for VoteCard
+func NewVoteCardRequest(server string, cardId string, direction string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "card_id", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginPageResponse(rsp)
-}
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "direction", runtime.ParamLocationPath, direction)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) LoginWithFormdataBodyWithResponse(ctx context.Context, body LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.LoginWithFormdataBody(ctx, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginResponse(rsp)
-}
 
-// GetCardsForDeckWithResponse request returning *GetCardsForDeckResponse
-func (c *ClientWithResponses) GetCardsForDeckWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCardsForDeckResponse, error) {
-	rsp, err := c.GetCardsForDeck(ctx, deckId, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/page/vote-card/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetCardsForDeckResponse(rsp)
-}
 
-// UpdateCardCorrectWithBodyWithResponse request with arbitrary body returning *UpdateCardCorrectResponse
-func (c *ClientWithResponses) UpdateCardCorrectWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardCorrectResponse, error) {
-	rsp, err := c.UpdateCardCorrectWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCardCorrectResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateCardCorrectWithFormdataBodyWithResponse(ctx context.Context, sessionId string, body UpdateCardCorrectFormdataRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardCorrectResponse, error) {
-	rsp, err := c.UpdateCardCorrectWithFormdataBody(ctx, sessionId, body, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCardCorrectResponse(rsp)
+
+	return req, nil
 }
 
-// UpdateCardIncorrectWithBodyWithResponse request with arbitrary body returning *UpdateCardIncorrectResponse
-func (c *ClientWithResponses) UpdateCardIncorrectWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardIncorrectResponse, error) {
-	rsp, err := c.UpdateCardIncorrectWithBody(ctx, sessionId, contentType, body, reqEditors...)
+// NewRegistrationPageRequest generates requests for RegistrationPage
+func NewRegistrationPageRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCardIncorrectResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateCardIncorrectWithFormdataBodyWithResponse(ctx context.Context, sessionId string, body UpdateCardIncorrectFormdataRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardIncorrectResponse, error) {
-	rsp, err := c.UpdateCardIncorrectWithFormdataBody(ctx, sessionId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseUpdateCardIncorrectResponse(rsp)
-}
 
-// BackOfCardWithResponse request returning *BackOfCardResponse
-func (c *ClientWithResponses) BackOfCardWithResponse(ctx context.Context, deckId string, cardId string, reqEditors ...RequestEditorFn) (*BackOfCardResponse, error) {
-	rsp, err := c.BackOfCard(ctx, deckId, cardId, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseBackOfCardResponse(rsp)
-}
 
-// GetCreateCardsForDeckContentWithResponse request returning *GetCreateCardsForDeckContentResponse
-func (c *ClientWithResponses) GetCreateCardsForDeckContentWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCreateCardsForDeckContentResponse, error) {
-	rsp, err := c.GetCreateCardsForDeckContent(ctx, deckId, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCreateCardsForDeckContentResponse(rsp)
+
+	return req, nil
 }
 
-// GetCreateCardsForDeckPageWithResponse request returning *GetCreateCardsForDeckPageResponse
-func (c *ClientWithResponses) GetCreateCardsForDeckPageWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCreateCardsForDeckPageResponse, error) {
-	rsp, err := c.GetCreateCardsForDeckPage(ctx, deckId, reqEditors...)
+// NewRegisterRequestWithFormdataBody calls the generic Register builder with application/x-www-form-urlencoded body
+func NewRegisterRequestWithFormdataBody(server string, body RegisterFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCreateCardsForDeckPageResponse(rsp)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRegisterRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// CreateCardForDeckWithBodyWithResponse request with arbitrary body returning *CreateCardForDeckResponse
-func (c *ClientWithResponses) CreateCardForDeckWithBodyWithResponse(ctx context.Context, deckId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardForDeckResponse, error) {
-	rsp, err := c.CreateCardForDeckWithBody(ctx, deckId, contentType, body, reqEditors...)
+// NewRegisterRequestWithBody generates requests for Register with any type of body
+func NewRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCardForDeckResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCardForDeckWithFormdataBodyWithResponse(ctx context.Context, deckId string, body CreateCardForDeckFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateCardForDeckResponse, error) {
-	rsp, err := c.CreateCardForDeckWithFormdataBody(ctx, deckId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateCardForDeckResponse(rsp)
-}
 
-// CreateDeckPageWithResponse request returning *CreateDeckPageResponse
-func (c *ClientWithResponses) CreateDeckPageWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*CreateDeckPageResponse, error) {
-	rsp, err := c.CreateDeckPage(ctx, groupId, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDeckPageResponse(rsp)
-}
 
-// CreateDeckWithBodyWithResponse request with arbitrary body returning *CreateDeckResponse
-func (c *ClientWithResponses) CreateDeckWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeckResponse, error) {
-	rsp, err := c.CreateDeckWithBody(ctx, groupId, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDeckResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateDeckWithResponse(ctx context.Context, groupId string, body CreateDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeckResponse, error) {
-	rsp, err := c.CreateDeck(ctx, groupId, body, reqEditors...)
+// NewGetCardInputRequest generates requests for GetCardInput
+func NewGetCardInputRequest(server string, cardNum int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "card-num", runtime.ParamLocationPath, cardNum)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDeckResponse(rsp)
-}
 
-// CreateGroupPageWithResponse request returning *CreateGroupPageResponse
-func (c *ClientWithResponses) CreateGroupPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateGroupPageResponse, error) {
-	rsp, err := c.CreateGroupPage(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateGroupPageResponse(rsp)
-}
 
-// CreateGroupWithBodyWithResponse request with arbitrary body returning *CreateGroupResponse
-func (c *ClientWithResponses) CreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
-	rsp, err := c.CreateGroupWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/secure/api/v1/card-input/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateGroupResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateGroupWithFormdataBodyWithResponse(ctx context.Context, body CreateGroupFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
-	rsp, err := c.CreateGroupWithFormdataBody(ctx, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateGroupResponse(rsp)
+
+	return req, nil
 }
 
-// FrontOfCardWithResponse request returning *FrontOfCardResponse
-func (c *ClientWithResponses) FrontOfCardWithResponse(ctx context.Context, deckId string, cardId string, reqEditors ...RequestEditorFn) (*FrontOfCardResponse, error) {
-	rsp, err := c.FrontOfCard(ctx, deckId, cardId, reqEditors...)
+// NewAddDeckRequest calls the generic AddDeck builder with application/json body
+func NewAddDeckRequest(server string, body AddDeckJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFrontOfCardResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewAddDeckRequestWithBody(server, "application/json", bodyReader)
 }
 
-// GroupPageWithResponse request returning *GroupPageResponse
-func (c *ClientWithResponses) GroupPageWithResponse(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*GroupPageResponse, error) {
-	rsp, err := c.GroupPage(ctx, groupID, reqEditors...)
+// NewAddDeckRequestWithBody generates requests for AddDeck with any type of body
+func NewAddDeckRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGroupPageResponse(rsp)
-}
 
-// HomePageWithResponse request returning *HomePageResponse
-func (c *ClientWithResponses) HomePageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HomePageResponse, error) {
-	rsp, err := c.HomePage(ctx, reqEditors...)
+	operationPath := fmt.Sprintf("/secure/api/v1/deck")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHomePageResponse(rsp)
-}
 
-// ViewDeckWithResponse request returning *ViewDeckResponse
-func (c *ClientWithResponses) ViewDeckWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*ViewDeckResponse, error) {
-	rsp, err := c.ViewDeck(ctx, deckId, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseViewDeckResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// VoteCardWithResponse request returning *VoteCardResponse
-func (c *ClientWithResponses) VoteCardWithResponse(ctx context.Context, cardId string, direction string, reqEditors ...RequestEditorFn) (*VoteCardResponse, error) {
-	rsp, err := c.VoteCard(ctx, cardId, direction, reqEditors...)
+// NewGetDecksForUserRequest generates requests for GetDecksForUser
+func NewGetDecksForUserRequest(server string, params *GetDecksForUserParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseVoteCardResponse(rsp)
-}
 
-// RegistrationPageWithResponse request returning *RegistrationPageResponse
-func (c *ClientWithResponses) RegistrationPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RegistrationPageResponse, error) {
-	rsp, err := c.RegistrationPage(ctx, reqEditors...)
+	operationPath := fmt.Sprintf("/secure/api/v1/decks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRegistrationPageResponse(rsp)
-}
 
-// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
-func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.RegisterWithBody(ctx, contentType, body, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRegisterResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) RegisterWithFormdataBodyWithResponse(ctx context.Context, body RegisterFormdataRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.RegisterWithFormdataBody(ctx, body, reqEditors...)
+// NewAddGroupRequest calls the generic AddGroup builder with application/json body
+func NewAddGroupRequest(server string, body AddGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRegisterResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewAddGroupRequestWithBody(server, "application/json", bodyReader)
 }
 
-// GetCardInputWithResponse request returning *GetCardInputResponse
-func (c *ClientWithResponses) GetCardInputWithResponse(ctx context.Context, cardNum int, reqEditors ...RequestEditorFn) (*GetCardInputResponse, error) {
-	rsp, err := c.GetCardInput(ctx, cardNum, reqEditors...)
+// NewAddGroupRequestWithBody generates requests for AddGroup with any type of body
+func NewAddGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCardInputResponse(rsp)
-}
 
-// AddDeckWithBodyWithResponse request with arbitrary body returning *AddDeckResponse
-func (c *ClientWithResponses) AddDeckWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDeckResponse, error) {
-	rsp, err := c.AddDeckWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/secure/api/v1/group")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddDeckResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddDeckWithResponse(ctx context.Context, body AddDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDeckResponse, error) {
-	rsp, err := c.AddDeck(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddDeckResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetDecksForUserWithResponse request returning *GetDecksForUserResponse
-func (c *ClientWithResponses) GetDecksForUserWithResponse(ctx context.Context, params *GetDecksForUserParams, reqEditors ...RequestEditorFn) (*GetDecksForUserResponse, error) {
-	rsp, err := c.GetDecksForUser(ctx, params, reqEditors...)
+// NewAddDeckToGroupRequest generates requests for AddDeckToGroup
+func NewAddDeckToGroupRequest(server string, groupId string, deckId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDecksForUserResponse(rsp)
-}
 
-// AddGroupWithBodyWithResponse request with arbitrary body returning *AddGroupResponse
-func (c *ClientWithResponses) AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error) {
-	rsp, err := c.AddGroupWithBody(ctx, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deck_id", runtime.ParamLocationPath, deckId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddGroupResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error) {
-	rsp, err := c.AddGroup(ctx, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddGroupResponse(rsp)
-}
 
-// AddDeckToGroupWithResponse request returning *AddDeckToGroupResponse
-func (c *ClientWithResponses) AddDeckToGroupWithResponse(ctx context.Context, groupId string, deckId string, reqEditors ...RequestEditorFn) (*AddDeckToGroupResponse, error) {
-	rsp, err := c.AddDeckToGroup(ctx, groupId, deckId, reqEditors...)
+	operationPath := fmt.Sprintf("/secure/api/v1/group/%s/deck/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddDeckToGroupResponse(rsp)
-}
 
-// GetGroupsWithResponse request returning *GetGroupsResponse
-func (c *ClientWithResponses) GetGroupsWithResponse(ctx context.Context, params *GetGroupsParams, reqEditors ...RequestEditorFn) (*GetGroupsResponse, error) {
-	rsp, err := c.GetGroups(ctx, params, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetGroupsResponse(rsp)
+
+	return req, nil
 }
 
-// ServeStylesWithResponse request returning *ServeStylesResponse
-func (c *ClientWithResponses) ServeStylesWithResponse(ctx context.Context, path string, styleName string, reqEditors ...RequestEditorFn) (*ServeStylesResponse, error) {
-	rsp, err := c.ServeStyles(ctx, path, styleName, reqEditors...)
+// NewGetGroupLeaderboardRequest generates requests for GetGroupLeaderboard
+func NewGetGroupLeaderboardRequest(server string, groupId string, params *GetGroupLeaderboardParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseServeStylesResponse(rsp)
-}
 
-// ParseGetFaviconResponse parses an HTTP response from a GetFaviconWithResponse call
-func ParseGetFaviconResponse(rsp *http.Response) (*GetFaviconResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFaviconResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/secure/api/v1/group/%s/leaderboard", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseLoginPageResponse parses an HTTP response from a LoginPageWithResponse call
-func ParseLoginPageResponse(rsp *http.Response) (*LoginPageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LoginPageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Window != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "window", runtime.ParamLocationQuery, *params.Window); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Metric != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "metric", runtime.ParamLocationQuery, *params.Metric); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
-func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetGroupsRequest generates requests for GetGroups
+func NewGetGroupsRequest(server string, params *GetGroupsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/secure/api/v1/groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponseBody
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON200 = &dest
 
-	}
+		if params.To != nil {
 
-	return response, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// ParseGetCardsForDeckResponse parses an HTTP response from a GetCardsForDeckWithResponse call
-func ParseGetCardsForDeckResponse(rsp *http.Response) (*GetCardsForDeckResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	response := &GetCardsForDeckResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseUpdateCardCorrectResponse parses an HTTP response from a UpdateCardCorrectWithResponse call
-func ParseUpdateCardCorrectResponse(rsp *http.Response) (*UpdateCardCorrectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewUploadMediaRequestWithBody generates requests for UploadMedia with any type of body
+func NewUploadMediaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCardCorrectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/secure/api/v1/media")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseUpdateCardIncorrectResponse parses an HTTP response from a UpdateCardIncorrectWithResponse call
-func ParseUpdateCardIncorrectResponse(rsp *http.Response) (*UpdateCardIncorrectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCardIncorrectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseBackOfCardResponse parses an HTTP response from a BackOfCardWithResponse call
-func ParseBackOfCardResponse(rsp *http.Response) (*BackOfCardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetSessionsRequest generates requests for GetSessions
+func NewGetSessionsRequest(server string, params *GetSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BackOfCardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/secure/api/v1/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseGetCreateCardsForDeckContentResponse parses an HTTP response from a GetCreateCardsForDeckContentWithResponse call
-func ParseGetCreateCardsForDeckContentResponse(rsp *http.Response) (*GetCreateCardsForDeckContentResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCreateCardsForDeckContentResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return response, nil
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// ParseGetCreateCardsForDeckPageResponse parses an HTTP response from a GetCreateCardsForDeckPageWithResponse call
-func ParseGetCreateCardsForDeckPageResponse(rsp *http.Response) (*GetCreateCardsForDeckPageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		if params.To != nil {
 
-	response := &GetCreateCardsForDeckPageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return response, nil
-}
+		}
 
-// ParseCreateCardForDeckResponse parses an HTTP response from a CreateCardForDeckWithResponse call
-func ParseCreateCardForDeckResponse(rsp *http.Response) (*CreateCardForDeckResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	response := &CreateCardForDeckResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	return response, nil
-}
+		if params.DeckId != nil {
 
-// ParseCreateDeckPageResponse parses an HTTP response from a CreateDeckPageWithResponse call
-func ParseCreateDeckPageResponse(rsp *http.Response) (*CreateDeckPageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deck_id", runtime.ParamLocationQuery, *params.DeckId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	response := &CreateDeckPageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		}
 
-	return response, nil
-}
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-// ParseCreateDeckResponse parses an HTTP response from a CreateDeckWithResponse call
-func ParseCreateDeckResponse(rsp *http.Response) (*CreateDeckResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDeckResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
+	return req, nil
 }
 
-// ParseCreateGroupPageResponse parses an HTTP response from a CreateGroupPageWithResponse call
-func ParseCreateGroupPageResponse(rsp *http.Response) (*CreateGroupPageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &CreateGroupPageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// NewServeStylesRequest generates requests for ServeStyles
+func NewServeStylesRequest(server string, path string, styleName string) (*http.Request, error) {
+	var err error
 
-	return response, nil
-}
+	var pathParam0 string
 
-// ParseCreateGroupResponse parses an HTTP response from a CreateGroupWithResponse call
-func ParseCreateGroupResponse(rsp *http.Response) (*CreateGroupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "path", runtime.ParamLocationPath, path)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateGroupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseFrontOfCardResponse parses an HTTP response from a FrontOfCardWithResponse call
-func ParseFrontOfCardResponse(rsp *http.Response) (*FrontOfCardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "style_name", runtime.ParamLocationPath, styleName)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FrontOfCardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseGroupPageResponse parses an HTTP response from a GroupPageWithResponse call
-func ParseGroupPageResponse(rsp *http.Response) (*GroupPageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GroupPageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/styles/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseHomePageResponse parses an HTTP response from a HomePageWithResponse call
-func ParseHomePageResponse(rsp *http.Response) (*HomePageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HomePageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseViewDeckResponse parses an HTTP response from a ViewDeckWithResponse call
-func ParseViewDeckResponse(rsp *http.Response) (*ViewDeckResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ViewDeckResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
+	return req, nil
 }
 
-// ParseVoteCardResponse parses an HTTP response from a VoteCardWithResponse call
-func ParseVoteCardResponse(rsp *http.Response) (*VoteCardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-
-	response := &VoteCardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return response, nil
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
 }
 
-// ParseRegistrationPageResponse parses an HTTP response from a RegistrationPageWithResponse call
-func ParseRegistrationPageResponse(rsp *http.Response) (*RegistrationPageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return &ClientWithResponses{client}, nil
+}
 
-	response := &RegistrationPageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
-
-	return response, nil
 }
 
-// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
-func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetFaviconWithResponse request
+	GetFaviconWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFaviconResponse, error)
 
-	response := &RegisterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// LoginPageWithResponse request
+	LoginPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LoginPageResponse, error)
 
-	return response, nil
-}
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
 
-// ParseGetCardInputResponse parses an HTTP response from a GetCardInputWithResponse call
-func ParseGetCardInputResponse(rsp *http.Response) (*GetCardInputResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	LoginWithFormdataBodyWithResponse(ctx context.Context, body LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
 
-	response := &GetCardInputResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// GetMediaWithResponse request
+	GetMediaWithResponse(ctx context.Context, mediaId string, reqEditors ...RequestEditorFn) (*GetMediaResponse, error)
 
-	return response, nil
-}
+	// GetCardsForDeckWithResponse request
+	GetCardsForDeckWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCardsForDeckResponse, error)
 
-// ParseAddDeckResponse parses an HTTP response from a AddDeckWithResponse call
-func ParseAddDeckResponse(rsp *http.Response) (*AddDeckResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// AnswerCardWithResponse request
+	AnswerCardWithResponse(ctx context.Context, sessionId string, grade AnswerCardParamsGrade, reqEditors ...RequestEditorFn) (*AnswerCardResponse, error)
 
-	response := &AddDeckResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// BackOfCardWithResponse request
+	BackOfCardWithResponse(ctx context.Context, deckId string, cardId string, params *BackOfCardParams, reqEditors ...RequestEditorFn) (*BackOfCardResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest UserError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// CardPreviewWithBodyWithResponse request with any body
+	CardPreviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CardPreviewResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ConflictError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	CardPreviewWithFormdataBodyWithResponse(ctx context.Context, body CardPreviewFormdataRequestBody, reqEditors ...RequestEditorFn) (*CardPreviewResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// CardHistoryPageWithResponse request
+	CardHistoryPageWithResponse(ctx context.Context, cardId string, reqEditors ...RequestEditorFn) (*CardHistoryPageResponse, error)
 
-	}
+	// AnswerCardChoiceWithBodyWithResponse request with any body
+	AnswerCardChoiceWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnswerCardChoiceResponse, error)
 
-	return response, nil
-}
+	AnswerCardChoiceWithFormdataBodyWithResponse(ctx context.Context, sessionId string, body AnswerCardChoiceFormdataRequestBody, reqEditors ...RequestEditorFn) (*AnswerCardChoiceResponse, error)
 
-// ParseGetDecksForUserResponse parses an HTTP response from a GetDecksForUserWithResponse call
-func ParseGetDecksForUserResponse(rsp *http.Response) (*GetDecksForUserResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// GetCreateCardsForDeckContentWithResponse request
+	GetCreateCardsForDeckContentWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCreateCardsForDeckContentResponse, error)
 
-	response := &GetDecksForUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// GetCreateCardsForDeckPageWithResponse request
+	GetCreateCardsForDeckPageWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCreateCardsForDeckPageResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetGroups
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// CreateCardForDeckWithBodyWithResponse request with any body
+	CreateCardForDeckWithBodyWithResponse(ctx context.Context, deckId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardForDeckResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest UserError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	CreateCardForDeckWithFormdataBodyWithResponse(ctx context.Context, deckId string, body CreateCardForDeckFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateCardForDeckResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// CreateDeckPageWithResponse request
+	CreateDeckPageWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*CreateDeckPageResponse, error)
 
-	}
+	// CreateDeckWithBodyWithResponse request with any body
+	CreateDeckWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeckResponse, error)
 
-	return response, nil
-}
+	CreateDeckWithResponse(ctx context.Context, groupId string, body CreateDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeckResponse, error)
 
-// ParseAddGroupResponse parses an HTTP response from a AddGroupWithResponse call
-func ParseAddGroupResponse(rsp *http.Response) (*AddGroupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// CreateGroupPageWithResponse request
+	CreateGroupPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateGroupPageResponse, error)
 
-	response := &AddGroupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// CreateGroupWithBodyWithResponse request with any body
+	CreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest UserError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	CreateGroupWithFormdataBodyWithResponse(ctx context.Context, body CreateGroupFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ConflictError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	// DeckSettingsPageWithResponse request
+	DeckSettingsPageWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*DeckSettingsPageResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// UpdateDeckSettingsWithBodyWithResponse request with any body
+	UpdateDeckSettingsWithBodyWithResponse(ctx context.Context, deckId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDeckSettingsResponse, error)
 
-	}
+	UpdateDeckSettingsWithFormdataBodyWithResponse(ctx context.Context, deckId string, body UpdateDeckSettingsFormdataRequestBody, reqEditors ...RequestEditorFn) (*UpdateDeckSettingsResponse, error)
 
-	return response, nil
-}
+	// DeckStatsPageWithResponse request
+	DeckStatsPageWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*DeckStatsPageResponse, error)
 
-// ParseAddDeckToGroupResponse parses an HTTP response from a AddDeckToGroupWithResponse call
-func ParseAddDeckToGroupResponse(rsp *http.Response) (*AddDeckToGroupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// EditCardPageWithResponse request
+	EditCardPageWithResponse(ctx context.Context, cardId string, reqEditors ...RequestEditorFn) (*EditCardPageResponse, error)
 
-	response := &AddDeckToGroupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// EditCardWithBodyWithResponse request with any body
+	EditCardWithBodyWithResponse(ctx context.Context, cardId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditCardResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest UserError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	EditCardWithFormdataBodyWithResponse(ctx context.Context, cardId string, body EditCardFormdataRequestBody, reqEditors ...RequestEditorFn) (*EditCardResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ConflictError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	// FrontOfCardWithResponse request
+	FrontOfCardWithResponse(ctx context.Context, deckId string, cardId string, params *FrontOfCardParams, reqEditors ...RequestEditorFn) (*FrontOfCardResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// GroupLeaderboardTabWithResponse request
+	GroupLeaderboardTabWithResponse(ctx context.Context, groupId string, params *GroupLeaderboardTabParams, reqEditors ...RequestEditorFn) (*GroupLeaderboardTabResponse, error)
 
-	}
+	// GroupPageWithResponse request
+	GroupPageWithResponse(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*GroupPageResponse, error)
 
-	return response, nil
-}
+	// HistoryPageWithResponse request
+	HistoryPageWithResponse(ctx context.Context, params *HistoryPageParams, reqEditors ...RequestEditorFn) (*HistoryPageResponse, error)
 
-// ParseGetGroupsResponse parses an HTTP response from a GetGroupsWithResponse call
-func ParseGetGroupsResponse(rsp *http.Response) (*GetGroupsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// HoldCardWithResponse request
+	HoldCardWithResponse(ctx context.Context, sessionId string, hold HoldCardParamsHold, reqEditors ...RequestEditorFn) (*HoldCardResponse, error)
 
-	response := &GetGroupsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// HomePageWithResponse request
+	HomePageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HomePageResponse, error)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetGroups
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// RetryMissedCardsWithResponse request
+	RetryMissedCardsWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*RetryMissedCardsResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest UserError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// ReviewPageWithResponse request
+	ReviewPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReviewPageResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// SessionSummaryPageWithResponse request
+	SessionSummaryPageWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*SessionSummaryPageResponse, error)
 
-	}
+	// StudySettingsPageWithResponse request
+	StudySettingsPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StudySettingsPageResponse, error)
 
-	return response, nil
-}
+	// UpdateStudySettingsWithBodyWithResponse request with any body
+	UpdateStudySettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateStudySettingsResponse, error)
 
-// ParseServeStylesResponse parses an HTTP response from a ServeStylesWithResponse call
-func ParseServeStylesResponse(rsp *http.Response) (*ServeStylesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	UpdateStudySettingsWithFormdataBodyWithResponse(ctx context.Context, body UpdateStudySettingsFormdataRequestBody, reqEditors ...RequestEditorFn) (*UpdateStudySettingsResponse, error)
 
-	response := &ServeStylesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// StatsPageWithResponse request
+	StatsPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StatsPageResponse, error)
 
-	return response, nil
-}
+	// StudyTagPageWithResponse request
+	StudyTagPageWithResponse(ctx context.Context, params *StudyTagPageParams, reqEditors ...RequestEditorFn) (*StudyTagPageResponse, error)
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// favicon image
-	// (GET /favicon.ico)
-	GetFavicon(w http.ResponseWriter, r *http.Request)
-	// serve login page
-	// (GET /login)
-	LoginPage(w http.ResponseWriter, r *http.Request)
-	// handles login
-	// (POST /login)
-	Login(w http.ResponseWriter, r *http.Request)
-	// card content for deck page
-	// (GET /page/add-card/{deck_id})
-	GetCardsForDeck(w http.ResponseWriter, r *http.Request, deckId string)
-	// handles updating card in session and returns next card in deck
-	// (POST /page/answered-correct/{session_id})
-	UpdateCardCorrect(w http.ResponseWriter, r *http.Request, sessionId string)
-	// handles updating card in session and returns next card in deck
-	// (POST /page/answered-incorrect/{session_id})
-	UpdateCardIncorrect(w http.ResponseWriter, r *http.Request, sessionId string)
-	// fetches back of card component
-	// (GET /page/back-of-card/{deck_id}/{card_id})
-	BackOfCard(w http.ResponseWriter, r *http.Request, deckId string, cardId string)
-	// create cards for deck page
-	// (GET /page/create-cards-content/{deck_id})
-	GetCreateCardsForDeckContent(w http.ResponseWriter, r *http.Request, deckId string)
-	// create cards for deck page
-	// (GET /page/create-cards/{deck_id})
-	GetCreateCardsForDeckPage(w http.ResponseWriter, r *http.Request, deckId string)
-	// handles form submit of create card on create deck page
-	// (POST /page/create-cards/{deck_id})
-	CreateCardForDeck(w http.ResponseWriter, r *http.Request, deckId string)
+	// StudySessionPageWithResponse request
+	StudySessionPageWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*StudySessionPageResponse, error)
+
+	// SuspendedCardsPageWithResponse request
+	SuspendedCardsPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SuspendedCardsPageResponse, error)
+
+	// AnswerCardTypedWithBodyWithResponse request with any body
+	AnswerCardTypedWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnswerCardTypedResponse, error)
+
+	AnswerCardTypedWithFormdataBodyWithResponse(ctx context.Context, sessionId string, body AnswerCardTypedFormdataRequestBody, reqEditors ...RequestEditorFn) (*AnswerCardTypedResponse, error)
+
+	// UnsuspendCardsWithBodyWithResponse request with any body
+	UnsuspendCardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnsuspendCardsResponse, error)
+
+	UnsuspendCardsWithFormdataBodyWithResponse(ctx context.Context, body UnsuspendCardsFormdataRequestBody, reqEditors ...RequestEditorFn) (*UnsuspendCardsResponse, error)
+
+	// ViewDeckWithResponse request
+	ViewDeckWithResponse(ctx context.Context, deckId string, params *ViewDeckParams, reqEditors ...RequestEditorFn) (*ViewDeckResponse, error)
+
+	// VoteCardWithResponse request
+	VoteCardWithResponse(ctx context.Context, cardId string, direction string, reqEditors ...RequestEditorFn) (*VoteCardResponse, error)
+
+	// RegistrationPageWithResponse request
+	RegistrationPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RegistrationPageResponse, error)
+
+	// RegisterWithBodyWithResponse request with any body
+	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	RegisterWithFormdataBodyWithResponse(ctx context.Context, body RegisterFormdataRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	// GetCardInputWithResponse request
+	GetCardInputWithResponse(ctx context.Context, cardNum int, reqEditors ...RequestEditorFn) (*GetCardInputResponse, error)
+
+	// AddDeckWithBodyWithResponse request with any body
+	AddDeckWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDeckResponse, error)
+
+	AddDeckWithResponse(ctx context.Context, body AddDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDeckResponse, error)
+
+	// GetDecksForUserWithResponse request
+	GetDecksForUserWithResponse(ctx context.Context, params *GetDecksForUserParams, reqEditors ...RequestEditorFn) (*GetDecksForUserResponse, error)
+
+	// AddGroupWithBodyWithResponse request with any body
+	AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+
+	AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+
+	// AddDeckToGroupWithResponse request
+	AddDeckToGroupWithResponse(ctx context.Context, groupId string, deckId string, reqEditors ...RequestEditorFn) (*AddDeckToGroupResponse, error)
+
+	// GetGroupLeaderboardWithResponse request
+	GetGroupLeaderboardWithResponse(ctx context.Context, groupId string, params *GetGroupLeaderboardParams, reqEditors ...RequestEditorFn) (*GetGroupLeaderboardResponse, error)
+
+	// GetGroupsWithResponse request
+	GetGroupsWithResponse(ctx context.Context, params *GetGroupsParams, reqEditors ...RequestEditorFn) (*GetGroupsResponse, error)
+
+	// UploadMediaWithBodyWithResponse request with any body
+	UploadMediaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadMediaResponse, error)
+
+	// GetSessionsWithResponse request
+	GetSessionsWithResponse(ctx context.Context, params *GetSessionsParams, reqEditors ...RequestEditorFn) (*GetSessionsResponse, error)
+
+	// ServeStylesWithResponse request
+	ServeStylesWithResponse(ctx context.Context, path string, styleName string, reqEditors ...RequestEditorFn) (*ServeStylesResponse, error)
+}
+
+type GetFaviconResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFaviconResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFaviconResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMediaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMediaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMediaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardsForDeckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardsForDeckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardsForDeckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AnswerCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AnswerCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnswerCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BackOfCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r BackOfCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BackOfCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CardPreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CardPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CardPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CardHistoryPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CardHistoryPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CardHistoryPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AnswerCardChoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AnswerCardChoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnswerCardChoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCreateCardsForDeckContentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCreateCardsForDeckContentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCreateCardsForDeckContentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCreateCardsForDeckPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCreateCardsForDeckPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCreateCardsForDeckPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCardForDeckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCardForDeckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCardForDeckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDeckPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDeckPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDeckPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDeckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDeckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDeckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateGroupPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateGroupPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateGroupPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckSettingsPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckSettingsPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckSettingsPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDeckSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDeckSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDeckSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckStatsPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckStatsPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckStatsPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditCardPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r EditCardPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditCardPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r EditCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FrontOfCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r FrontOfCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FrontOfCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GroupLeaderboardTabResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GroupLeaderboardTabResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GroupLeaderboardTabResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GroupPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GroupPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GroupPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HistoryPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HistoryPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoryPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HoldCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HoldCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HoldCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HomePageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HomePageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HomePageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetryMissedCardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RetryMissedCardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryMissedCardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReviewPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ReviewPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReviewPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SessionSummaryPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SessionSummaryPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SessionSummaryPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StudySettingsPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StudySettingsPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StudySettingsPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateStudySettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateStudySettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateStudySettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StatsPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StatsPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StatsPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StudyTagPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StudyTagPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StudyTagPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StudySessionPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StudySessionPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StudySessionPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SuspendedCardsPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SuspendedCardsPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SuspendedCardsPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AnswerCardTypedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AnswerCardTypedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnswerCardTypedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnsuspendCardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UnsuspendCardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnsuspendCardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ViewDeckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ViewDeckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ViewDeckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VoteCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r VoteCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VoteCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegistrationPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RegistrationPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegistrationPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardInputResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardInputResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardInputResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddDeckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *UserError
+	JSON409      *ConflictError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AddDeckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddDeckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDecksForUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetGroups
+	JSON400      *UserError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDecksForUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDecksForUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *UserError
+	JSON409      *ConflictError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AddGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddDeckToGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *UserError
+	JSON409      *ConflictError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AddDeckToGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddDeckToGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGroupLeaderboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetGroupLeaderboard
+	JSON400      *UserError
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGroupLeaderboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGroupLeaderboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetGroups
+	JSON400      *UserError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadMediaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UploadMedia
+	JSON400      *UserError
+	JSON413      *UserError
+	JSON415      *UserError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadMediaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadMediaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSessions
+	JSON400      *UserError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ServeStylesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ServeStylesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ServeStylesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetFaviconWithResponse request returning *GetFaviconResponse
+func (c *ClientWithResponses) GetFaviconWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFaviconResponse, error) {
+	rsp, err := c.GetFavicon(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFaviconResponse(rsp)
+}
+
+// LoginPageWithResponse request returning *LoginPageResponse
+func (c *ClientWithResponses) LoginPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LoginPageResponse, error) {
+	rsp, err := c.LoginPage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginPageResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithFormdataBodyWithResponse(ctx context.Context, body LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// GetMediaWithResponse request returning *GetMediaResponse
+func (c *ClientWithResponses) GetMediaWithResponse(ctx context.Context, mediaId string, reqEditors ...RequestEditorFn) (*GetMediaResponse, error) {
+	rsp, err := c.GetMedia(ctx, mediaId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMediaResponse(rsp)
+}
+
+// GetCardsForDeckWithResponse request returning *GetCardsForDeckResponse
+func (c *ClientWithResponses) GetCardsForDeckWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCardsForDeckResponse, error) {
+	rsp, err := c.GetCardsForDeck(ctx, deckId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardsForDeckResponse(rsp)
+}
+
+// AnswerCardWithResponse request returning *AnswerCardResponse
+func (c *ClientWithResponses) AnswerCardWithResponse(ctx context.Context, sessionId string, grade AnswerCardParamsGrade, reqEditors ...RequestEditorFn) (*AnswerCardResponse, error) {
+	rsp, err := c.AnswerCard(ctx, sessionId, grade, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerCardResponse(rsp)
+}
+
+// BackOfCardWithResponse request returning *BackOfCardResponse
+func (c *ClientWithResponses) BackOfCardWithResponse(ctx context.Context, deckId string, cardId string, params *BackOfCardParams, reqEditors ...RequestEditorFn) (*BackOfCardResponse, error) {
+	rsp, err := c.BackOfCard(ctx, deckId, cardId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBackOfCardResponse(rsp)
+}
+
+// CardPreviewWithBodyWithResponse request with arbitrary body returning *CardPreviewResponse
+func (c *ClientWithResponses) CardPreviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CardPreviewResponse, error) {
+	rsp, err := c.CardPreviewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCardPreviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) CardPreviewWithFormdataBodyWithResponse(ctx context.Context, body CardPreviewFormdataRequestBody, reqEditors ...RequestEditorFn) (*CardPreviewResponse, error) {
+	rsp, err := c.CardPreviewWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCardPreviewResponse(rsp)
+}
+
+// CardHistoryPageWithResponse request returning *CardHistoryPageResponse
+func (c *ClientWithResponses) CardHistoryPageWithResponse(ctx context.Context, cardId string, reqEditors ...RequestEditorFn) (*CardHistoryPageResponse, error) {
+	rsp, err := c.CardHistoryPage(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCardHistoryPageResponse(rsp)
+}
+
+// AnswerCardChoiceWithBodyWithResponse request with arbitrary body returning *AnswerCardChoiceResponse
+func (c *ClientWithResponses) AnswerCardChoiceWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnswerCardChoiceResponse, error) {
+	rsp, err := c.AnswerCardChoiceWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerCardChoiceResponse(rsp)
+}
+
+func (c *ClientWithResponses) AnswerCardChoiceWithFormdataBodyWithResponse(ctx context.Context, sessionId string, body AnswerCardChoiceFormdataRequestBody, reqEditors ...RequestEditorFn) (*AnswerCardChoiceResponse, error) {
+	rsp, err := c.AnswerCardChoiceWithFormdataBody(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerCardChoiceResponse(rsp)
+}
+
+// GetCreateCardsForDeckContentWithResponse request returning *GetCreateCardsForDeckContentResponse
+func (c *ClientWithResponses) GetCreateCardsForDeckContentWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCreateCardsForDeckContentResponse, error) {
+	rsp, err := c.GetCreateCardsForDeckContent(ctx, deckId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCreateCardsForDeckContentResponse(rsp)
+}
+
+// GetCreateCardsForDeckPageWithResponse request returning *GetCreateCardsForDeckPageResponse
+func (c *ClientWithResponses) GetCreateCardsForDeckPageWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*GetCreateCardsForDeckPageResponse, error) {
+	rsp, err := c.GetCreateCardsForDeckPage(ctx, deckId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCreateCardsForDeckPageResponse(rsp)
+}
+
+// CreateCardForDeckWithBodyWithResponse request with arbitrary body returning *CreateCardForDeckResponse
+func (c *ClientWithResponses) CreateCardForDeckWithBodyWithResponse(ctx context.Context, deckId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardForDeckResponse, error) {
+	rsp, err := c.CreateCardForDeckWithBody(ctx, deckId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardForDeckResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCardForDeckWithFormdataBodyWithResponse(ctx context.Context, deckId string, body CreateCardForDeckFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateCardForDeckResponse, error) {
+	rsp, err := c.CreateCardForDeckWithFormdataBody(ctx, deckId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardForDeckResponse(rsp)
+}
+
+// CreateDeckPageWithResponse request returning *CreateDeckPageResponse
+func (c *ClientWithResponses) CreateDeckPageWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*CreateDeckPageResponse, error) {
+	rsp, err := c.CreateDeckPage(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeckPageResponse(rsp)
+}
+
+// CreateDeckWithBodyWithResponse request with arbitrary body returning *CreateDeckResponse
+func (c *ClientWithResponses) CreateDeckWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeckResponse, error) {
+	rsp, err := c.CreateDeckWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeckResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDeckWithResponse(ctx context.Context, groupId string, body CreateDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeckResponse, error) {
+	rsp, err := c.CreateDeck(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeckResponse(rsp)
+}
+
+// CreateGroupPageWithResponse request returning *CreateGroupPageResponse
+func (c *ClientWithResponses) CreateGroupPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateGroupPageResponse, error) {
+	rsp, err := c.CreateGroupPage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGroupPageResponse(rsp)
+}
+
+// CreateGroupWithBodyWithResponse request with arbitrary body returning *CreateGroupResponse
+func (c *ClientWithResponses) CreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
+	rsp, err := c.CreateGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateGroupWithFormdataBodyWithResponse(ctx context.Context, body CreateGroupFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
+	rsp, err := c.CreateGroupWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGroupResponse(rsp)
+}
+
+// DeckSettingsPageWithResponse request returning *DeckSettingsPageResponse
+func (c *ClientWithResponses) DeckSettingsPageWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*DeckSettingsPageResponse, error) {
+	rsp, err := c.DeckSettingsPage(ctx, deckId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckSettingsPageResponse(rsp)
+}
+
+// UpdateDeckSettingsWithBodyWithResponse request with arbitrary body returning *UpdateDeckSettingsResponse
+func (c *ClientWithResponses) UpdateDeckSettingsWithBodyWithResponse(ctx context.Context, deckId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDeckSettingsResponse, error) {
+	rsp, err := c.UpdateDeckSettingsWithBody(ctx, deckId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDeckSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDeckSettingsWithFormdataBodyWithResponse(ctx context.Context, deckId string, body UpdateDeckSettingsFormdataRequestBody, reqEditors ...RequestEditorFn) (*UpdateDeckSettingsResponse, error) {
+	rsp, err := c.UpdateDeckSettingsWithFormdataBody(ctx, deckId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDeckSettingsResponse(rsp)
+}
+
+// DeckStatsPageWithResponse request returning *DeckStatsPageResponse
+func (c *ClientWithResponses) DeckStatsPageWithResponse(ctx context.Context, deckId string, reqEditors ...RequestEditorFn) (*DeckStatsPageResponse, error) {
+	rsp, err := c.DeckStatsPage(ctx, deckId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckStatsPageResponse(rsp)
+}
+
+// EditCardPageWithResponse request returning *EditCardPageResponse
+func (c *ClientWithResponses) EditCardPageWithResponse(ctx context.Context, cardId string, reqEditors ...RequestEditorFn) (*EditCardPageResponse, error) {
+	rsp, err := c.EditCardPage(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditCardPageResponse(rsp)
+}
+
+// EditCardWithBodyWithResponse request with arbitrary body returning *EditCardResponse
+func (c *ClientWithResponses) EditCardWithBodyWithResponse(ctx context.Context, cardId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditCardResponse, error) {
+	rsp, err := c.EditCardWithBody(ctx, cardId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditCardWithFormdataBodyWithResponse(ctx context.Context, cardId string, body EditCardFormdataRequestBody, reqEditors ...RequestEditorFn) (*EditCardResponse, error) {
+	rsp, err := c.EditCardWithFormdataBody(ctx, cardId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditCardResponse(rsp)
+}
+
+// FrontOfCardWithResponse request returning *FrontOfCardResponse
+func (c *ClientWithResponses) FrontOfCardWithResponse(ctx context.Context, deckId string, cardId string, params *FrontOfCardParams, reqEditors ...RequestEditorFn) (*FrontOfCardResponse, error) {
+	rsp, err := c.FrontOfCard(ctx, deckId, cardId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFrontOfCardResponse(rsp)
+}
+
+// GroupLeaderboardTabWithResponse request returning *GroupLeaderboardTabResponse
+func (c *ClientWithResponses) GroupLeaderboardTabWithResponse(ctx context.Context, groupId string, params *GroupLeaderboardTabParams, reqEditors ...RequestEditorFn) (*GroupLeaderboardTabResponse, error) {
+	rsp, err := c.GroupLeaderboardTab(ctx, groupId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupLeaderboardTabResponse(rsp)
+}
+
+// GroupPageWithResponse request returning *GroupPageResponse
+func (c *ClientWithResponses) GroupPageWithResponse(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*GroupPageResponse, error) {
+	rsp, err := c.GroupPage(ctx, groupID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupPageResponse(rsp)
+}
+
+// HistoryPageWithResponse request returning *HistoryPageResponse
+func (c *ClientWithResponses) HistoryPageWithResponse(ctx context.Context, params *HistoryPageParams, reqEditors ...RequestEditorFn) (*HistoryPageResponse, error) {
+	rsp, err := c.HistoryPage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoryPageResponse(rsp)
+}
+
+// HoldCardWithResponse request returning *HoldCardResponse
+func (c *ClientWithResponses) HoldCardWithResponse(ctx context.Context, sessionId string, hold HoldCardParamsHold, reqEditors ...RequestEditorFn) (*HoldCardResponse, error) {
+	rsp, err := c.HoldCard(ctx, sessionId, hold, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHoldCardResponse(rsp)
+}
+
+// HomePageWithResponse request returning *HomePageResponse
+func (c *ClientWithResponses) HomePageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HomePageResponse, error) {
+	rsp, err := c.HomePage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHomePageResponse(rsp)
+}
+
+// RetryMissedCardsWithResponse request returning *RetryMissedCardsResponse
+func (c *ClientWithResponses) RetryMissedCardsWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*RetryMissedCardsResponse, error) {
+	rsp, err := c.RetryMissedCards(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryMissedCardsResponse(rsp)
+}
+
+// ReviewPageWithResponse request returning *ReviewPageResponse
+func (c *ClientWithResponses) ReviewPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReviewPageResponse, error) {
+	rsp, err := c.ReviewPage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewPageResponse(rsp)
+}
+
+// SessionSummaryPageWithResponse request returning *SessionSummaryPageResponse
+func (c *ClientWithResponses) SessionSummaryPageWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*SessionSummaryPageResponse, error) {
+	rsp, err := c.SessionSummaryPage(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSessionSummaryPageResponse(rsp)
+}
+
+// StudySettingsPageWithResponse request returning *StudySettingsPageResponse
+func (c *ClientWithResponses) StudySettingsPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StudySettingsPageResponse, error) {
+	rsp, err := c.StudySettingsPage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStudySettingsPageResponse(rsp)
+}
+
+// UpdateStudySettingsWithBodyWithResponse request with arbitrary body returning *UpdateStudySettingsResponse
+func (c *ClientWithResponses) UpdateStudySettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateStudySettingsResponse, error) {
+	rsp, err := c.UpdateStudySettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateStudySettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateStudySettingsWithFormdataBodyWithResponse(ctx context.Context, body UpdateStudySettingsFormdataRequestBody, reqEditors ...RequestEditorFn) (*UpdateStudySettingsResponse, error) {
+	rsp, err := c.UpdateStudySettingsWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateStudySettingsResponse(rsp)
+}
+
+// StatsPageWithResponse request returning *StatsPageResponse
+func (c *ClientWithResponses) StatsPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StatsPageResponse, error) {
+	rsp, err := c.StatsPage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStatsPageResponse(rsp)
+}
+
+// StudyTagPageWithResponse request returning *StudyTagPageResponse
+func (c *ClientWithResponses) StudyTagPageWithResponse(ctx context.Context, params *StudyTagPageParams, reqEditors ...RequestEditorFn) (*StudyTagPageResponse, error) {
+	rsp, err := c.StudyTagPage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStudyTagPageResponse(rsp)
+}
+
+// StudySessionPageWithResponse request returning *StudySessionPageResponse
+func (c *ClientWithResponses) StudySessionPageWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*StudySessionPageResponse, error) {
+	rsp, err := c.StudySessionPage(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStudySessionPageResponse(rsp)
+}
+
+// SuspendedCardsPageWithResponse request returning *SuspendedCardsPageResponse
+func (c *ClientWithResponses) SuspendedCardsPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SuspendedCardsPageResponse, error) {
+	rsp, err := c.SuspendedCardsPage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSuspendedCardsPageResponse(rsp)
+}
+
+// AnswerCardTypedWithBodyWithResponse request with arbitrary body returning *AnswerCardTypedResponse
+func (c *ClientWithResponses) AnswerCardTypedWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnswerCardTypedResponse, error) {
+	rsp, err := c.AnswerCardTypedWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerCardTypedResponse(rsp)
+}
+
+func (c *ClientWithResponses) AnswerCardTypedWithFormdataBodyWithResponse(ctx context.Context, sessionId string, body AnswerCardTypedFormdataRequestBody, reqEditors ...RequestEditorFn) (*AnswerCardTypedResponse, error) {
+	rsp, err := c.AnswerCardTypedWithFormdataBody(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerCardTypedResponse(rsp)
+}
+
+// UnsuspendCardsWithBodyWithResponse request with arbitrary body returning *UnsuspendCardsResponse
+func (c *ClientWithResponses) UnsuspendCardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnsuspendCardsResponse, error) {
+	rsp, err := c.UnsuspendCardsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnsuspendCardsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UnsuspendCardsWithFormdataBodyWithResponse(ctx context.Context, body UnsuspendCardsFormdataRequestBody, reqEditors ...RequestEditorFn) (*UnsuspendCardsResponse, error) {
+	rsp, err := c.UnsuspendCardsWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnsuspendCardsResponse(rsp)
+}
+
+// ViewDeckWithResponse request returning *ViewDeckResponse
+func (c *ClientWithResponses) ViewDeckWithResponse(ctx context.Context, deckId string, params *ViewDeckParams, reqEditors ...RequestEditorFn) (*ViewDeckResponse, error) {
+	rsp, err := c.ViewDeck(ctx, deckId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseViewDeckResponse(rsp)
+}
+
+// VoteCardWithResponse request returning *VoteCardResponse
+func (c *ClientWithResponses) VoteCardWithResponse(ctx context.Context, cardId string, direction string, reqEditors ...RequestEditorFn) (*VoteCardResponse, error) {
+	rsp, err := c.VoteCard(ctx, cardId, direction, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVoteCardResponse(rsp)
+}
+
+// RegistrationPageWithResponse request returning *RegistrationPageResponse
+func (c *ClientWithResponses) RegistrationPageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RegistrationPageResponse, error) {
+	rsp, err := c.RegistrationPage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegistrationPageResponse(rsp)
+}
+
+// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
+func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.RegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterWithFormdataBodyWithResponse(ctx context.Context, body RegisterFormdataRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.RegisterWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+// GetCardInputWithResponse request returning *GetCardInputResponse
+func (c *ClientWithResponses) GetCardInputWithResponse(ctx context.Context, cardNum int, reqEditors ...RequestEditorFn) (*GetCardInputResponse, error) {
+	rsp, err := c.GetCardInput(ctx, cardNum, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardInputResponse(rsp)
+}
+
+// AddDeckWithBodyWithResponse request with arbitrary body returning *AddDeckResponse
+func (c *ClientWithResponses) AddDeckWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDeckResponse, error) {
+	rsp, err := c.AddDeckWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDeckResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddDeckWithResponse(ctx context.Context, body AddDeckJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDeckResponse, error) {
+	rsp, err := c.AddDeck(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDeckResponse(rsp)
+}
+
+// GetDecksForUserWithResponse request returning *GetDecksForUserResponse
+func (c *ClientWithResponses) GetDecksForUserWithResponse(ctx context.Context, params *GetDecksForUserParams, reqEditors ...RequestEditorFn) (*GetDecksForUserResponse, error) {
+	rsp, err := c.GetDecksForUser(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDecksForUserResponse(rsp)
+}
+
+// AddGroupWithBodyWithResponse request with arbitrary body returning *AddGroupResponse
+func (c *ClientWithResponses) AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error) {
+	rsp, err := c.AddGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error) {
+	rsp, err := c.AddGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddGroupResponse(rsp)
+}
+
+// AddDeckToGroupWithResponse request returning *AddDeckToGroupResponse
+func (c *ClientWithResponses) AddDeckToGroupWithResponse(ctx context.Context, groupId string, deckId string, reqEditors ...RequestEditorFn) (*AddDeckToGroupResponse, error) {
+	rsp, err := c.AddDeckToGroup(ctx, groupId, deckId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDeckToGroupResponse(rsp)
+}
+
+// GetGroupLeaderboardWithResponse request returning *GetGroupLeaderboardResponse
+func (c *ClientWithResponses) GetGroupLeaderboardWithResponse(ctx context.Context, groupId string, params *GetGroupLeaderboardParams, reqEditors ...RequestEditorFn) (*GetGroupLeaderboardResponse, error) {
+	rsp, err := c.GetGroupLeaderboard(ctx, groupId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGroupLeaderboardResponse(rsp)
+}
+
+// GetGroupsWithResponse request returning *GetGroupsResponse
+func (c *ClientWithResponses) GetGroupsWithResponse(ctx context.Context, params *GetGroupsParams, reqEditors ...RequestEditorFn) (*GetGroupsResponse, error) {
+	rsp, err := c.GetGroups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGroupsResponse(rsp)
+}
+
+// UploadMediaWithBodyWithResponse request with arbitrary body returning *UploadMediaResponse
+func (c *ClientWithResponses) UploadMediaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadMediaResponse, error) {
+	rsp, err := c.UploadMediaWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadMediaResponse(rsp)
+}
+
+// GetSessionsWithResponse request returning *GetSessionsResponse
+func (c *ClientWithResponses) GetSessionsWithResponse(ctx context.Context, params *GetSessionsParams, reqEditors ...RequestEditorFn) (*GetSessionsResponse, error) {
+	rsp, err := c.GetSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionsResponse(rsp)
+}
+
+// ServeStylesWithResponse request returning *ServeStylesResponse
+func (c *ClientWithResponses) ServeStylesWithResponse(ctx context.Context, path string, styleName string, reqEditors ...RequestEditorFn) (*ServeStylesResponse, error) {
+	rsp, err := c.ServeStyles(ctx, path, styleName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseServeStylesResponse(rsp)
+}
+
+// ParseGetFaviconResponse parses an HTTP response from a GetFaviconWithResponse call
+func ParseGetFaviconResponse(rsp *http.Response) (*GetFaviconResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFaviconResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseLoginPageResponse parses an HTTP response from a LoginPageWithResponse call
+func ParseLoginPageResponse(rsp *http.Response) (*LoginPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMediaResponse parses an HTTP response from a GetMediaWithResponse call
+func ParseGetMediaResponse(rsp *http.Response) (*GetMediaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMediaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetCardsForDeckResponse parses an HTTP response from a GetCardsForDeckWithResponse call
+func ParseGetCardsForDeckResponse(rsp *http.Response) (*GetCardsForDeckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCardsForDeckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAnswerCardResponse parses an HTTP response from a AnswerCardWithResponse call
+func ParseAnswerCardResponse(rsp *http.Response) (*AnswerCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnswerCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseBackOfCardResponse parses an HTTP response from a BackOfCardWithResponse call
+func ParseBackOfCardResponse(rsp *http.Response) (*BackOfCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BackOfCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCardPreviewResponse parses an HTTP response from a CardPreviewWithResponse call
+func ParseCardPreviewResponse(rsp *http.Response) (*CardPreviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CardPreviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCardHistoryPageResponse parses an HTTP response from a CardHistoryPageWithResponse call
+func ParseCardHistoryPageResponse(rsp *http.Response) (*CardHistoryPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CardHistoryPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAnswerCardChoiceResponse parses an HTTP response from a AnswerCardChoiceWithResponse call
+func ParseAnswerCardChoiceResponse(rsp *http.Response) (*AnswerCardChoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnswerCardChoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetCreateCardsForDeckContentResponse parses an HTTP response from a GetCreateCardsForDeckContentWithResponse call
+func ParseGetCreateCardsForDeckContentResponse(rsp *http.Response) (*GetCreateCardsForDeckContentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCreateCardsForDeckContentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetCreateCardsForDeckPageResponse parses an HTTP response from a GetCreateCardsForDeckPageWithResponse call
+func ParseGetCreateCardsForDeckPageResponse(rsp *http.Response) (*GetCreateCardsForDeckPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCreateCardsForDeckPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCreateCardForDeckResponse parses an HTTP response from a CreateCardForDeckWithResponse call
+func ParseCreateCardForDeckResponse(rsp *http.Response) (*CreateCardForDeckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCardForDeckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCreateDeckPageResponse parses an HTTP response from a CreateDeckPageWithResponse call
+func ParseCreateDeckPageResponse(rsp *http.Response) (*CreateDeckPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDeckPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCreateDeckResponse parses an HTTP response from a CreateDeckWithResponse call
+func ParseCreateDeckResponse(rsp *http.Response) (*CreateDeckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDeckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCreateGroupPageResponse parses an HTTP response from a CreateGroupPageWithResponse call
+func ParseCreateGroupPageResponse(rsp *http.Response) (*CreateGroupPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateGroupPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCreateGroupResponse parses an HTTP response from a CreateGroupWithResponse call
+func ParseCreateGroupResponse(rsp *http.Response) (*CreateGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeckSettingsPageResponse parses an HTTP response from a DeckSettingsPageWithResponse call
+func ParseDeckSettingsPageResponse(rsp *http.Response) (*DeckSettingsPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckSettingsPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDeckSettingsResponse parses an HTTP response from a UpdateDeckSettingsWithResponse call
+func ParseUpdateDeckSettingsResponse(rsp *http.Response) (*UpdateDeckSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDeckSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeckStatsPageResponse parses an HTTP response from a DeckStatsPageWithResponse call
+func ParseDeckStatsPageResponse(rsp *http.Response) (*DeckStatsPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckStatsPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseEditCardPageResponse parses an HTTP response from a EditCardPageWithResponse call
+func ParseEditCardPageResponse(rsp *http.Response) (*EditCardPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditCardPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseEditCardResponse parses an HTTP response from a EditCardWithResponse call
+func ParseEditCardResponse(rsp *http.Response) (*EditCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseFrontOfCardResponse parses an HTTP response from a FrontOfCardWithResponse call
+func ParseFrontOfCardResponse(rsp *http.Response) (*FrontOfCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FrontOfCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGroupLeaderboardTabResponse parses an HTTP response from a GroupLeaderboardTabWithResponse call
+func ParseGroupLeaderboardTabResponse(rsp *http.Response) (*GroupLeaderboardTabResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GroupLeaderboardTabResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGroupPageResponse parses an HTTP response from a GroupPageWithResponse call
+func ParseGroupPageResponse(rsp *http.Response) (*GroupPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GroupPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseHistoryPageResponse parses an HTTP response from a HistoryPageWithResponse call
+func ParseHistoryPageResponse(rsp *http.Response) (*HistoryPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HistoryPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseHoldCardResponse parses an HTTP response from a HoldCardWithResponse call
+func ParseHoldCardResponse(rsp *http.Response) (*HoldCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HoldCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseHomePageResponse parses an HTTP response from a HomePageWithResponse call
+func ParseHomePageResponse(rsp *http.Response) (*HomePageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HomePageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRetryMissedCardsResponse parses an HTTP response from a RetryMissedCardsWithResponse call
+func ParseRetryMissedCardsResponse(rsp *http.Response) (*RetryMissedCardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetryMissedCardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseReviewPageResponse parses an HTTP response from a ReviewPageWithResponse call
+func ParseReviewPageResponse(rsp *http.Response) (*ReviewPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReviewPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSessionSummaryPageResponse parses an HTTP response from a SessionSummaryPageWithResponse call
+func ParseSessionSummaryPageResponse(rsp *http.Response) (*SessionSummaryPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SessionSummaryPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseStudySettingsPageResponse parses an HTTP response from a StudySettingsPageWithResponse call
+func ParseStudySettingsPageResponse(rsp *http.Response) (*StudySettingsPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StudySettingsPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUpdateStudySettingsResponse parses an HTTP response from a UpdateStudySettingsWithResponse call
+func ParseUpdateStudySettingsResponse(rsp *http.Response) (*UpdateStudySettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateStudySettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseStatsPageResponse parses an HTTP response from a StatsPageWithResponse call
+func ParseStatsPageResponse(rsp *http.Response) (*StatsPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StatsPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseStudyTagPageResponse parses an HTTP response from a StudyTagPageWithResponse call
+func ParseStudyTagPageResponse(rsp *http.Response) (*StudyTagPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StudyTagPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseStudySessionPageResponse parses an HTTP response from a StudySessionPageWithResponse call
+func ParseStudySessionPageResponse(rsp *http.Response) (*StudySessionPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StudySessionPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSuspendedCardsPageResponse parses an HTTP response from a SuspendedCardsPageWithResponse call
+func ParseSuspendedCardsPageResponse(rsp *http.Response) (*SuspendedCardsPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SuspendedCardsPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAnswerCardTypedResponse parses an HTTP response from a AnswerCardTypedWithResponse call
+func ParseAnswerCardTypedResponse(rsp *http.Response) (*AnswerCardTypedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnswerCardTypedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUnsuspendCardsResponse parses an HTTP response from a UnsuspendCardsWithResponse call
+func ParseUnsuspendCardsResponse(rsp *http.Response) (*UnsuspendCardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnsuspendCardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseViewDeckResponse parses an HTTP response from a ViewDeckWithResponse call
+func ParseViewDeckResponse(rsp *http.Response) (*ViewDeckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ViewDeckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseVoteCardResponse parses an HTTP response from a VoteCardWithResponse call
+func ParseVoteCardResponse(rsp *http.Response) (*VoteCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VoteCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRegistrationPageResponse parses an HTTP response from a RegistrationPageWithResponse call
+func ParseRegistrationPageResponse(rsp *http.Response) (*RegistrationPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegistrationPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
+func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetCardInputResponse parses an HTTP response from a GetCardInputWithResponse call
+func ParseGetCardInputResponse(rsp *http.Response) (*GetCardInputResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCardInputResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAddDeckResponse parses an HTTP response from a AddDeckWithResponse call
+func ParseAddDeckResponse(rsp *http.Response) (*AddDeckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddDeckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDecksForUserResponse parses an HTTP response from a GetDecksForUserWithResponse call
+func ParseGetDecksForUserResponse(rsp *http.Response) (*GetDecksForUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDecksForUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetGroups
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddGroupResponse parses an HTTP response from a AddGroupWithResponse call
+func ParseAddGroupResponse(rsp *http.Response) (*AddGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddDeckToGroupResponse parses an HTTP response from a AddDeckToGroupWithResponse call
+func ParseAddDeckToGroupResponse(rsp *http.Response) (*AddDeckToGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddDeckToGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGroupLeaderboardResponse parses an HTTP response from a GetGroupLeaderboardWithResponse call
+func ParseGetGroupLeaderboardResponse(rsp *http.Response) (*GetGroupLeaderboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGroupLeaderboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetGroupLeaderboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGroupsResponse parses an HTTP response from a GetGroupsWithResponse call
+func ParseGetGroupsResponse(rsp *http.Response) (*GetGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetGroups
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadMediaResponse parses an HTTP response from a UploadMediaWithResponse call
+func ParseUploadMediaResponse(rsp *http.Response) (*UploadMediaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadMediaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UploadMedia
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionsResponse parses an HTTP response from a GetSessionsWithResponse call
+func ParseGetSessionsResponse(rsp *http.Response) (*GetSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSessions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseServeStylesResponse parses an HTTP response from a ServeStylesWithResponse call
+func ParseServeStylesResponse(rsp *http.Response) (*ServeStylesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ServeStylesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// favicon image
+	// (GET /favicon.ico)
+	GetFavicon(w http.ResponseWriter, r *http.Request)
+	// serve login page
+	// (GET /login)
+	LoginPage(w http.ResponseWriter, r *http.Request)
+	// handles login
+	// (POST /login)
+	Login(w http.ResponseWriter, r *http.Request)
+	// serve a card attachment
+	// (GET /media/{media_id})
+	GetMedia(w http.ResponseWriter, r *http.Request, mediaId string)
+	// card content for deck page
+	// (GET /page/add-card/{deck_id})
+	GetCardsForDeck(w http.ResponseWriter, r *http.Request, deckId string)
+	// handles grading the current card in session and returns next card in deck
+	// (POST /page/answer/{session_id}/{grade})
+	AnswerCard(w http.ResponseWriter, r *http.Request, sessionId string, grade AnswerCardParamsGrade)
+	// fetches back of card component
+	// (GET /page/back-of-card/{deck_id}/{card_id})
+	BackOfCard(w http.ResponseWriter, r *http.Request, deckId string, cardId string, params BackOfCardParams)
+	// previews a card being written
+	// (POST /page/card-preview)
+	CardPreview(w http.ResponseWriter, r *http.Request)
+	// serve the user's answer history for a card
+	// (GET /page/card/{card_id})
+	CardHistoryPage(w http.ResponseWriter, r *http.Request, cardId string)
+	// handles grading the options picked for the current multiple choice card in session
+	// (POST /page/choice-answer/{session_id})
+	AnswerCardChoice(w http.ResponseWriter, r *http.Request, sessionId string)
+	// create cards for deck page
+	// (GET /page/create-cards-content/{deck_id})
+	GetCreateCardsForDeckContent(w http.ResponseWriter, r *http.Request, deckId string)
+	// create cards for deck page
+	// (GET /page/create-cards/{deck_id})
+	GetCreateCardsForDeckPage(w http.ResponseWriter, r *http.Request, deckId string)
+	// handles form submit of create card on create deck page
+	// (POST /page/create-cards/{deck_id})
+	CreateCardForDeck(w http.ResponseWriter, r *http.Request, deckId string)
 	// serve create deck page
 	// (GET /page/create-deck/{group_id})
 	CreateDeckPage(w http.ResponseWriter, r *http.Request, groupId string)
@@ -3641,18 +6995,75 @@ type ServerInterface interface {
 	// handles form submit of create group page
 	// (POST /page/create-group)
 	CreateGroup(w http.ResponseWriter, r *http.Request)
+	// serve deck settings page
+	// (GET /page/deck-settings/{deck_id})
+	DeckSettingsPage(w http.ResponseWriter, r *http.Request, deckId string)
+	// handles form submit of deck settings page
+	// (POST /page/deck-settings/{deck_id})
+	UpdateDeckSettings(w http.ResponseWriter, r *http.Request, deckId string)
+	// serve card stats of a deck to its owner
+	// (GET /page/deck-stats/{deck_id})
+	DeckStatsPage(w http.ResponseWriter, r *http.Request, deckId string)
+	// serve the form editing a card
+	// (GET /page/edit-card/{card_id})
+	EditCardPage(w http.ResponseWriter, r *http.Request, cardId string)
+	// handles form submit of the card edit page
+	// (POST /page/edit-card/{card_id})
+	EditCard(w http.ResponseWriter, r *http.Request, cardId string)
 	// fetches front of card component
 	// (GET /page/front-of-card/{deck_id}/{card_id})
-	FrontOfCard(w http.ResponseWriter, r *http.Request, deckId string, cardId string)
+	FrontOfCard(w http.ResponseWriter, r *http.Request, deckId string, cardId string, params FrontOfCardParams)
+	// serve the leaderboard tab of a group page
+	// (GET /page/group-leaderboard/{group_id})
+	GroupLeaderboardTab(w http.ResponseWriter, r *http.Request, groupId string, params GroupLeaderboardTabParams)
 	// serve create group page
 	// (GET /page/group/{groupID})
 	GroupPage(w http.ResponseWriter, r *http.Request, groupID string)
+	// serve the user's study session history
+	// (GET /page/history)
+	HistoryPage(w http.ResponseWriter, r *http.Request, params HistoryPageParams)
+	// suspends or buries the current card in session and returns the next card
+	// (POST /page/hold-card/{session_id}/{hold})
+	HoldCard(w http.ResponseWriter, r *http.Request, sessionId string, hold HoldCardParamsHold)
 	// serve home page
 	// (GET /page/home)
 	HomePage(w http.ResponseWriter, r *http.Request)
+	// start a session over the cards missed in a finished session
+	// (POST /page/retry/{session_id})
+	RetryMissedCards(w http.ResponseWriter, r *http.Request, sessionId string)
+	// serve review page for cards due today
+	// (GET /page/review)
+	ReviewPage(w http.ResponseWriter, r *http.Request)
+	// serve summary of a finished study session
+	// (GET /page/session-summary/{session_id})
+	SessionSummaryPage(w http.ResponseWriter, r *http.Request, sessionId string)
+	// serve study settings page
+	// (GET /page/settings)
+	StudySettingsPage(w http.ResponseWriter, r *http.Request)
+	// handles form submit of study settings page
+	// (POST /page/settings)
+	UpdateStudySettings(w http.ResponseWriter, r *http.Request)
+	// serve the user's learning stats
+	// (GET /page/stats)
+	StatsPage(w http.ResponseWriter, r *http.Request)
+	// serve a study session over tagged cards
+	// (GET /page/study-tag)
+	StudyTagPage(w http.ResponseWriter, r *http.Request, params StudyTagPageParams)
+	// serve the current card of a study session
+	// (GET /page/study/{session_id})
+	StudySessionPage(w http.ResponseWriter, r *http.Request, sessionId string)
+	// serve the cards the user has suspended
+	// (GET /page/suspended)
+	SuspendedCardsPage(w http.ResponseWriter, r *http.Request)
+	// handles grading an answer typed for the current card in session
+	// (POST /page/typed-answer/{session_id})
+	AnswerCardTyped(w http.ResponseWriter, r *http.Request, sessionId string)
+	// unsuspend cards
+	// (POST /page/unsuspend)
+	UnsuspendCards(w http.ResponseWriter, r *http.Request)
 	// fetches view deck page
 	// (GET /page/view-deck/{deck_id})
-	ViewDeck(w http.ResponseWriter, r *http.Request, deckId string)
+	ViewDeck(w http.ResponseWriter, r *http.Request, deckId string, params ViewDeckParams)
 	// Handles card voting from User
 	// (PUT /page/vote-card/{card_id}/{direction})
 	VoteCard(w http.ResponseWriter, r *http.Request, cardId string, direction string)
@@ -3677,29 +7088,436 @@ type ServerInterface interface {
 	// request to add deck to group
 	// (PUT /secure/api/v1/group/{group_id}/deck/{deck_id})
 	AddDeckToGroup(w http.ResponseWriter, r *http.Request, groupId string, deckId string)
+	// Get Group Leaderboard
+	// (GET /secure/api/v1/group/{group_id}/leaderboard)
+	GetGroupLeaderboard(w http.ResponseWriter, r *http.Request, groupId string, params GetGroupLeaderboardParams)
 	// Get Groups
 	// (GET /secure/api/v1/groups)
 	GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams)
+	// Upload Media
+	// (POST /secure/api/v1/media)
+	UploadMedia(w http.ResponseWriter, r *http.Request)
+	// Get Sessions
+	// (GET /secure/api/v1/sessions)
+	GetSessions(w http.ResponseWriter, r *http.Request, params GetSessionsParams)
 	// serve css
 	// (GET /styles/{path}/{style_name})
 	ServeStyles(w http.ResponseWriter, r *http.Request, path string, styleName string)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetFavicon operation middleware
+func (siw *ServerInterfaceWrapper) GetFavicon(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFavicon(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// LoginPage operation middleware
+func (siw *ServerInterfaceWrapper) LoginPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoginPage(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMedia operation middleware
+func (siw *ServerInterfaceWrapper) GetMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "media_id" -------------
+	var mediaId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "media_id", mux.Vars(r)["media_id"], &mediaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "media_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMedia(w, r, mediaId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCardsForDeck operation middleware
+func (siw *ServerInterfaceWrapper) GetCardsForDeck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "deck_id" -------------
+	var deckId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCardsForDeck(w, r, deckId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AnswerCard operation middleware
+func (siw *ServerInterfaceWrapper) AnswerCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "grade" -------------
+	var grade AnswerCardParamsGrade
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grade", mux.Vars(r)["grade"], &grade, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grade", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnswerCard(w, r, sessionId, grade)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// BackOfCard operation middleware
+func (siw *ServerInterfaceWrapper) BackOfCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "deck_id" -------------
+	var deckId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "card_id" -------------
+	var cardId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "card_id", mux.Vars(r)["card_id"], &cardId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "card_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BackOfCardParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", r.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reversed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reversed", r.URL.Query(), &params.Reversed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reversed", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BackOfCard(w, r, deckId, cardId, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CardPreview operation middleware
+func (siw *ServerInterfaceWrapper) CardPreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CardPreview(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CardHistoryPage operation middleware
+func (siw *ServerInterfaceWrapper) CardHistoryPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "card_id" -------------
+	var cardId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "card_id", mux.Vars(r)["card_id"], &cardId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "card_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CardHistoryPage(w, r, cardId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AnswerCardChoice operation middleware
+func (siw *ServerInterfaceWrapper) AnswerCardChoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnswerCardChoice(w, r, sessionId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCreateCardsForDeckContent operation middleware
+func (siw *ServerInterfaceWrapper) GetCreateCardsForDeckContent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "deck_id" -------------
+	var deckId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCreateCardsForDeckContent(w, r, deckId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCreateCardsForDeckPage operation middleware
+func (siw *ServerInterfaceWrapper) GetCreateCardsForDeckPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "deck_id" -------------
+	var deckId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCreateCardsForDeckPage(w, r, deckId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateCardForDeck operation middleware
+func (siw *ServerInterfaceWrapper) CreateCardForDeck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "deck_id" -------------
+	var deckId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCardForDeck(w, r, deckId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateDeckPage operation middleware
+func (siw *ServerInterfaceWrapper) CreateDeckPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", mux.Vars(r)["group_id"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDeckPage(w, r, groupId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateDeck operation middleware
+func (siw *ServerInterfaceWrapper) CreateDeck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", mux.Vars(r)["group_id"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDeck(w, r, groupId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// CreateGroupPage operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroupPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// GetFavicon operation middleware
-func (siw *ServerInterfaceWrapper) GetFavicon(w http.ResponseWriter, r *http.Request) {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGroupPage(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFavicon(w, r)
+		siw.Handler.CreateGroup(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3709,12 +7527,23 @@ func (siw *ServerInterfaceWrapper) GetFavicon(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// LoginPage operation middleware
-func (siw *ServerInterfaceWrapper) LoginPage(w http.ResponseWriter, r *http.Request) {
+// DeckSettingsPage operation middleware
+func (siw *ServerInterfaceWrapper) DeckSettingsPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "deck_id" -------------
+	var deckId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LoginPage(w, r)
+		siw.Handler.DeckSettingsPage(w, r, deckId)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3724,12 +7553,23 @@ func (siw *ServerInterfaceWrapper) LoginPage(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+// UpdateDeckSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDeckSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "deck_id" -------------
+	var deckId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
+		siw.Handler.UpdateDeckSettings(w, r, deckId)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3739,8 +7579,8 @@ func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCardsForDeck operation middleware
-func (siw *ServerInterfaceWrapper) GetCardsForDeck(w http.ResponseWriter, r *http.Request) {
+// DeckStatsPage operation middleware
+func (siw *ServerInterfaceWrapper) DeckStatsPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -3755,7 +7595,7 @@ func (siw *ServerInterfaceWrapper) GetCardsForDeck(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCardsForDeck(w, r, deckId)
+		siw.Handler.DeckStatsPage(w, r, deckId)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3765,23 +7605,23 @@ func (siw *ServerInterfaceWrapper) GetCardsForDeck(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateCardCorrect operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCardCorrect(w http.ResponseWriter, r *http.Request) {
+// EditCardPage operation middleware
+func (siw *ServerInterfaceWrapper) EditCardPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "session_id" -------------
-	var sessionId string
+	// ------------- Path parameter "card_id" -------------
+	var cardId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	err = runtime.BindStyledParameterWithOptions("simple", "card_id", mux.Vars(r)["card_id"], &cardId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "card_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCardCorrect(w, r, sessionId)
+		siw.Handler.EditCardPage(w, r, cardId)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3791,23 +7631,23 @@ func (siw *ServerInterfaceWrapper) UpdateCardCorrect(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateCardIncorrect operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCardIncorrect(w http.ResponseWriter, r *http.Request) {
+// EditCard operation middleware
+func (siw *ServerInterfaceWrapper) EditCard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "session_id" -------------
-	var sessionId string
+	// ------------- Path parameter "card_id" -------------
+	var cardId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	err = runtime.BindStyledParameterWithOptions("simple", "card_id", mux.Vars(r)["card_id"], &cardId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "card_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCardIncorrect(w, r, sessionId)
+		siw.Handler.EditCard(w, r, cardId)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3817,8 +7657,8 @@ func (siw *ServerInterfaceWrapper) UpdateCardIncorrect(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// BackOfCard operation middleware
-func (siw *ServerInterfaceWrapper) BackOfCard(w http.ResponseWriter, r *http.Request) {
+// FrontOfCard operation middleware
+func (siw *ServerInterfaceWrapper) FrontOfCard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -3841,8 +7681,27 @@ func (siw *ServerInterfaceWrapper) BackOfCard(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FrontOfCardParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", r.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reversed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reversed", r.URL.Query(), &params.Reversed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reversed", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.BackOfCard(w, r, deckId, cardId)
+		siw.Handler.FrontOfCard(w, r, deckId, cardId, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3852,23 +7711,42 @@ func (siw *ServerInterfaceWrapper) BackOfCard(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCreateCardsForDeckContent operation middleware
-func (siw *ServerInterfaceWrapper) GetCreateCardsForDeckContent(w http.ResponseWriter, r *http.Request) {
+// GroupLeaderboardTab operation middleware
+func (siw *ServerInterfaceWrapper) GroupLeaderboardTab(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "deck_id" -------------
-	var deckId string
+	// ------------- Path parameter "group_id" -------------
+	var groupId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", mux.Vars(r)["group_id"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GroupLeaderboardTabParams
+
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", r.URL.Query(), &params.Window)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", r.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metric", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCreateCardsForDeckContent(w, r, deckId)
+		siw.Handler.GroupLeaderboardTab(w, r, groupId, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3878,23 +7756,23 @@ func (siw *ServerInterfaceWrapper) GetCreateCardsForDeckContent(w http.ResponseW
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCreateCardsForDeckPage operation middleware
-func (siw *ServerInterfaceWrapper) GetCreateCardsForDeckPage(w http.ResponseWriter, r *http.Request) {
+// GroupPage operation middleware
+func (siw *ServerInterfaceWrapper) GroupPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "deck_id" -------------
-	var deckId string
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", mux.Vars(r)["groupID"], &groupID, runtime.BindStyledParameterOptions{Explode: false, Required: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCreateCardsForDeckPage(w, r, deckId)
+		siw.Handler.GroupPage(w, r, groupID)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3904,23 +7782,33 @@ func (siw *ServerInterfaceWrapper) GetCreateCardsForDeckPage(w http.ResponseWrit
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateCardForDeck operation middleware
-func (siw *ServerInterfaceWrapper) CreateCardForDeck(w http.ResponseWriter, r *http.Request) {
+// HistoryPage operation middleware
+func (siw *ServerInterfaceWrapper) HistoryPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "deck_id" -------------
-	var deckId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HistoryPageParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	// ------------- Optional query parameter "deck_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deck_id", r.URL.Query(), &params.DeckId)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
 		return
 	}
 
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCardForDeck(w, r, deckId)
+		siw.Handler.HistoryPage(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3930,23 +7818,114 @@ func (siw *ServerInterfaceWrapper) CreateCardForDeck(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateDeckPage operation middleware
-func (siw *ServerInterfaceWrapper) CreateDeckPage(w http.ResponseWriter, r *http.Request) {
+// HoldCard operation middleware
+func (siw *ServerInterfaceWrapper) HoldCard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "group_id" -------------
-	var groupId string
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "group_id", mux.Vars(r)["group_id"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "hold" -------------
+	var hold HoldCardParamsHold
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hold", mux.Vars(r)["hold"], &hold, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hold", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDeckPage(w, r, groupId)
+		siw.Handler.HoldCard(w, r, sessionId, hold)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HomePage operation middleware
+func (siw *ServerInterfaceWrapper) HomePage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HomePage(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RetryMissedCards operation middleware
+func (siw *ServerInterfaceWrapper) RetryMissedCards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryMissedCards(w, r, sessionId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReviewPage operation middleware
+func (siw *ServerInterfaceWrapper) ReviewPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReviewPage(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SessionSummaryPage operation middleware
+func (siw *ServerInterfaceWrapper) SessionSummaryPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SessionSummaryPage(w, r, sessionId)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3956,23 +7935,27 @@ func (siw *ServerInterfaceWrapper) CreateDeckPage(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateDeck operation middleware
-func (siw *ServerInterfaceWrapper) CreateDeck(w http.ResponseWriter, r *http.Request) {
+// StudySettingsPage operation middleware
+func (siw *ServerInterfaceWrapper) StudySettingsPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "group_id" -------------
-	var groupId string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StudySettingsPage(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "group_id", mux.Vars(r)["group_id"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateStudySettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStudySettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDeck(w, r, groupId)
+		siw.Handler.UpdateStudySettings(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3982,12 +7965,12 @@ func (siw *ServerInterfaceWrapper) CreateDeck(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateGroupPage operation middleware
-func (siw *ServerInterfaceWrapper) CreateGroupPage(w http.ResponseWriter, r *http.Request) {
+// StatsPage operation middleware
+func (siw *ServerInterfaceWrapper) StatsPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGroupPage(w, r)
+		siw.Handler.StatsPage(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3997,12 +7980,32 @@ func (siw *ServerInterfaceWrapper) CreateGroupPage(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateGroup operation middleware
-func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
+// StudyTagPage operation middleware
+func (siw *ServerInterfaceWrapper) StudyTagPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StudyTagPageParams
+
+	// ------------- Required query parameter "tag" -------------
+
+	if paramValue := r.URL.Query().Get("tag"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tag"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGroup(w, r)
+		siw.Handler.StudyTagPage(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -4012,32 +8015,38 @@ func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// FrontOfCard operation middleware
-func (siw *ServerInterfaceWrapper) FrontOfCard(w http.ResponseWriter, r *http.Request) {
+// StudySessionPage operation middleware
+func (siw *ServerInterfaceWrapper) StudySessionPage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "deck_id" -------------
-	var deckId string
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "deck_id", mux.Vars(r)["deck_id"], &deckId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "card_id" -------------
-	var cardId string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StudySessionPage(w, r, sessionId)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "card_id", mux.Vars(r)["card_id"], &cardId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "card_id", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SuspendedCardsPage operation middleware
+func (siw *ServerInterfaceWrapper) SuspendedCardsPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FrontOfCard(w, r, deckId, cardId)
+		siw.Handler.SuspendedCardsPage(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -4047,23 +8056,23 @@ func (siw *ServerInterfaceWrapper) FrontOfCard(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GroupPage operation middleware
-func (siw *ServerInterfaceWrapper) GroupPage(w http.ResponseWriter, r *http.Request) {
+// AnswerCardTyped operation middleware
+func (siw *ServerInterfaceWrapper) AnswerCardTyped(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID string
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", mux.Vars(r)["groupID"], &groupID, runtime.BindStyledParameterOptions{Explode: false, Required: false})
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GroupPage(w, r, groupID)
+		siw.Handler.AnswerCardTyped(w, r, sessionId)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -4073,12 +8082,12 @@ func (siw *ServerInterfaceWrapper) GroupPage(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HomePage operation middleware
-func (siw *ServerInterfaceWrapper) HomePage(w http.ResponseWriter, r *http.Request) {
+// UnsuspendCards operation middleware
+func (siw *ServerInterfaceWrapper) UnsuspendCards(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HomePage(w, r)
+		siw.Handler.UnsuspendCards(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -4103,8 +8112,43 @@ func (siw *ServerInterfaceWrapper) ViewDeck(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ViewDeckParams
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "direction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "direction", r.URL.Query(), &params.Direction)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "direction", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ViewDeck(w, r, deckId)
+		siw.Handler.ViewDeck(w, r, deckId, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -4343,6 +8387,53 @@ func (siw *ServerInterfaceWrapper) AddDeckToGroup(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetGroupLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetGroupLeaderboard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", mux.Vars(r)["group_id"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_authScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupLeaderboardParams
+
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", r.URL.Query(), &params.Window)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", r.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metric", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGroupLeaderboard(w, r, groupId, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetGroups operation middleware
 func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -4418,6 +8509,106 @@ func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// UploadMedia operation middleware
+func (siw *ServerInterfaceWrapper) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Jwt_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadMedia(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Jwt_authScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSessionsParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "limit" -------------
+
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "offset" -------------
+
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deck_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deck_id", r.URL.Query(), &params.DeckId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deck_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSessions(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ServeStyles operation middleware
 func (siw *ServerInterfaceWrapper) ServeStyles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -4572,14 +8763,20 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/login", wrapper.Login).Methods("POST")
 
-	r.HandleFunc(options.BaseURL+"/page/add-card/{deck_id}", wrapper.GetCardsForDeck).Methods("GET")
+	r.HandleFunc(options.BaseURL+"/media/{media_id}", wrapper.GetMedia).Methods("GET")
 
-	r.HandleFunc(options.BaseURL+"/page/answered-correct/{session_id}", wrapper.UpdateCardCorrect).Methods("POST")
+	r.HandleFunc(options.BaseURL+"/page/add-card/{deck_id}", wrapper.GetCardsForDeck).Methods("GET")
 
-	r.HandleFunc(options.BaseURL+"/page/answered-incorrect/{session_id}", wrapper.UpdateCardIncorrect).Methods("POST")
+	r.HandleFunc(options.BaseURL+"/page/answer/{session_id}/{grade}", wrapper.AnswerCard).Methods("POST")
 
 	r.HandleFunc(options.BaseURL+"/page/back-of-card/{deck_id}/{card_id}", wrapper.BackOfCard).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/page/card-preview", wrapper.CardPreview).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/page/card/{card_id}", wrapper.CardHistoryPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/choice-answer/{session_id}", wrapper.AnswerCardChoice).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/page/create-cards-content/{deck_id}", wrapper.GetCreateCardsForDeckContent).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/page/create-cards/{deck_id}", wrapper.GetCreateCardsForDeckPage).Methods("GET")
@@ -4594,12 +8791,50 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/page/create-group", wrapper.CreateGroup).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/page/deck-settings/{deck_id}", wrapper.DeckSettingsPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/deck-settings/{deck_id}", wrapper.UpdateDeckSettings).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/page/deck-stats/{deck_id}", wrapper.DeckStatsPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/edit-card/{card_id}", wrapper.EditCardPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/edit-card/{card_id}", wrapper.EditCard).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/page/front-of-card/{deck_id}/{card_id}", wrapper.FrontOfCard).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/page/group-leaderboard/{group_id}", wrapper.GroupLeaderboardTab).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/page/group/{groupID}", wrapper.GroupPage).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/page/history", wrapper.HistoryPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/hold-card/{session_id}/{hold}", wrapper.HoldCard).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/page/home", wrapper.HomePage).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/page/retry/{session_id}", wrapper.RetryMissedCards).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/page/review", wrapper.ReviewPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/session-summary/{session_id}", wrapper.SessionSummaryPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/settings", wrapper.StudySettingsPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/settings", wrapper.UpdateStudySettings).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/page/stats", wrapper.StatsPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/study-tag", wrapper.StudyTagPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/study/{session_id}", wrapper.StudySessionPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/suspended", wrapper.SuspendedCardsPage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/page/typed-answer/{session_id}", wrapper.AnswerCardTyped).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/page/unsuspend", wrapper.UnsuspendCards).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/page/view-deck/{deck_id}", wrapper.ViewDeck).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/page/vote-card/{card_id}/{direction}", wrapper.VoteCard).Methods("PUT")
@@ -4618,8 +8853,14 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/secure/api/v1/group/{group_id}/deck/{deck_id}", wrapper.AddDeckToGroup).Methods("PUT")
 
+	r.HandleFunc(options.BaseURL+"/secure/api/v1/group/{group_id}/leaderboard", wrapper.GetGroupLeaderboard).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/secure/api/v1/groups", wrapper.GetGroups).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/secure/api/v1/media", wrapper.UploadMedia).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/secure/api/v1/sessions", wrapper.GetSessions).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/styles/{path}/{style_name}", wrapper.ServeStyles).Methods("GET")
 
 	return r
@@ -4628,50 +8869,104 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbbW/byBH+Kwu2QFtAMp22Aa76lnMuiYtrEzjOXYHAMFbkSNqY3OXtLi0Lhv57MbPL",
-	"N3FpU7Kd5HL5JonkzrPz8szscHQbJSovlARpTTS7jTT8VoKxP6pUAP3wIk1fQnJ15n7HXxIlLUj6yIsi",
-	"Ewm3Qsn4k1ESfzPJCnKOn/6sYRHNoj/FjYjYXTUxrvlfnkO03W4nUQom0aLAdaJZhYHNVbphC6UZT1Mh",
-	"lyyF5CraThDSa63K4rEx0aL7glriQ4jqRAO3cMJ1elbrcHMHtpvper2eLpTOp6XOQCYqhXQ82JagUXAT",
-	"hIeAE65TttAqJ32ygi+hgd8y9T3wP5+5HbK2xZ9Ys4288ZoF7wgTui40CrS6hO0k+lkthfwsyEnSKMyZ",
-	"WjIhQ2jPYCmMBf1ZAFfCRmHWdLMmwX3kW/zFFEqaDm/tYLdwY+Mi42If31VJmYO0py/DMJ3QBqcpkwSM",
-	"WZQZerKLMl1FasNeXx4ZeWwb2omSi0wk9ietlX602KfV3s4/QRJkqrMKJiIUi3i9AsnsCjT8xTDONBhV",
-	"6gQY3AhjzS7TumcDXkr6XNk86wK1mwKiWWSsFnJ5JxxciwuJTPTmf9NzLZZLdNQuU34W8W2CIb5ma2FX",
-	"zFhuS9NjyK8D0muwaKBTWZT2PSS41JNAwmQmUAgzTooXTsowe/mwsJCbUTXCr8Ku0P60Uw+Wa803Iazv",
-	"m6CrI1JRNJDHN1i3k+hUWtCSZ+9BX4P+isJQslLCTQGJhZQBrsQUXWaGoEZNnhv0v8Ohd1Z+7x65awt2",
-	"xW3lrYZZdQWSiQUrDWi24obNASTjpV2BtIgI0qjOfC6/PGUYEbqVysEFjli0eBlxfDBfg+k5m/O0Sg5M",
-	"GJbzFNh8Q0ZHTUa4kJeAANoF6ew2KrQqQFt/isA4nc65y8c7qpq4qwutvIJ3L2MGnYo0pOU6/JTfXYcM",
-	"+ziWdYkfXKypKT62br0ISKlqi51tkuz0ktNGsCzCT1HKLUytyCGa9HcX3NgkkmGQk6gs0j1l7GxMpJFf",
-	"ftIG3Fl5aMuV6rrbRgNdylFabW4NimgKmtDW237cAwFVyHSd+wWbawELT1o5GENBJ1MKJblEbhOedj2Z",
-	"uXuPokkENzwvMgRRMTNz1MxcgAbM6SWEgKRgucggrVG4G+aIAqNKAzdKUhTi1xGoXoR4OUlKrSHtEjRb",
-	"r0QGrNAKmaaRSPF6FNqIy+UnKg3s5XwF7M35+Tuf8BmW/TVuB+OvcLQ8mrDnx8d/62B+fnxcC8MdLj2T",
-	"tJ2kJXri7dooNuQ3A9H+TYfj6zaVBVhuZEC27h2U0tQ7mIay7O0imn0cUSdF20mIKszoauulbwHs1Fh9",
-	"SjEB8BdVTdJXUMGNWSsdNjUmt2Hd9TQUKk56Ajkl+EsqRYJC4aYQGsylaF+u42MS0ZOX7vdRsOqj9X6b",
-	"1/AA3bStUt85aQR2lu8bDEkHklILuyE9Orif1vYSazX8PAeuQb+qguzfv55HvgbBddzVJuBW1voOjpAL",
-	"1WexMyisZi/enbIq71S9BSss8Wt9RzSJrkEb99yzo+OjY9SGKkDyQkSz6B9Hz46Oaat2RajjBb8WiZJH",
-	"IiHJS7B9AEuwhvkbmciR3WhRV4OeptEMjzKv3A3RToPj78fHB1ampOgyz7neRLOoKx+vxVkVNUHUGmyp",
-	"pWEoyZWwvqNEDaUufAqOd25jT4KespsTXrcyC2UCsFdcphkYfy8SdV3dcpn6s1lq3EGWs09rG96Nbz21",
-	"emMhBuv00eNeC3AbVkd4JX9f3D9gdXXR2aGzJKok5mk6xRI7vqXqS6TbQdvCNUjLUi2uQTbHVepy4kmb",
-	"zBNwUaz9zSulia8xCjTPwYI2lCfQlygyqkQ3izyQKnqDlr54IpehnfhVaGutPnijM2nWoCGdJkprSGx8",
-	"a7BuUrJS391ORrm77rYLyfzT3tVcAEm4sfUNqVNdV7UfqARA7Z44GKOU2yC9X797OvJAL77vzs/ud+eh",
-	"rlXYqQ/UaMCiQn4lNj2tgHy36sFWnfPkaqoWO/wW3+L3O5muk8WIBYQpMr5BMLgoUwuS2bPfjzy5ers4",
-	"cZceh+kmwSf9Fr4IRy7AJiswHU2w2t4t/bsTC6nfTL3YEXmmaRlTIu69oDR4eg2GD2abuv9f5ZwTv9+v",
-	"PvW4trnb4VDqaav086vSF2u/Xz3eV/+Rlkw5z4Ulz26WQkX5r816u0prNPYk9c5BzL07dHBQdTnwVi3M",
-	"23sqsefceCm+db2HvVi6dm3OJKzDft28oAs7M88ytf4pL+zmF56V4F4gT0JmqwB+ESd3R5u+Lu9z8PYT",
-	"9xxzNJgys3cocJRzj9fSQd69O5PyAO/uvbTdx7vvcOdl1X88yImryZGQEaiQevoDdO997mg3c488xM9e",
-	"twZnnqZsPX7ssjXsIG3t1R5Cr7UevUKlVQdL1Fd49Q9To3Z0ESxSyTA+3Zy+3D5yoLZDdCRZnr788hkl",
-	"6K0r5Xq7ezT+6JFdnbxROTw9a9Uv0Vs7uBaw9uXFvaVz5UFvzv/zs3str6HQYJAbGHeEj+tRU7m7wV8E",
-	"rH8X3a5qj7iPYAq7Vv6w0VBSfJsK7cZpXF+kDCjvZMXlEvMARh0u4oZKPxjQR+ysPrqv3TU/nXPUV6Ry",
-	"RecoRY6hmzBR1Rv6IkZ4U+VMrytP4U5bzha69b5mj+jbGYrs6rY90fL00diGMrKG6DxyTw0R5Jn6LdcB",
-	"5UNo0vWg2mFwcGig52Vgx27kAPTqC2JeiPj6WUwjMTTY5sJyKsv8/sRFROZDjdyDdOrm41qT1RiVdHYe",
-	"6uXT2N7oiERsobBqXu9fHKLWoRHCrlYrh1kCsrfVAq6h3mBHF6SGkK7TapYn6Ks8TY0jziW9FeEMt86E",
-	"bE9Q0IxtT5vVDPIBzrnzt4uDWrGV+O0k+ucYhTeDZ/TEv0ZUzZ2B4e0kej5GTmjKMWxUq6pqpT7sD9jP",
-	"DMbGmXcKg5wkJLeQskwYqhjpSTYHu6aJQG/hFAVqzG4uaNy4WyBUaCbildIf3PWdaOnCoEWtYsZybVmm",
-	"1BUem7TCyKG4+q0EvWkCy1/qTrm3g2zcqMkQDJCpBzEg36ro4dJkmc9Bo6Zp3AMFO6py2a8SGZKfiVzY",
-	"cQoQ1PntzxQNoKmN4E6l5i4rqMXCwMNgHMp9zVTw/sH7SGH4GrAOzjIfJ346ic03zQDoTijWjZA7uNQd",
-	"PA4h04M7Bbv/GDuUTquppm+DT/1f1was2GrPxv3zVPBIQOb15yarkE/J1CXNG4pAueEz1LmqLPtIzcbJ",
-	"Ix7D/jCeUf9Zyap7XOOwZOsevSPbhlKsZ8HvyfV7cv02kqsfcCQnbkYbP17gVjqZl1UYKQjtJgMT3yKh",
-	"beNb+kpDu8NnQp5g8kY+dgdP7TqaxjC32ArA9hmZ8L6nG8ZNpdRIDiBk/+3B/ZjEmEP6oMa46VA3Ie+2",
-	"WOrMT47O4jhTCc9WytjZD8c/PIuj7cX2/wEAAP//Qt78rzA/AAA=",
+	"H4sIAAAAAAAC/+w9f4/bNpZfhdAdkDvAHk+u7WFv9q9smiY5bHeLJN09YBEMaOnZZkcmvSQ1jjuY7354",
+	"jxRFSZQtezyTtJu/MrFE8vH9/kXqLsvVeqMkSGuyq7tMwz8rMPZPqhBAP7woiu8hv3nnfsdfciUtSPqT",
+	"bzalyLkVSs5+MUribyZfwZrjX/+uYZFdZf82a5aYuadmhnP+ha8hu7+/n2QFmFyLDc6TXdUwsLkqdmyh",
+	"NONFIeSSFZDfZPcTBOm1VtXm3DDRpMcCtcRBCNVLroufNNwK2L4LSNztAe7TdLvdThdKr6eVLkHmqoBi",
+	"PLTReqPg3bh3EWbOcq6LbEIvCY2rWl0BbmKlRA4vpNmCfppdRAuO2oZdAVP01LCNyG+gcNRg66q0YlMC",
+	"y2nK4S1q4BYQe09GJr9QhttzYHJtZzRlwS0/dbLDyMpxr0hxRAZbaLUmIWIbvoQs4CKS7wO4eDoZd5DF",
+	"Yv7IZGrWG49Z8NKf4DLc+3uwiHzzJBuIFxy1A2IEE0ZMsleFsE8mFvVio0CFQthIb91Psj+rpZBPAiit",
+	"NArKUi2ZkClmeAdLYewTadR6sVEwa3pZ08IpyN/bqtg9KR+3Vhy1B4MjWpz8YbeB4gmNWLTeOF3HLI5g",
+	"3A1J4P1naSqzAfk0whhWG22B/ftQkEQGS2yVpwZf8rQo/LwpFS9+hELw4a0dtJEbrTagrXdRF6IE+lfp",
+	"NbfZVTYXkutdNskQzdlVZqwWcknmtwHoH27cx/CWmv8C+Ti7WtEuSCVJxq3l+WqNsPc2TCuajZKm5U13",
+	"9mvhk51tSkTZeH2v8grXfPt9GmK3aCQlVZ6DMYuqRFPrtL+uXYnGp/78kJFJjUF7qeSiFLl9pbXSZ3NO",
+	"aLa/DpL8XQ0mQigWs+0KJHK+hmeGcabBqErnwOCTMNZkHb/SjU1wNuFzZddlG9Aunw6Dg3NxIZH13vzf",
+	"9IMWyyXoriv3JMvHHhA5lGwr7IoZy21lei7clwHSayCn463cVPY95DjVo4CE3rbARZhxq/jFCRl/Bl6A",
+	"niuui7NxczxnArL3jYgF+XPqjvg7BVkEsDkKTGFhbUaF2n8XdoUMS6Tx2OVa890DtlCT+T0YgzHi+UH3",
+	"M7+DXOniPJAHYO8n2VtpQUtevgd9C/oL0nmSVRI+bSC3UDDAmZiSzhkgUCO3fFDYH8Dg8czv3ZB9W7Ar",
+	"bmvVYJhVNyCZWLDKgGYrbtgcQDJe2RVIixABkfIvyv6gKll8VoxH1kUYJhWyCcIUAgnnrj+mTiXsrdQa",
+	"nBYVi8hIZ20v7myo8j6hk6qjpSiGCAE0X4LscDbnRe3KIDHXvAA235HUICuSS+pXQADinF7PzUWrMp3z",
+	"/CZBy4l7utBK2sTj+56T6/KVUQa1vRYuM13X9G1vVaz5EgzDOKYqhEKn37nA+BfuDAcztaC/fRYuaNaD",
+	"PnpXn04esm//2P16l4Gs1uj4z7kReVbn4kq4dinDbJLlpfoV/6U9Xqs8LyvUzFGUEE2ttEZc9hEkC/gE",
+	"hglplc9VBnS4MSGDqRbDmcuAs4MYQmd+Korku4Sak0lJo89IyzU3N9MViOUqgTf3O64GCAK+i//hkhGc",
+	"LJCDIJkwIdkGdA4NhPTeM8P8CsfgkCAr+RzKPmD0M1uJogC0ggXokRBuVyJfoeDX6HtmmqD/SNi2orCr",
+	"Pmz08zlw5uY/GqxPCXTBwjIolvAZwdr1wbJqczaoTmCwMPmUJunDVwi+1Hy9B6JsMkbmlJ+wt3/41AhX",
+	"o5bOoH8sX5rEenxpYtUxYQY2XHMLBdrAXK3XnHSP2fAcTDJd07dacb2oZ7aarY8FPrlGVAvoLbEMRcKk",
+	"mY3zS82rHxOr1HmgjpGntYtrblsatuAWplasIUXwAc0v00BOsmpTHLlGZ2OiyPz0kxjg1sxDW65R1942",
+	"WrBrOQqrzatDS4TscW+ZEiBfTe1Kg1mpskiYILVlay53bMFFWWlAl+0GfOGBcUYT+BxuCVxL0uQ9DKNL",
+	"V1SlY9H+U5c8ndJkkJAc/4LPrbZWY5yMyY7NIUf/vJ4jZXu5XoKdarAga7EYIWFR+i4FfCjc7PdP2xuq",
+	"PUJ0iI3Iaw1zwJlrz9FzRSbMJ6OlsuBmJ/dtcPZxamq/lqptuoZNyXOgUTSDsBhXjlNicSzRQyPUYUsb",
+	"zBdsrgUsfOS9BmMoMpMFhTMuEy187sBH5O7di2ySwSe+3pQIRJ1eYC6/wAiUFLL8CilACrBclFAEKNwL",
+	"c4QC8aGBGyVDrWAEVC9SyYU8r7SGop1lQPSXwDZa5WBMsyLFMRdJWaTs30tVJPbyYQXszYcPP/kUIctV",
+	"AQFuB8Z/wMXyYsK+u7z8zxbM311ehsVwh0sfzcWqKlp64unaIDalvQZszu/aKLyODWrC1o40C9G7g6s0",
+	"Cceru4yX5V8X2dU/RiQqs/tJymCZ0TnD731XQ8/36Bo2kwD+IybW2jnjDnuo9aZqaNeJD3wSj61hPQdt",
+	"njHjs41sCxpYyQ0myippoeVf7mUukFYLGL//CPxX0updyol0BBxg2zVYLfLko62QhdqOZRDiUj8kTDtp",
+	"obDZXoqReltJkCPkBbr6YZL5eOJ670uay5uBJ5QbMumHxmrgN3ucmoLvDAY1nGm1ZdWGonxV8F3EIJQd",
+	"xSKugIJ+Jrw9M1QxNJOaVXCaZtAzw5BV2K9KRvwSgVYZ0OPkmPYeDWj23GRc+mgMm0+SjFo4enTacGO2",
+	"SqcZbj/A6SU66enegpwymNeUjE4uCp82QoO5FjJNYBp57X4fBVacU03wKaVFh+YbNCFG/Noutwtp//vb",
+	"NNl1eZjiJJItYPwabnyKoqHH5TiiangAzWOQI+4MM7amTwHdLhylQ6ABlO8LjyZZUbmKwLWBXMliQDss",
+	"hBRmdR4v4kbI9ANZra8frv+M5fpYf8cqy8triphSk6Z4rsZ4jN/W4m2ktddo7zWlkXpkSTJFq92p7wEp",
+	"Xk5rbHcNO7ekggsuyh3DN51yRiVNgSPGCUJWNh0e0tQuRtxjMrozhdIFWwOnyprv+KGsUsF3E3ZJHrRU",
+	"BFKSVGINUzIVvXXfvvjLC4aEqCOyYFbCws+Ms2NcQ2SMJlihWmF0/KpCDM5+4lqMDMfi9q2+0g6/71cJ",
+	"/r0UjZv2qmTM7IX+9IwVSgzklRZ2R6bHTf3L1l5jgRP/ngPXoH+oBel///4h83UnnMc9bXC1stY3wQq5",
+	"UH0ivYON1ezFT29ZnSuo+wetsCXEb2ST7Ba0ceOeX1xeXLoMJUi+EdlV9s3F84tL0qJ2RVDPFvxW5Epe",
+	"iJxWTnLnEqxh/kWXKM1oUidvb4vsKnsN9gf3QtZpwfqvy8sTy6WE6Gq95uj1Ze318dmsrB2NJNQabKWl",
+	"YbiSq6v6rlEhe+CTP/GT29ijQE/RtFs8dINvlEmpAi6LEox/F5VxqGhyWfhibGFcqw1nv2xteje+OS7q",
+	"+EtFDK3zJ7Nem+99Gh3pmfx7s35XQhsXrR06SlK9bHZH/1yL4n6QqOR2rk2Usa9raU1b4B+jvw2TgGmM",
+	"fMXlEibMKJfOy7lkc2A5x8whMcZSqSLF1q7GjSKj+RosaENBLDIeiVEdhV9lNfS9tsTJHj75eJDh4hq6",
+	"yi3YqUNCm/8ON2Mior+5/LaPU7sKiGgQh1U0Y0VZMkoLSdKs36aGyxj3ji3tShgmiiwlA3VuNwxxHIBC",
+	"MeNFMcWnszvvKwwzAtziaoUWtyCbDgUkJE1PApqgJqZSzQ9KU4ZgDFEbp+UhNDxNadBO/CzNGYJN0H8O",
+	"Z2QIZ3c+zYBIm90tNS+AkJdWMpqcYsPQ79hCWbpsrCO0Q+CWG6Yh5yUmHp3iceoU35Twyb8m6mQhLd7D",
+	"uLPyL12C+DCymz3sxfckOZj23BpX9x/U3dErB4eXdOBml2g0OCM1JwlBC6ijdDqX2Fb6DgpBbQq+C8Cj",
+	"gXlOYCGnVD/AnAG1g+OmKEFCkEZTHcVntUJGDNYp3hYzCBmWjlmhxQbuJFFgS+poUYuOOM/uvAd2P85s",
+	"E9MLsyn5DgGr6xq+4tDmtT/x/Oavi9G8Nkaw04zmt3BoZMdwefyFsodo0j5C/pEVsOBVaU3NA971rofV",
+	"SfLC6S2C6p8V6F0D1njh6WUs7Qp0EjJCuFWuL2VgXY0G1kBy1blSJXD5iDpyATZfgWmxBgtuScSQ+GC6",
+	"iZq9BhSjRGmKmnGQ4Zt6WnOwz65AaPYj1zeF2spQJ9yqqizQtTAr/NmVTyhkQ/C7PBs3oJ3grw2cgb1/",
+	"JFx77JkaD3NAodxqYS3IDqqPlfRSGCqrITPtXBgaQl9n4nx+1Hf6ODejUYnCksnCyBQpFjUA3SoXkfcx",
+	"/0YYq/TO+/2HVUYj+Of07x4SUESawiGJrdyefBXbnd9rCEPdHNOEyzAsEWRWnUD4U0d1Mx2ZVWNjXHf6",
+	"7SasdjSE7TkR9TRrrnHWMFSzrVZyuceXeFm3D57No/h4ivANnN1+LOlLWenEyezYeKc6jSJjHnMGVRDJ",
+	"WpupB3WEF94c+qBAtXcG2mA12dusvi8eTvDUHvlLj6Mv3jEnyKN+kZRjHqP06VE5Wql9qXg8lB8hLJlq",
+	"vhbUpRJNhYjy/23m6+n/gLFHiQaP1SXJSxJOyr4MnItL65Ijkdhjbnw0u6tLvUc49YG1OZOwTfN1c8Qu",
+	"zcy8LNX21Xpjd3/jZQW1LU6GhqEW/bksdR+Xhxg8HnEgDajBVKXdg8BRzD0eSydxd/faiwdwd+/Y5THc",
+	"vYedl3U/0ElM7EaniUB9LY+fYO6dyBzNZm7IQ/jsdXQ3xwns0bt75AH80T8EewyDxNgLHEJnPupLD0aY",
+	"8z6zYAYamQVzbtxxIUbavnW1n8+IW2t/E9bcsWDrnpMDPGj4rQ8tajToZijF2zRbnxFpSGuNLvZ+pi64",
+	"GIef1cAP3U/z2MFCh8NTxOmwuOX2aP6ug3dkbRfA1+3TmKkM4TvVrJ1zsQjZrAkTMi8rd7vDLWh0g0M+",
+	"34p1InYnZCKcvyGxoG0TciPGtooJ/GErQUeEgELY6SlJlPjyHsRvfQSFNxlntWh6uOMGbsqe0HlJzG71",
+	"UF63of/GcyUkDv3rjQ5pJxzh717xyshl2U1NQaTmBXvVTNzgVQMmyMB4wajZH0chES4GUf24aD5Sf6Uu",
+	"q+rqrm8uv0nxZ4QqSiLxJcT9/77VcZyFrgcRRbr6yx29PHfNIZyDSBYdfsCnX6sO/6pVhxZzJMsO5ExO",
+	"y6aL+ehwGTt0a4VeNwf75nJ/tl3Ul3KpRestJJthCvsfOAtN2J3kUecikg98fr5gcZLqc1twHShMDWUO",
+	"+AlTkhTDFuBmwtZK4jlbzXhZtvkUnw8wRdjjUYzIqfCKaI7wGsDxNY8J43hAhuc7RnegYfdzG66mbzoF",
+	"Wmh9/5zmL2JDZvncuQbJcId+9Kz69vv7M8fEcTQ8ktXefv/5kzdJTPmCy3GOcqRr65bXcE5kgogDY9lC",
+	"aGObUhdKea40kAdSN7v2ULu3ptWGTMlyRxCFpUOh0nXw7FH7I+1Ye0FZ0YkHtWgWtIqZG7EZWEUtFgZs",
+	"a5G4Cz7RA/909bb6GkTfkeG5IOILVdbNTK3WHPx9T7ntBmBj+h0Yqgr+T9tGmwmrpBUlVtiEYZVsrgpU",
+	"2j+yaq20VltXGl2rEHR74JUkJsM1XKyGHCD6eZ43qiyeppcHkZRs5fG7yybZvNJf23ccazqcUNv4vNIC",
+	"zOgOntaWWsy7hnEaLTTW0pA+x6zh8bOe4eakaAcarN6NLHE79W4YZ42/zK13Rw0jPVn7qVE6Q0hfsy53",
+	"dR/cktoRh7rh3iFMPwpjgMTInL2C3cdwe6NpfkU73dJmZ2JLy7WNcEp+aIPGNeHBHY/r2sAWHeuGnXH+",
+	"R7BgUbhdVODP3fFcK2NaZxvIRxaS3L1hwiEQj8/IbrONTDlMBfAjtHhIp36GHqMfRlbIo9Z+7cR33fAb",
+	"cErCU8gBoRYpOnUR5c9cvXdQjfbwHsbjZ0K+/393py3BiAnQnCE6sgAQcV9z3IacunCyqI/Y+NzS4zNi",
+	"+47n0Vn8A7t6QCK/tf9TikyDN2s/cQY+idmGqyy3I1kqX3HdjSXq/rwNaHc2rJFtJ8UuLe+SmEQln3JJ",
+	"8FuTXn8af5pAw/04HMQ4qYrd1PLlCUagMTfEbc4m+MA+XCVyolEgjvrAl2NCLVqnPruXvJhpIALCbX85",
+	"KXTeiXqcSefLZW0mumQ7wS6RqlTSClm5NEJrSXK7e+n3oLplDv5mmKDBh1QpTffbslD9qHDRxU9MgDoQ",
+	"PDk10bl1HsNHMo/uSh5/hCqKOPuorp+Qu/s06sSJe70LYoUGwAY7OF9xcids/D2BXh/sYNcrZj+FZYVY",
+	"LECb0EbOwk084a7Coc5XOjr7uRtfBz738FR9r1zWiHdU6Da9Dje5Vq2DwUkal2Aj5nGiFbU+dyTCkb4X",
+	"Ubtn7vTcsHCEY8p1LHg0IZJfrXgsMgTk9TQ9+hy+E/Bg20BdOHnz4cc/uyhbw0aDwd3VVXGcLyEFfxOw",
+	"PWujZi8/qXQBOpjpiJRCMn/BMMXK9ZEDw/zlBX9013V3wyPmknlU4seph7Kc/lki27WqFgvXG+Rywtkk",
+	"2wK/wb8+Tg5vyJlJhORRNhWmH9hY/Ly/OV/Bw1SesqtR+0EF6gVPg9cCUDx0G2t3YViyaqMGjhSS6hkF",
+	"M2WPGtwjYz4zfhdJp9Q4t/R4L/Hp3ZJamilrkeqrxLM3nU6W2V1gC2drq4SaeEnHtR2a6ACPM5Z4zfgF",
+	"exeOH27dM//Rh34rxd+U64Q+spXi2Gx1ms+fighv6kZOjyvftOCw5Wiho/t7jkjpdr5W1c2JNQ+fIjPW",
+	"rDaysbU15EBjazJ5HW49OsE0pz5BdlJD6+AnCNJOErktLboRA9B9JTDjGzG7fe6OQtL3UpxYTmW1Phyd",
+	"kcn2okbsQTilaeIvCqJU1lf2JI/f09dgRkskwpYSq4MVv/1oHfoyTRurNcMswTINVgu4hbDBFi4IDSlc",
+	"F/W1w0le5UXhsg2+csDdZTy+mhB/B6sfFPhPW53AnJ1vzPb58vlhBNbL060QIxDefCGCRvzP4RHt71Dd",
+	"T7LvxqyT+p5LmqhW1XX9cAJlgH7DGbl3nikokSckGWuqqPteW8PmYLdAlHUULnBBjdbNH9FEiU2JCmIX",
+	"D3P97J7vTS/RpOTicW1ZqdRNtan7OFP+gn80nFYadx/pEBggCw/EwPpWZQ9frekmoKucGLXgoKpy1q9e",
+	"MrV+KdbCjkPAQJvBEDSBCO6ohNlHhdDdcDoYp+q+5ttNxwvvmcTwNWDEV5ZeTpwokqsbvtTSEcVwOmeP",
+	"LqV3TlKmJx9f6X4e+1R1Wl99+/vQp45YQ1SMmiBn/czBphoib9M3X/etVXQptUi4G95CfVA1Zc/X1Hi2",
+	"Ewb/MpwRvoFp1RGsUbavYU6bYC5vzHmaYy9Y1Ajrbzyke7F694b5u3xBhnsa57ASsrhIWfLet/6OZMXx",
+	"1Z+v/bZn6LcdZzw73248RTK/PTwifCDvwULpL6wkdmuuqvzHx/uPscS+Bstoe6y1vwFRPc0vdkP3OMaD",
+	"MmS++sFf/eDfiR98pDyalBCGr8ylHWL3jcbUXZn4JW7/yU6ydHn4rHai/ab50OMpJar098dP8pE735w8",
+	"QeU+/+boEd99gVzhEME8Jvp8YaKP8R6tnpNHE+IWg1BZOVKFh6/uflXiX5X4KDCodObR8DhHVE61G/EX",
+	"pL9cy9FASTrC7kowszuMM+5nd/RfuoR/OPXPc2sYx7Db1Re0684yhrnJVgDWJJqA9S28pxfGtaoESE6I",
+	"u/3/Hlx2y4055WCYMZl7gP/1W6SvcNCt7lezWalyXq6UsVd/uPzD8xl+Xuj/BwBxeCi0BI4AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3,11 +3,14 @@ package cmd
 import (
 	"context"
 	"github.com/rmarken/reptr/service/internal/database"
+	"github.com/rmarken/reptr/service/internal/jobs"
 	"github.com/rmarken/reptr/service/internal/logic/auth"
 	deck_viewer "github.com/rmarken/reptr/service/internal/logic/deck-viewer"
 	"github.com/rmarken/reptr/service/internal/logic/decks"
 	"github.com/rmarken/reptr/service/internal/logic/decks/session"
+	"github.com/rmarken/reptr/service/internal/logic/media"
 	"github.com/rmarken/reptr/service/internal/logic/provider"
+	"github.com/rmarken/reptr/service/internal/logic/scheduler"
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -27,8 +30,17 @@ type Config struct {
 	Auth0Endpoint     string `yaml:"AUTH0_ENDPOINT"`
 	Auth0CallbackUrl  string `yaml:"AUTH0_CALLBACK_URL"`
 	SessionKey        string `yaml:"SESSION_KEY"`
+	// SessionTTL is how long a study session can sit idle before it is abandoned, e.g. "24h".
+	SessionTTL string `yaml:"SESSION_TTL"`
+	// SessionSweepInterval is how often idle sessions are looked for, e.g. "15m".
+	SessionSweepInterval string `yaml:"SESSION_SWEEP_INTERVAL"`
 }
 
+const (
+	DefaultSessionTTL           = 24 * time.Hour
+	DefaultSessionSweepInterval = 15 * time.Minute
+)
+
 func LoadConfigFromFile(logger zerolog.Logger, path string) Config {
 	file, err := os.ReadFile(path)
 	if err != nil {
@@ -94,6 +106,9 @@ func LoadConfFromEnv(logger zerolog.Logger) Config {
 		Auth0Endpoint:     authEndpoint,
 		Auth0CallbackUrl:  callbackURL,
 		SessionKey:        sessionKey,
+		// Optional, the defaults are used when they are not set.
+		SessionTTL:           os.Getenv("SESSION_TTL"),
+		SessionSweepInterval: os.Getenv("SESSION_SWEEP_INTERVAL"),
 	}
 	return config
 }
@@ -111,12 +126,41 @@ func MustLoadProvider(logger zerolog.Logger, repo database.Repository) *provider
 }
 
 func MustLoadDeckViewerController(logger zerolog.Logger, repo database.Repository) *deck_viewer.Logic {
-	return deck_viewer.New(logger, repo)
+	return deck_viewer.New(logger, repo, scheduler.NewSelector())
+}
+
+func MustLoadMediaController(logger zerolog.Logger, repo database.Repository) *media.Logic {
+	return media.New(logger, repo)
+}
+
+func MustLoadJobRunner(logger zerolog.Logger) *jobs.Runner {
+	return jobs.New(logger)
+}
+
+// MustParseDuration parses the named duration from config, using def when it is not set.
+func MustParseDuration(logger zerolog.Logger, name, value string, def time.Duration) time.Duration {
+	if value == "" {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		logger.Panic().Err(err).Msgf("while parsing %s", name)
+	}
+	if d <= 0 {
+		logger.Panic().Msgf("%s must be positive", name)
+	}
+	return d
 }
 
 func MustLoadRepo(logger zerolog.Logger, db *mongo.Database) *database.DataAccessObject {
 	return database.NewRepository(logger, db)
 }
+func MustEnsureIndexes(ctx context.Context, logger zerolog.Logger, repo *database.DataAccessObject) {
+	err := repo.EnsureIndexes(ctx)
+	if err != nil {
+		logger.Panic().Err(err).Msg("while ensuring indexes")
+	}
+}
 func MustConnectMongo(ctx context.Context, logger zerolog.Logger, config Config) *mongo.Database {
 	serverAPI := options.ServerAPI(options.ServerAPIVersion1)
 
@@ -2,18 +2,28 @@ package main
 
 import (
 	"context"
+	"errors"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/sessions"
 	exAPI "github.com/rmarken/reptr/api"
 	"github.com/rmarken/reptr/service/cmd"
 	"github.com/rmarken/reptr/service/internal/api"
 	"github.com/rmarken/reptr/service/internal/api/middlewares"
+	"github.com/rmarken/reptr/service/internal/jobs"
 	"github.com/rs/zerolog"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	// Embed the time zone database so users can study in their own time zone on hosts without one.
+	_ "time/tzdata"
 )
 
+// shutdownTimeout is how long in-flight requests are given to finish once the server is asked to stop.
+const shutdownTimeout = 10 * time.Second
+
 var (
 	config cmd.Config
 	log    zerolog.Logger
@@ -40,20 +50,28 @@ func init() {
 }
 
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	db := cmd.MustConnectMongo(ctx, log, config)
-	defer db.Client().Disconnect(ctx)
+	defer db.Client().Disconnect(context.Background())
 	repo := cmd.MustLoadRepo(log, db)
+	cmd.MustEnsureIndexes(ctx, log, repo)
 	l := cmd.MustLoadLogic(log, repo)
 
 	sessionController := cmd.MustLoadSessionLogic(log, l, repo)
 	deckViewer := cmd.MustLoadDeckViewerController(log, repo)
+	mediaController := cmd.MustLoadMediaController(log, repo)
 	authenticator := cmd.MustLoadAuth(ctx, log, config, repo)
 
+	runner := cmd.MustLoadJobRunner(log)
+	sessionTTL := cmd.MustParseDuration(log, "SESSION_TTL", config.SessionTTL, cmd.DefaultSessionTTL)
+	sweepInterval := cmd.MustParseDuration(log, "SESSION_SWEEP_INTERVAL", config.SessionSweepInterval, cmd.DefaultSessionSweepInterval)
+	runner.Every(ctx, sweepInterval, jobs.NewSessionSweeper(sessionController, sessionTTL))
+
 	p := cmd.MustLoadProvider(log, repo)
 	store := sessions.NewCookieStore([]byte(config.SessionKey))
-	serverImpl := api.New(log, l, p, authenticator, sessionController, store, deckViewer)
+	serverImpl := api.New(log, l, p, authenticator, sessionController, store, deckViewer, mediaController)
 
 	router := mux.NewRouter()
 
@@ -76,11 +94,13 @@ func main() {
 	pageRoute.HandleFunc("/create-group", wrapper.CreateGroupPage).Methods(http.MethodGet)
 	pageRoute.HandleFunc("/create-group", wrapper.CreateGroup).Methods(http.MethodPost)
 	pageRoute.HandleFunc("/group/{groupID}", wrapper.GroupPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/group-leaderboard/{group_id}", wrapper.GroupLeaderboardTab).Methods(http.MethodGet)
 	pageRoute.HandleFunc("/create-deck/{group_id}", wrapper.CreateDeckPage).Methods(http.MethodGet)
 	pageRoute.HandleFunc("/create-deck", wrapper.CreateDeckPage).Methods(http.MethodGet)
 	pageRoute.HandleFunc("/create-deck/{group_id}", wrapper.CreateDeck).Methods(http.MethodPost)
 	pageRoute.HandleFunc("/create-deck", wrapper.CreateDeck).Methods(http.MethodPost)
 	pageRoute.HandleFunc("/create-cards/{deck_id}", wrapper.CreateCardForDeck).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/card-preview", wrapper.CardPreview).Methods(http.MethodPost)
 	pageRoute.HandleFunc("/create-cards/{deck_id}", wrapper.GetCreateCardsForDeckPage).Methods(http.MethodGet)
 	pageRoute.HandleFunc("/create-cards-content/{deck_id}", wrapper.GetCreateCardsForDeckContent).Methods(http.MethodGet)
 	pageRoute.HandleFunc("/add-card/{deck_id}", wrapper.GetCardsForDeck).Methods(http.MethodGet)
@@ -88,20 +108,50 @@ func main() {
 	pageRoute.HandleFunc("/back-of-card/{deck_id}/{card_id}", wrapper.BackOfCard).Methods(http.MethodGet)
 	pageRoute.HandleFunc("/view-deck/{deck_id}", wrapper.ViewDeck).Methods(http.MethodGet)
 	pageRoute.HandleFunc("/upvote-card/{card_id}/{direction}", wrapper.VoteCard).Methods(http.MethodPost)
-	pageRoute.HandleFunc("/answered-correct/{session_id}", wrapper.UpdateCardCorrect).Methods(http.MethodPost)
-	pageRoute.HandleFunc("/answered-incorrect/{session_id}", wrapper.UpdateCardIncorrect).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/answer/{session_id}/{grade}", wrapper.AnswerCard).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/typed-answer/{session_id}", wrapper.AnswerCardTyped).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/choice-answer/{session_id}", wrapper.AnswerCardChoice).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/session-summary/{session_id}", wrapper.SessionSummaryPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/retry/{session_id}", wrapper.RetryMissedCards).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/study/{session_id}", wrapper.StudySessionPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/review", wrapper.ReviewPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/hold-card/{session_id}/{hold}", wrapper.HoldCard).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/suspended", wrapper.SuspendedCardsPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/unsuspend", wrapper.UnsuspendCards).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/study-tag", wrapper.StudyTagPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/history", wrapper.HistoryPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/stats", wrapper.StatsPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/card/{card_id}", wrapper.CardHistoryPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/edit-card/{card_id}", wrapper.EditCardPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/edit-card/{card_id}", wrapper.EditCard).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/deck-settings/{deck_id}", wrapper.DeckSettingsPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/deck-settings/{deck_id}", wrapper.UpdateDeckSettings).Methods(http.MethodPost)
+	pageRoute.HandleFunc("/deck-stats/{deck_id}", wrapper.DeckStatsPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/settings", wrapper.StudySettingsPage).Methods(http.MethodGet)
+	pageRoute.HandleFunc("/settings", wrapper.UpdateStudySettings).Methods(http.MethodPost)
 
 	pageRoute.Use(
 		middlewares.Session(log, store),
 		middlewares.Authenticate(log, authenticator),
 		middlewares.ExchangeSubjectForUser(log, p))
 
+	mediaRoute := router.PathPrefix("/media").Subrouter()
+	mediaRoute.HandleFunc("/{media_id}", wrapper.GetMedia).Methods(http.MethodGet)
+
+	mediaRoute.Use(
+		middlewares.Session(log, store),
+		middlewares.Authenticate(log, authenticator),
+		middlewares.ExchangeSubjectForUser(log, p))
+
 	secureRoute := router.PathPrefix("/secure").Subrouter()
 	secureRoute.HandleFunc("/api/v1/deck", wrapper.AddDeck).Methods(http.MethodPost)
 	secureRoute.HandleFunc("/api/v1/group", wrapper.AddGroup).Methods(http.MethodPost)
 	secureRoute.HandleFunc("/api/v1/group/{group_id}/deck/{deck_id}", wrapper.AddDeckToGroup).Methods("PUT")
+	secureRoute.HandleFunc("/api/v1/group/{group_id}/leaderboard", wrapper.GetGroupLeaderboard).Methods(http.MethodGet)
 	secureRoute.HandleFunc("/api/v1/groups", wrapper.GetGroups).Methods(http.MethodGet)
+	secureRoute.HandleFunc("/api/v1/sessions", wrapper.GetSessions).Methods(http.MethodGet)
 	secureRoute.HandleFunc("/api/v1/card-input/{card-num}", wrapper.GetCardInput).Methods(http.MethodGet)
+	secureRoute.HandleFunc("/api/v1/media", wrapper.UploadMedia).Methods(http.MethodPost)
 
 	secureRoute.Use(
 		middlewares.Authenticate(log, authenticator),
@@ -112,6 +162,20 @@ func main() {
 		Addr:    net.JoinHostPort("0.0.0.0", config.PORT),
 	}
 
-	log.Fatal().Err(s.ListenAndServe())
+	go func() {
+		err := s.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal().Err(err).Msg("while serving")
+		}
+	}()
 
+	<-ctx.Done()
+	log.Info().Msg("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := s.Shutdown(shutdownCtx); err != nil {
+		log.Error().Err(err).Msg("while shutting down server")
+	}
+	runner.Wait()
 }
@@ -10,12 +10,14 @@ import (
 	deck_viewer "github.com/rmarken/reptr/service/internal/logic/deck-viewer"
 	"github.com/rmarken/reptr/service/internal/logic/decks"
 	"github.com/rmarken/reptr/service/internal/logic/decks/session"
+	"github.com/rmarken/reptr/service/internal/logic/media"
 	"github.com/rmarken/reptr/service/internal/logic/provider"
 	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rmarken/reptr/service/internal/web/components/dumb"
 	"github.com/rs/zerolog"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 //go:generate mockgen -destination ./mocks/sessions_mock.go -package api  github.com/gorilla/sessions Store
@@ -35,10 +37,11 @@ type ReprtClient struct {
 	sessionController    session.Controller
 	authenticator        auth.Authentication
 	deckViewerController deck_viewer.Controller
+	mediaController      media.Controller
 	store                sessions.Store
 }
 
-func New(logger zerolog.Logger, deckController decks.Controller, providerController provider.Controller, authentication auth.Authentication, sessionController session.Controller, store sessions.Store, deckViewerController deck_viewer.Controller) *ReprtClient {
+func New(logger zerolog.Logger, deckController decks.Controller, providerController provider.Controller, authentication auth.Authentication, sessionController session.Controller, store sessions.Store, deckViewerController deck_viewer.Controller, mediaController media.Controller) *ReprtClient {
 	logger = logger.With().Str("module", "server").Logger()
 	return &ReprtClient{
 		logger:               logger,
@@ -48,6 +51,7 @@ func New(logger zerolog.Logger, deckController decks.Controller, providerControl
 		sessionController:    sessionController,
 		store:                store,
 		deckViewerController: deckViewerController,
+		mediaController:      mediaController,
 	}
 }
 
@@ -80,6 +84,114 @@ func (rc ReprtClient) GetGroups(w http.ResponseWriter, r *http.Request, params a
 	json.NewEncoder(w).Encode(g)
 }
 
+func (rc ReprtClient) GetSessions(w http.ResponseWriter, r *http.Request, params api.GetSessionsParams) {
+	log := rc.logger.With().Str("method", "GetSessions").Logger()
+	w.Header().Set("Content-Type", "application/json")
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		log.Error().Msg("username not on context while calling GetSessions")
+		http.Error(w, "username not on context", http.StatusBadRequest)
+		return
+	}
+
+	var deckID string
+	if params.DeckId != nil {
+		deckID = *params.DeckId
+	}
+
+	history, err := rc.sessionController.GetSessionHistory(r.Context(), username, deckID, params.From, params.To, params.Limit, params.Offset)
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting sessions with: %+v", params)
+		status := toStatus(err)
+		w.WriteHeader(status)
+		errObj := api.ErrorObject{
+			Error:      err.Error(),
+			Message:    fmt.Sprintf("error in request with %+v", params),
+			StatusCode: status,
+		}
+		json.NewEncoder(w).Encode(errObj)
+		return
+	}
+	s := make(api.GetSessions, len(history))
+	for i, summary := range history {
+		kind := string(summary.Kind)
+		s[i] = api.SessionRecord{
+			Id:              summary.SessionID,
+			Kind:            &kind,
+			DeckId:          summary.DeckID,
+			DeckName:        summary.DeckName,
+			StartedAt:       summary.StartedAt,
+			TotalCards:      summary.TotalCards,
+			NumCorrect:      summary.NumCorrect,
+			PercentCorrect:  summary.PercentCorrect(),
+			DurationSeconds: int(summary.TimeTaken.Seconds()),
+		}
+		if summary.FinishedAt != nil {
+			s[i].FinishedAt = *summary.FinishedAt
+		}
+	}
+	json.NewEncoder(w).Encode(s)
+}
+
+func (rc ReprtClient) GetGroupLeaderboard(w http.ResponseWriter, r *http.Request, groupID string, params api.GetGroupLeaderboardParams) {
+	log := rc.logger.With().Str("method", "GetGroupLeaderboard").Logger()
+	w.Header().Set("Content-Type", "application/json")
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		log.Error().Msg("username not on context while calling GetGroupLeaderboard")
+		http.Error(w, "username not on context", http.StatusBadRequest)
+		return
+	}
+
+	window, metric := leaderboardOptions(params.Window, params.Metric)
+	leaderboard, err := rc.deckController.GetGroupLeaderboard(r.Context(), groupID, username, window, metric, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting leaderboard for group %s with: %+v", groupID, params)
+		status := toStatus(err)
+		w.WriteHeader(status)
+		errObj := api.ErrorObject{
+			Error:      err.Error(),
+			Message:    fmt.Sprintf("error in request with %+v", params),
+			StatusCode: status,
+		}
+		json.NewEncoder(w).Encode(errObj)
+		return
+	}
+
+	entries := make([]api.LeaderboardEntry, len(leaderboard.Entries))
+	for i, entry := range leaderboard.Entries {
+		entries[i] = api.LeaderboardEntry{
+			Rank:           i + 1,
+			Username:       entry.Username,
+			Reviews:        entry.Reviews,
+			Correct:        entry.Correct,
+			PercentCorrect: entry.PercentCorrect(),
+			Streak:         entry.Streak,
+		}
+	}
+	json.NewEncoder(w).Encode(api.Leaderboard{
+		GroupId:    leaderboard.GroupID,
+		Window:     string(leaderboard.Window),
+		Metric:     string(metric),
+		ComputedAt: leaderboard.ComputedAt,
+		Entries:    entries,
+	})
+}
+
+// leaderboardOptions reads the window and metric a leaderboard was asked for, defaulting to this week's reviews.
+func leaderboardOptions(window, metric *string) (models.LeaderboardWindow, models.LeaderboardMetric) {
+	w, m := models.WeeklyLeaderboard, models.RankByReviews
+	if window != nil && *window != "" {
+		w = models.LeaderboardWindow(*window)
+	}
+	if metric != nil && *metric != "" {
+		m = models.LeaderboardMetric(*metric)
+	}
+	return w, m
+}
+
 func decksFromDecks(fromService []models.GetDeckResults) []api.Deck {
 	apiDecks := make([]api.Deck, len(fromService))
 	for i, deck := range fromService {
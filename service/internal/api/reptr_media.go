@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/rmarken/reptr/api"
+	reptrCtx "github.com/rmarken/reptr/service/internal/context"
+	"github.com/rmarken/reptr/service/internal/models"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// mediaFormMemory is how much of a multipart form is held in memory; the rest of its files are buffered on disk.
+	mediaFormMemory = 8 << 20
+	// maxMediaUploadSize bounds a request uploading one attachment, leaving room for the rest of the form.
+	maxMediaUploadSize = models.MaxMediaSize + 1<<20
+	// maxCardFormSize bounds a request creating a card, which can attach several files to each side.
+	maxCardFormSize = 4*models.MaxMediaSize + 1<<20
+	// mediaCacheControl lets browsers keep attachments for good: an attachment's content never changes, new content
+	// is uploaded as a new attachment.
+	mediaCacheControl = "private, max-age=31536000, immutable"
+)
+
+func (rc ReprtClient) GetMedia(w http.ResponseWriter, r *http.Request, mediaID string) {
+	logger := rc.logger.With().Str("method", "GetMedia").Logger()
+	logger.Info().Msgf("serving media %s", mediaID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msg("username not on context while calling GetMedia")
+		http.Error(w, "username not on context", http.StatusBadRequest)
+		return
+	}
+
+	media, content, err := rc.mediaController.Open(r.Context(), mediaID, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while opening media %s", mediaID)
+		status := toStatus(err)
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	defer content.Close()
+
+	// The caller is checked before their cached copy is, so the ETag doesn't tell others the attachment exists.
+	etag := strconv.Quote(mediaID)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", media.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(media.Size, 10))
+	w.Header().Set("Cache-Control", mediaCacheControl)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", media.UploadedAt.UTC().Format(http.TimeFormat))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = io.Copy(w, content)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while streaming media %s", mediaID)
+	}
+}
+
+func (rc ReprtClient) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	log := rc.logger.With().Str("method", "UploadMedia").Logger()
+	w.Header().Set("Content-Type", "application/json")
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		log.Error().Msg("username not on context while calling UploadMedia")
+		http.Error(w, "username not on context", http.StatusBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxMediaUploadSize)
+	file, header, err := r.FormFile("file")
+	if err == nil {
+		defer file.Close()
+		var media models.Media
+		media, err = rc.mediaController.Upload(r.Context(), username, header.Filename, file)
+		if err == nil {
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(api.MediaRecord{
+				Id:          media.ID,
+				ContentType: media.ContentType,
+				Size:        media.Size,
+				Url:         media.Ref().URL(),
+			})
+			return
+		}
+	}
+
+	log.Error().Err(err).Msg("while uploading media")
+	status := toStatus(err)
+	w.WriteHeader(status)
+	errObj := api.ErrorObject{
+		Error:      err.Error(),
+		Message:    fmt.Sprintf("error uploading media"),
+		StatusCode: status,
+	}
+	json.NewEncoder(w).Encode(errObj)
+}
+
+// parseCardForm parses a form creating a card, which is multipart when files are attached to it.
+func parseCardForm(w http.ResponseWriter, r *http.Request) error {
+	r.Body = http.MaxBytesReader(w, r.Body, maxCardFormSize)
+	err := r.ParseMultipartForm(mediaFormMemory)
+	if errors.Is(err, http.ErrNotMultipart) {
+		return r.ParseForm()
+	}
+	return err
+}
+
+// uploadCardMedia uploads the files attached to each side of a card being created.
+func (rc ReprtClient) uploadCardMedia(r *http.Request, username string) ([]models.MediaRef, []models.MediaRef, error) {
+	front, err := rc.uploadFormMedia(r, username, "front-media")
+	if err != nil {
+		return nil, nil, err
+	}
+	back, err := rc.uploadFormMedia(r, username, "back-media")
+	if err != nil {
+		rc.discardMedia(r, front...)
+		return nil, nil, err
+	}
+	return front, back, nil
+}
+
+// uploadOcclusionImage uploads the diagram of an image occlusion card being created.
+func (rc ReprtClient) uploadOcclusionImage(r *http.Request, username string) (models.MediaRef, error) {
+	refs, err := rc.uploadFormMedia(r, username, "occlusion-image")
+	if err != nil || len(refs) == 0 {
+		return models.MediaRef{}, err
+	}
+	return refs[0], nil
+}
+
+// uploadFormMedia uploads the files attached to the named field of a multipart form, returning references to them.
+func (rc ReprtClient) uploadFormMedia(r *http.Request, username, field string) ([]models.MediaRef, error) {
+	if r.MultipartForm == nil {
+		return nil, nil
+	}
+
+	var refs []models.MediaRef
+	for _, header := range r.MultipartForm.File[field] {
+		media, err := rc.uploadFormFile(r, username, header)
+		if err != nil {
+			rc.discardMedia(r, refs...)
+			return nil, err
+		}
+		refs = append(refs, media.Ref())
+	}
+	return refs, nil
+}
+
+func (rc ReprtClient) uploadFormFile(r *http.Request, username string, header *multipart.FileHeader) (models.Media, error) {
+	file, err := header.Open()
+	if err != nil {
+		return models.Media{}, err
+	}
+	defer file.Close()
+	return rc.mediaController.Upload(r.Context(), username, header.Filename, file)
+}
+
+// discardMedia deletes attachments uploaded for a card that is not going to be created, so that they aren't left
+// behind. It carries on past failures, which are only logged.
+func (rc ReprtClient) discardMedia(r *http.Request, refs ...models.MediaRef) {
+	logger := rc.logger.With().Str("method", "discardMedia").Logger()
+
+	// The request may have been cancelled, which is no reason to keep the attachments.
+	ctx := context.WithoutCancel(r.Context())
+	for _, ref := range refs {
+		err := rc.mediaController.Delete(ctx, ref.ID)
+		if err != nil {
+			logger.Error().Err(err).Msgf("while discarding media %s", ref.ID)
+		}
+	}
+}
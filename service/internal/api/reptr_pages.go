@@ -8,19 +8,28 @@ import (
 	"github.com/rmarken/reptr/api"
 	reptrCtx "github.com/rmarken/reptr/service/internal/context"
 	"github.com/rmarken/reptr/service/internal/database"
+	deck_viewer "github.com/rmarken/reptr/service/internal/logic/deck-viewer"
 	"github.com/rmarken/reptr/service/internal/logic/decks"
+	"github.com/rmarken/reptr/service/internal/logic/decks/session"
+	"github.com/rmarken/reptr/service/internal/logic/media"
 	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rmarken/reptr/service/internal/web/chart"
 	"github.com/rmarken/reptr/service/internal/web/components/dumb"
 	"github.com/rmarken/reptr/service/internal/web/components/pages"
+	"github.com/rmarken/reptr/service/internal/web/markdown"
 	"net/http"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 )
 
 const (
-	hxTriggerHeaderKey = "HX-Trigger"
+	hxTriggerHeaderKey  = "HX-Trigger"
+	hxRedirectHeaderKey = "HX-Redirect"
 
 	stylesDir = "/styles/pages/"
 
@@ -40,9 +49,31 @@ const (
 	deckViewStyle     = stylesDir + "deck_viewer.css"
 	createDeckStyle   = stylesDir + "create_deck.css"
 	errorStyle        = stylesDir + "error.css"
+
+	// highlightStyle colours code in cards. It is generated from the highlighter's style rather than read from a file.
+	highlightStyleDir  = "code"
+	highlightStyleName = "highlight.css"
+	highlightStyle     = "/styles/" + highlightStyleDir + "/" + highlightStyleName
+)
+
+// historyPageSize is the number of sessions listed on each page of the session history.
+const historyPageSize = 20
+
+const (
+	// statsDays is how far back the reviews heatmap goes: a year of whole weeks.
+	statsDays = 52 * 7
+	// statsTrendDays is how far back the other stats charts go.
+	statsTrendDays = 30
+)
+
+// Card types submitted by the forms for creating cards other than basic cards.
+const (
+	multipleChoiceCardType = "multiple_choice"
+	clozeCardType          = "cloze"
+	occlusionCardType      = "image_occlusion"
 )
 
-var cssFileArr = []string{baseStyle, pageStyle}
+var cssFileArr = []string{baseStyle, pageStyle, highlightStyle}
 
 func (rc ReprtClient) GetFavicon(w http.ResponseWriter, _ *http.Request) {
 	log := rc.logger.With().Str("method", "GetFavicon").Logger()
@@ -63,6 +94,18 @@ func (rc ReprtClient) ServeStyles(w http.ResponseWriter, r *http.Request, path s
 	log := rc.logger.With().Str("method", "ServeStyles").Logger()
 	log.Info().Msgf("serving %s %s", path, styleName)
 
+	if path == highlightStyleDir && styleName == highlightStyleName {
+		css, err := markdown.HighlightCSS()
+		if err != nil {
+			log.Error().Err(err).Msg("while generating highlight styles")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/css")
+		w.Write(css)
+		return
+	}
+
 	absolutePath, err := filepath.Abs(fmt.Sprintf("./service/internal/web/styles/%s/%s", path, styleName))
 	file, err := os.ReadFile(absolutePath)
 	if err != nil {
@@ -348,8 +391,18 @@ func (rc ReprtClient) HomePage(w http.ResponseWriter, r *http.Request) {
 	homeDecks := make([]dumb.Deck, len(homepageData.Decks))
 	for i, deck := range homepageData.Decks {
 		homeDecks[i] = webDeckFromModel(deck)
+		homeDecks[i].NumDue = homepageData.DueByDeck[deck.ID]
+	}
+	homeData := pages.HomeData{Username: userName, Groups: homeGroups, Decks: homeDecks, NumDue: homepageData.NumDue}
+	// The home page is still useful without the streak banner, so a failure to load it is not an error page.
+	progress, err := rc.sessionController.GetStudyProgress(r.Context(), userName, time.Now())
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting study progress for user %s", userName)
+	} else {
+		homeProgress := studyProgressFromModel(progress)
+		homeData.Progress = &homeProgress
 	}
-	pages.Page(pages.PageData{Title: "Home"}, pages.Home(pages.HomeData{Username: userName, Groups: homeGroups, Decks: homeDecks}), append(cssFileArr, tableStyle, homeStyle, groupStyle)).Render(r.Context(), w)
+	pages.Page(pages.PageData{Title: "Home"}, pages.Home(homeData), append(cssFileArr, tableStyle, homeStyle, groupStyle)).Render(r.Context(), w)
 }
 
 func (rc ReprtClient) CreateGroup(w http.ResponseWriter, r *http.Request) {
@@ -424,7 +477,52 @@ func (rc ReprtClient) GroupPage(w http.ResponseWriter, r *http.Request, groupID
 	}
 
 	logger.Debug().Msgf("group from service: %+v", group)
-	pages.Page(pages.PageData{Title: "Groups"}, pages.Form(nil, pages.GroupPage(groupPageFromModel(group))), append(cssFileArr, tableStyle, groupStyle)).Render(r.Context(), w)
+	groupData := groupPageFromModel(group)
+	if username, ok := reptrCtx.Username(r.Context()); ok {
+		due, err := rc.deckController.GetDueCardsForUser(r.Context(), username, time.Now())
+		if err != nil {
+			// Due counts are informational; still serve the group.
+			logger.Error().Err(err).Msgf("while getting due cards for %s", username)
+		}
+		dueByDeck := models.CountDueByDeck(due)
+		for i := range groupData.Decks {
+			groupData.Decks[i].NumDue = dueByDeck[groupData.Decks[i].ID]
+		}
+	}
+	pages.Page(pages.PageData{Title: "Groups"}, pages.Form(nil, pages.GroupPage(groupData)), append(cssFileArr, tableStyle, groupStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) GroupLeaderboardTab(w http.ResponseWriter, r *http.Request, groupID string, params api.GroupLeaderboardTabParams) {
+	logger := rc.logger.With().Str("method", "GroupLeaderboardTab").Logger()
+	logger.Info().Msgf("serving leaderboard tab for group: %s", groupID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	window, metric := leaderboardOptions(params.Window, params.Metric)
+	leaderboard, err := rc.deckController.GetGroupLeaderboard(r.Context(), groupID, username, window, metric, time.Now())
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting leaderboard for group %s", groupID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting leaderboard",
+			Msg:        "Problem getting the group leaderboard.",
+		})
+		return
+	}
+
+	pages.Leaderboard(leaderboardFromModel(leaderboard, metric, username)).Render(r.Context(), w)
 }
 
 func (rc ReprtClient) CreateGroupPage(w http.ResponseWriter, r *http.Request) {
@@ -545,8 +643,10 @@ func (rc ReprtClient) GetCreateCardsForDeckPage(w http.ResponseWriter, r *http.R
 	viewCards := make([]dumb.CardDisplay, len(deck.Cards))
 	for i, card := range deck.Cards {
 		viewCards[i] = dumb.CardDisplay{
+			ID:    card.ID,
 			Front: card.Front,
 			Back:  card.Back,
+			Tags:  card.Tags,
 		}
 	}
 
@@ -554,6 +654,7 @@ func (rc ReprtClient) GetCreateCardsForDeckPage(w http.ResponseWriter, r *http.R
 		DeckID:   deck.ID,
 		DeckName: deck.Name,
 		Cards:    viewCards,
+		Tags:     models.DeckTags(deck.Cards),
 	})), append(cssFileArr, formStyle, createDeckStyle)).Render(r.Context(), w)
 
 }
@@ -589,8 +690,10 @@ func (rc ReprtClient) GetCreateCardsForDeckContent(w http.ResponseWriter, r *htt
 	viewCards := make([]dumb.CardDisplay, len(deck.Cards))
 	for i, card := range deck.Cards {
 		viewCards[i] = dumb.CardDisplay{
+			ID:    card.ID,
 			Front: card.Front,
 			Back:  card.Back,
+			Tags:  card.Tags,
 		}
 	}
 
@@ -598,6 +701,7 @@ func (rc ReprtClient) GetCreateCardsForDeckContent(w http.ResponseWriter, r *htt
 		DeckID:   deck.ID,
 		DeckName: deck.Name,
 		Cards:    viewCards,
+		Tags:     models.DeckTags(deck.Cards),
 	}).Render(r.Context(), w)
 
 }
@@ -631,14 +735,16 @@ func (rc ReprtClient) GetCardsForDeck(w http.ResponseWriter, r *http.Request, de
 	viewCards := make([]dumb.CardDisplay, len(deck.Cards))
 	for i, card := range deck.Cards {
 		viewCards[i] = dumb.CardDisplay{
+			ID:    card.ID,
 			Front: card.Front,
 			Back:  card.Back,
+			Tags:  card.Tags,
 		}
 	}
 	dumb.GroupCardDisplay(viewCards).Render(r.Context(), w)
 }
 
-func (rc ReprtClient) ViewDeck(w http.ResponseWriter, r *http.Request, deckID string) {
+func (rc ReprtClient) ViewDeck(w http.ResponseWriter, r *http.Request, deckID string, params api.ViewDeckParams) {
 	logger := rc.logger.With().Str("method", "ViewDeck").Logger()
 	logger.Info().Msg("view deck")
 
@@ -665,163 +771,137 @@ func (rc ReprtClient) ViewDeck(w http.ResponseWriter, r *http.Request, deckID st
 		return
 	}
 
-	content, err := rc.getCardViewerContent(r.Context(), username, deckID)
-	if err != nil {
-		logger.Error().Err(err).Msgf("while getting card content %s", deckID)
-		status := toStatus(err)
+	order := models.DefaultCardOrder
+	if params.Order != nil {
+		order = models.CardOrder(*params.Order)
+	}
+	if !order.IsValid() {
+		logger.Error().Msgf("invalid order %s", order)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(status),
-			Status:     http.StatusText(status),
-			Error:      "while getting card content",
-			Msg:        "Looks like there are no cards for this deck. Use the deck builder to add cards to this deck.",
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      fmt.Sprintf("invalid order %s", order),
+			Msg:        "Problem getting deck content.",
 		})
 		return
 	}
 
-	pages.Page(pages.PageData{Title: "View Deck"}, pages.DeckViewerPage(content), append(cssFileArr, deckViewStyle)).Render(r.Context(), w)
-}
-
-func (rc ReprtClient) getCardViewerContent(ctx context.Context, username, deckID string) (pages.DeckViewPageData, error) {
-	s, err := rc.sessionController.GetActiveSessionForUserAndDeckID(ctx, username, deckID)
-	if err != nil {
-		return pages.DeckViewPageData{}, err
-	}
-	if s.IsFront {
-		f, err := rc.deckController.GetFrontOfCardByID(ctx, deckID, s.CurrentCardID, username)
-		if err != nil {
-			return pages.DeckViewPageData{}, err
-		}
-		return pages.DeckViewPageData{
-			DeckName: s.DeckName,
-			DeckID:   deckID,
-			Content: dumb.FrontCardDisplay(dumb.CardFront{
-				SessionID:      s.ID,
-				DeckID:         deckID,
-				CardType:       "",
-				CardID:         s.CurrentCardID,
-				Front:          f.Content,
-				Upvotes:        strconv.Itoa(f.Upvotes),
-				Downvotes:      strconv.Itoa(f.Downvotes),
-				PreviousCardID: f.PreviousCard,
-				NextCardID:     f.NextCard,
-			}),
-		}, err
-	}
-
-	b, err := rc.deckController.GetBackOfCardByID(ctx, deckID, s.CurrentCardID, username)
-	if err != nil {
-		return pages.DeckViewPageData{}, err
+	direction := models.ForwardDirection
+	if params.Direction != nil {
+		direction = models.StudyDirection(*params.Direction)
 	}
-	return pages.DeckViewPageData{
-		DeckName: s.DeckName,
-		DeckID:   deckID,
-		Content: dumb.BackOfCardDisplay(dumb.CardBack{
-			SessionID:      s.ID,
-			DeckID:         deckID,
-			CardID:         s.CurrentCardID,
-			BackContent:    b.Answer,
-			NextCardID:     b.NextCard,
-			PreviousCardID: b.PreviousCard,
-			IsUpvoted:      bool(b.IsUpvotedByUser),
-			IsDownvoted:    bool(b.IsDownvotedByUser),
-		}),
-	}, err
-
-}
-
-func (rc ReprtClient) CreateCardForDeck(w http.ResponseWriter, r *http.Request, deckID string) {
-	logger := rc.logger.With().Str("method", "CreateCardForDeck").Logger()
-	logger.Info().Msg("creating card")
-
-	err := r.ParseForm()
-	if err != nil {
-		logger.Error().Err(err).Msg("unable to parse form")
+	if !direction.IsValid() {
+		logger.Error().Msgf("invalid direction %s", direction)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusInternalServerError),
-			Status:     http.StatusText(http.StatusInternalServerError),
-			Error:      "unable to parse form",
-			Msg:        "Problem creating card",
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      fmt.Sprintf("invalid direction %s", direction),
+			Msg:        "Problem getting deck content.",
 		})
 		return
 	}
 
-	if deckID == "" {
-		logger.Error().Msgf("create deck attempt without deckID")
+	mode := models.SelfGradedMode
+	if params.Mode != nil {
+		mode = models.AnswerMode(*params.Mode)
+	}
+	if !mode.IsValid() {
+		logger.Error().Msgf("invalid mode %s", mode)
 		rc.serveError(w, r, pages.ErrorPageData{
 			StatusCode: strconv.Itoa(http.StatusBadRequest),
 			Status:     http.StatusText(http.StatusBadRequest),
-			Error:      "create deck attempt without deckID",
-			Msg:        "Problem creating card",
+			Error:      fmt.Sprintf("invalid mode %s", mode),
+			Msg:        "Problem getting deck content.",
 		})
 		return
 	}
 
-	cardFront := r.PostForm.Get("card-front")
-	if cardFront == "" {
-		logger.Info().Msgf("create deck attempt without card front")
+	var tags []string
+	if params.Tag != nil {
+		tags = models.ParseTags(*params.Tag)
+	}
+
+	s, err := rc.sessionController.GetActiveSessionForUserAndDeckID(r.Context(), username, deckID, tags, order, direction, mode)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting session for deck %s", deckID)
+		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusBadRequest),
-			Status:     http.StatusText(http.StatusBadRequest),
-			Error:      "create deck attempt without card front",
-			Msg:        "Problem creating card",
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting session",
+			Msg:        "Problem getting deck content.",
 		})
 		return
 	}
 
-	cardBack := r.PostForm.Get("card-back")
-	if cardBack == "" {
-		logger.Error().Msgf("create deck attempt without card back")
+	content, err := rc.getCardViewerContent(r.Context(), username, s)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting card content %s", deckID)
+		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusBadRequest),
-			Status:     http.StatusText(http.StatusBadRequest),
-			Error:      "create deck attempt without card back",
-			Msg:        "Problem creating card",
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting card content",
+			Msg:        "Looks like there are no cards for this deck. Use the deck builder to add cards to this deck.",
 		})
 		return
 	}
 
+	pages.Page(pages.PageData{Title: "View Deck"}, pages.DeckViewerPage(content), append(cssFileArr, deckViewStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) ReviewPage(w http.ResponseWriter, r *http.Request) {
+	logger := rc.logger.With().Str("method", "ReviewPage").Logger()
+	logger.Info().Msg("review due cards")
+
 	username, ok := reptrCtx.Username(r.Context())
 	if !ok {
 		logger.Error().Msgf("username is not on context")
 		rc.serveError(w, r, pages.ErrorPageData{
 			StatusCode: strconv.Itoa(http.StatusInternalServerError),
-			Status:     "Internal Server Error",
+			Status:     http.StatusText(http.StatusInternalServerError),
 			Error:      "username not on context",
 			Msg:        "Try logging back in.",
 		})
 		return
 	}
 
-	timeNow := time.Now().UTC()
-	err = rc.deckController.AddCardToDeck(r.Context(), deckID, models.Card{
-		ID:        uuid.NewString(),
-		Front:     cardFront,
-		Back:      cardBack,
-		Kind:      models.BasicCard,
-		DeckID:    deckID,
-		CreatedAt: timeNow,
-		UpdatedAt: timeNow,
-		CreatedBy: username,
-	})
+	s, err := rc.sessionController.StartReviewSession(r.Context(), username)
 	if err != nil {
-		logger.Error().Err(err).Msgf("while creating a card for deck %s", deckID)
+		if errors.Is(err, session.ErrNothingDue) {
+			pages.Page(pages.PageData{Title: "Due Today"}, pages.NothingDue(), append(cssFileArr, deckViewStyle)).Render(r.Context(), w)
+			return
+		}
+		logger.Error().Err(err).Msgf("while starting review session for %s", username)
 		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
 			StatusCode: strconv.Itoa(status),
 			Status:     http.StatusText(status),
-			Error:      "while creating a card for deck",
-			Msg:        "Problem creating card",
+			Error:      "while starting review session",
+			Msg:        "Problem getting cards due today.",
 		})
 		return
 	}
 
-	w.Header().Set(hxTriggerHeaderKey, "newCard")
-	w.WriteHeader(http.StatusCreated)
+	content, err := rc.getCardViewerContent(r.Context(), username, s)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting card content for session %s", s.ID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting card content",
+			Msg:        "Problem getting cards due today.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Due Today"}, pages.DeckViewerPage(content), append(cssFileArr, deckViewStyle)).Render(r.Context(), w)
 }
 
-func (rc ReprtClient) BackOfCard(w http.ResponseWriter, r *http.Request, deckID, cardID string) {
-	logger := rc.logger.With().Str("method", "BackOfCard").Logger()
-	logger.Info().Msgf("getting back of card for deckID and cardID: %s %s", deckID, cardID)
+func (rc ReprtClient) StudyTagPage(w http.ResponseWriter, r *http.Request, params api.StudyTagPageParams) {
+	logger := rc.logger.With().Str("method", "StudyTagPage").Logger()
+	logger.Info().Msgf("study cards tagged %s", params.Tag)
 
 	username, ok := reptrCtx.Username(r.Context())
 	if !ok {
@@ -835,186 +915,1140 @@ func (rc ReprtClient) BackOfCard(w http.ResponseWriter, r *http.Request, deckID,
 		return
 	}
 
-	s, err := rc.sessionController.GetActiveSessionForUserAndDeckID(r.Context(), username, deckID)
-	if err != nil {
-		logger.Error().Err(err).Msgf("failed to get session")
-		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusInternalServerError),
-			Status:     http.StatusText(http.StatusInternalServerError),
-			Error:      "not able to get session",
-			Msg:        "Try logging back in.",
-		})
-		return
-	}
-
-	backOfCard, err := rc.deckController.GetBackOfCardByID(r.Context(), deckID, cardID, username)
+	s, err := rc.sessionController.StartTagSession(r.Context(), username, models.ParseTags(params.Tag))
 	if err != nil {
-		logger.Error().Err(err).Msgf("while getting back of card for cardID: %s", s.CurrentCardID)
+		logger.Error().Err(err).Msgf("while starting session over tags %s for %s", params.Tag, username)
 		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
 			StatusCode: strconv.Itoa(status),
 			Status:     http.StatusText(status),
-			Error:      "while getting back of card",
-			Msg:        "Problem processing getting back of card.",
+			Error:      "while starting tag session",
+			Msg:        "Problem getting cards with these tags.",
 		})
 		return
 	}
 
-	err = rc.sessionController.UpdateCardOrientation(r.Context(), s.ID, false)
+	content, err := rc.getCardViewerContent(r.Context(), username, s)
 	if err != nil {
-		logger.Error().Err(err).Msgf("failed to update card orientation")
+		logger.Error().Err(err).Msgf("while getting card content for session %s", s.ID)
+		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusInternalServerError),
-			Status:     http.StatusText(http.StatusInternalServerError),
-			Error:      "username not on context",
-			Msg:        "Try logging back in.",
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting card content",
+			Msg:        "Problem getting cards with these tags.",
 		})
 		return
 	}
 
-	dumb.BackOfCardDisplay(dumb.CardBack{
-		SessionID:      s.ID,
-		DeckID:         deckID,
-		CardID:         backOfCard.CardID,
-		BackContent:    backOfCard.Answer,
-		NextCardID:     backOfCard.NextCard,
-		PreviousCardID: backOfCard.PreviousCard,
-		IsUpvoted:      bool(backOfCard.IsUpvotedByUser),
-		IsDownvoted:    bool(backOfCard.IsDownvotedByUser),
-		VoteButtonData: dumb.VoteButtonsData{
-			CardID:            backOfCard.CardID,
-			UpvoteClass:       backOfCard.IsUpvotedByUser.UpvotedClass(),
-			DownvoteClass:     backOfCard.IsDownvotedByUser.DownvotedClass(),
-			UpvoteDirection:   backOfCard.IsUpvotedByUser.NextUpvoteDirection(),
-			DownvoteDirection: backOfCard.IsDownvotedByUser.DownvotedClass()},
-	}).Render(r.Context(), w)
+	pages.Page(pages.PageData{Title: s.DeckName}, pages.DeckViewerPage(content), append(cssFileArr, deckViewStyle)).Render(r.Context(), w)
 }
 
-func (rc ReprtClient) FrontOfCard(w http.ResponseWriter, r *http.Request, deckID, cardID string) {
-	logger := rc.logger.With().Str("method", "FrontOfCard").Logger()
-	logger.Info().Msgf("getting front of card with deckID and cardID: %s %s", deckID, cardID)
+func (rc ReprtClient) RetryMissedCards(w http.ResponseWriter, r *http.Request, sessionID string) {
+	logger := rc.logger.With().Str("method", "RetryMissedCards").Logger()
+	logger.Info().Msgf("retrying missed cards of session %s", sessionID)
 
 	username, ok := reptrCtx.Username(r.Context())
 	if !ok {
 		logger.Error().Msgf("username is not on context")
 		rc.serveError(w, r, pages.ErrorPageData{
 			StatusCode: strconv.Itoa(http.StatusInternalServerError),
-			Status:     "Internal Server Error",
+			Status:     http.StatusText(http.StatusInternalServerError),
 			Error:      "username not on context",
 			Msg:        "Try logging back in.",
 		})
 		return
 	}
 
-	frontOfCard, err := rc.deckController.GetFrontOfCardByID(r.Context(), deckID, cardID, username)
+	s, err := rc.sessionController.StartRetrySession(r.Context(), sessionID, username)
 	if err != nil {
-		logger.Error().Err(err).Msgf("while getting front of card for cardID: %s", cardID)
+		logger.Error().Err(err).Msgf("while starting retry of session %s", sessionID)
 		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
 			StatusCode: strconv.Itoa(status),
 			Status:     http.StatusText(status),
-			Error:      "while getting front of card",
-			Msg:        "Something went wrong while getting front of card.",
+			Error:      "while starting retry session",
+			Msg:        "Problem retrying missed cards.",
 		})
-		http.Error(w, "while getting front of card", toStatus(err))
 		return
 	}
 
-	s, err := rc.sessionController.GetActiveSessionForUserAndDeckID(r.Context(), username, deckID)
-	if err != nil {
-		logger.Error().Err(err).Msgf("failed to get session")
+	w.Header().Set(hxRedirectHeaderKey, path.Join("/page/study/", s.ID))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (rc ReprtClient) StudySessionPage(w http.ResponseWriter, r *http.Request, sessionID string) {
+	logger := rc.logger.With().Str("method", "StudySessionPage").Logger()
+	logger.Info().Msgf("serving study session %s", sessionID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
 		rc.serveError(w, r, pages.ErrorPageData{
 			StatusCode: strconv.Itoa(http.StatusInternalServerError),
 			Status:     http.StatusText(http.StatusInternalServerError),
-			Error:      "not able to get session",
+			Error:      "username not on context",
 			Msg:        "Try logging back in.",
 		})
 		return
 	}
 
-	err = rc.sessionController.SetCurrentCard(r.Context(), s.ID, cardID, true)
+	s, err := rc.studySession(r.Context(), username, "", &sessionID)
 	if err != nil {
-		logger.Error().Err(err).Msgf("failed to update card orientation")
+		logger.Error().Err(err).Msgf("while getting session %s", sessionID)
+		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusInternalServerError),
-			Status:     http.StatusText(http.StatusInternalServerError),
-			Error:      "username not on context",
-			Msg:        "Try logging back in.",
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting session",
+			Msg:        "Problem getting study session.",
 		})
 		return
 	}
-
-	dumb.FrontCardDisplay(dumb.CardFront{
-		SessionID:      s.ID,
-		DeckID:         deckID,
-		CardID:         frontOfCard.CardID,
-		Front:          frontOfCard.Content,
-		NextCardID:     frontOfCard.NextCard,
-		PreviousCardID: frontOfCard.PreviousCard,
-		Downvotes:      strconv.Itoa(frontOfCard.Downvotes),
-		Upvotes:        strconv.Itoa(frontOfCard.Upvotes),
-		CardType:       "",
-	}).Render(r.Context(), w)
-}
-
-func (rc ReprtClient) UpdateCardCorrect(w http.ResponseWriter, r *http.Request, sessionID string) {
-	logger := rc.logger.With().Str("method", "CardAnswerCorrect").Logger()
-	logger.Info().Msgf("getting front of card with ID: %s", sessionID)
-
-	if sessionID == "" {
-		logger.Error().Msgf("error updating card without sessionID")
+	if s.FinishedAt != nil {
+		http.Redirect(w, r, path.Join("/page/session-summary/", s.ID), http.StatusSeeOther)
+		return
+	}
+	if s.AbandonedAt != nil {
+		logger.Info().Msgf("session %s was abandoned", s.ID)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusBadRequest),
-			Status:     http.StatusText(http.StatusBadRequest),
-			Error:      "get front of card without deckID",
-			Msg:        "Problem processing getting front of card.",
+			StatusCode: strconv.Itoa(http.StatusGone),
+			Status:     http.StatusText(http.StatusGone),
+			Error:      "session was abandoned",
+			Msg:        "This session expired after being left idle. Start a new one from the home page.",
 		})
 		return
 	}
 
-	cardResponse, err := rc.deckViewerController.AnswerCurrentCard(r.Context(), sessionID, true)
+	content, err := rc.getCardViewerContent(r.Context(), username, s)
 	if err != nil {
-		logger.Error().Err(err).Msg("while AnsweringCurrentCard")
+		logger.Error().Err(err).Msgf("while getting card content for session %s", s.ID)
+		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusInternalServerError),
-			Status:     http.StatusText(http.StatusInternalServerError),
-			Error:      "while AnsweringCurrentCard",
-			Msg:        "Problem answering card.",
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting card content",
+			Msg:        "Problem getting study session.",
 		})
 		return
 	}
 
-	cardResponse.Render(r.Context(), w)
+	pages.Page(pages.PageData{Title: s.DeckName}, pages.DeckViewerPage(content), append(cssFileArr, deckViewStyle)).Render(r.Context(), w)
 }
 
-func (rc ReprtClient) UpdateCardIncorrect(w http.ResponseWriter, r *http.Request, sessionID string) {
-	logger := rc.logger.With().Str("method", "UpdateCardIncorrect").Logger()
-	logger.Info().Msgf("getting front of card with ID: %s", sessionID)
+func (rc ReprtClient) getCardViewerContent(ctx context.Context, username string, s models.DeckSession) (pages.DeckViewPageData, error) {
+	deckID := s.CurrentDeckID()
+	if s.IsFront {
+		f, err := rc.deckController.GetFrontOfCardByID(ctx, deckID, s.CurrentCardID, username, s.IsReversed, s.Tags)
+		if err != nil {
+			return pages.DeckViewPageData{}, err
+		}
+		// The front is being shown again, so its answer is timed from now rather than from when it was first shown.
+		err = rc.sessionController.SetCurrentCard(ctx, s.ID, s.CurrentCardID, s.IsReversed, true)
+		if err != nil {
+			return pages.DeckViewPageData{}, err
+		}
+		previous, next := studyNeighbours(s, deckID, s.CurrentCardID, s.IsReversed, f.PreviousCard, f.NextCard)
+		return pages.DeckViewPageData{
+			DeckName: s.DeckName,
+			DeckID:   s.DeckID,
+			Content: dumb.FrontCardDisplay(dumb.CardFront{
+				SessionID:        s.ID,
+				DeckID:           deckID,
+				CardType:         f.Kind.String(),
+				Typed:            s.Mode == models.TypedMode,
+				Choices:          dumb.Choices(s.ID, f),
+				Media:            f.Media,
+				Occlusion:        f.Occlusion,
+				CardID:           s.CurrentCardID,
+				Reversed:         s.IsReversed,
+				Front:            f.Content,
+				Upvotes:          strconv.Itoa(f.Upvotes),
+				Downvotes:        strconv.Itoa(f.Downvotes),
+				PreviousCardID:   previous.CardID,
+				PreviousDeckID:   previous.DeckID,
+				PreviousReversed: previous.Reversed,
+				NextCardID:       next.CardID,
+				NextDeckID:       next.DeckID,
+				NextReversed:     next.Reversed,
+			}),
+		}, err
+	}
 
-	if sessionID == "" {
-		logger.Error().Msgf("error updating card without sessionID")
-		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusBadRequest),
+	b, err := rc.deckController.GetBackOfCardByID(ctx, deckID, s.CurrentCardID, username, s.IsReversed, s.Tags)
+	if err != nil {
+		return pages.DeckViewPageData{}, err
+	}
+	previous, next := studyNeighbours(s, deckID, s.CurrentCardID, s.IsReversed, b.PreviousCard, b.NextCard)
+	return pages.DeckViewPageData{
+		DeckName: s.DeckName,
+		DeckID:   s.DeckID,
+		Content: dumb.BackOfCardDisplay(dumb.CardBack{
+			SessionID:        s.ID,
+			DeckID:           deckID,
+			CardID:           s.CurrentCardID,
+			Reversed:         s.IsReversed,
+			BackContent:      b.Answer,
+			NextCardID:       next.CardID,
+			NextDeckID:       next.DeckID,
+			NextReversed:     next.Reversed,
+			PreviousCardID:   previous.CardID,
+			PreviousDeckID:   previous.DeckID,
+			PreviousReversed: previous.Reversed,
+			IsUpvoted:        bool(b.IsUpvotedByUser),
+			IsDownvoted:      bool(b.IsDownvotedByUser),
+			Choices:          dumb.AnswerChoices(s.ID, b),
+			Cloze:            dumb.ClozeNote(b),
+			Media:            b.Media,
+			Occlusion:        b.Occlusion,
+		}),
+	}, err
+
+}
+
+// studySession returns the session a card is being studied in: the session named in the request when there is one,
+// otherwise the user's session for the deck.
+func (rc ReprtClient) studySession(ctx context.Context, username, deckID string, sessionID *string) (models.DeckSession, error) {
+	if sessionID == nil || *sessionID == "" {
+		return rc.sessionController.GetActiveSessionForUserAndDeckID(ctx, username, deckID, nil, models.DefaultCardOrder, models.ForwardDirection, models.SelfGradedMode)
+	}
+
+	s, err := rc.sessionController.GetSessionByID(ctx, *sessionID)
+	if err != nil {
+		return models.DeckSession{}, err
+	}
+	if s.Username != username {
+		return models.DeckSession{}, database.ErrNoResults
+	}
+	return s, nil
+}
+
+func (rc ReprtClient) CreateCardForDeck(w http.ResponseWriter, r *http.Request, deckID string) {
+	logger := rc.logger.With().Str("method", "CreateCardForDeck").Logger()
+	logger.Info().Msg("creating card")
+
+	err := parseCardForm(w, r)
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to parse form")
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "unable to parse form",
+			Msg:        "Problem creating card",
+		})
+		return
+	}
+
+	if deckID == "" {
+		logger.Error().Msgf("create deck attempt without deckID")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      "create deck attempt without deckID",
+			Msg:        "Problem creating card",
+		})
+		return
+	}
+
+	cardFront := r.PostForm.Get("card-front")
+	if cardFront == "" {
+		logger.Info().Msgf("create deck attempt without card front")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      "create deck attempt without card front",
+			Msg:        "Problem creating card",
+		})
+		return
+	}
+
+	kind := models.BasicCard
+	var options []models.CardOption
+	var note string
+	var occlusion *models.Occlusion
+	switch r.PostForm.Get("card-type") {
+	case multipleChoiceCardType:
+		kind = models.MultipleChoice
+		options = cardOptions(r.PostForm["option"], r.PostForm["correct"])
+	case clozeCardType:
+		kind = models.Cloze
+		note = cardFront
+	case occlusionCardType:
+		kind = models.ImageOcclusion
+		occlusion = &models.Occlusion{Masks: occlusionMasks(r.PostForm)}
+	}
+
+	cardBack := r.PostForm.Get("card-back")
+	if cardBack == "" && kind == models.BasicCard {
+		logger.Error().Msgf("create deck attempt without card back")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      "create deck attempt without card back",
+			Msg:        "Problem creating card",
+		})
+		return
+	}
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     "Internal Server Error",
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	frontMedia, backMedia, err := rc.uploadCardMedia(r, username)
+	if err == nil && occlusion != nil {
+		occlusion.Image, err = rc.uploadOcclusionImage(r, username)
+		if err != nil {
+			rc.discardMedia(r, append(frontMedia, backMedia...)...)
+		}
+	}
+	if err != nil {
+		logger.Error().Err(err).Msgf("while uploading media for a card of deck %s", deckID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while uploading card media",
+			Msg:        "Problem attaching media to card",
+		})
+		return
+	}
+
+	timeNow := time.Now().UTC()
+	err = rc.deckController.AddCardToDeck(r.Context(), deckID, models.Card{
+		ID:         uuid.NewString(),
+		Front:      cardFront,
+		Back:       cardBack,
+		Kind:       kind,
+		DeckID:     deckID,
+		CreatedAt:  timeNow,
+		UpdatedAt:  timeNow,
+		CreatedBy:  username,
+		Options:    options,
+		Note:       note,
+		FrontMedia: frontMedia,
+		BackMedia:  backMedia,
+		Occlusion:  occlusion,
+		Tags:       models.ParseTags(r.PostForm.Get("tags")),
+	})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while creating a card for deck %s", deckID)
+		uploaded := append(frontMedia, backMedia...)
+		if occlusion != nil && occlusion.Image.ID != "" {
+			uploaded = append(uploaded, occlusion.Image)
+		}
+		rc.discardMedia(r, uploaded...)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while creating a card for deck",
+			Msg:        "Problem creating card",
+		})
+		return
+	}
+
+	w.Header().Set(hxTriggerHeaderKey, "newCard")
+	w.WriteHeader(http.StatusCreated)
+}
+
+// CardPreview renders a card being written from its Markdown, as it will be shown while studying.
+func (rc ReprtClient) CardPreview(w http.ResponseWriter, r *http.Request) {
+	logger := rc.logger.With().Str("method", "CardPreview").Logger()
+
+	err := r.ParseForm()
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to parse form")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      "unable to parse form",
+			Msg:        "Problem previewing card.",
+		})
+		return
+	}
+
+	dumb.CardPreview(r.PostForm.Get("card-front"), r.PostForm.Get("card-back")).Render(r.Context(), w)
+}
+
+// occlusionMasks builds the masks of an image occlusion card from the geometry and label of each drawn rectangle.
+// Rectangles with geometry that does not parse are dropped.
+func occlusionMasks(form url.Values) []models.Region {
+	labels := form["mask-label"]
+	masks := make([]models.Region, 0, len(labels))
+	for i, label := range labels {
+		x, errX := strconv.ParseFloat(formIndex(form, "mask-x", i), 64)
+		y, errY := strconv.ParseFloat(formIndex(form, "mask-y", i), 64)
+		width, errWidth := strconv.ParseFloat(formIndex(form, "mask-width", i), 64)
+		height, errHeight := strconv.ParseFloat(formIndex(form, "mask-height", i), 64)
+		if errors.Join(errX, errY, errWidth, errHeight) != nil {
+			continue
+		}
+		masks = append(masks, models.Region{X: x, Y: y, Width: width, Height: height, Label: label})
+	}
+	return masks
+}
+
+// formIndex returns the i-th value of a repeated form field, or "" when there are fewer.
+func formIndex(form url.Values, key string, i int) string {
+	values := form[key]
+	if i >= len(values) {
+		return ""
+	}
+	return values[i]
+}
+
+// cardOptions builds the options of a multiple choice card from the text of each option and the indexes of the
+// correct ones.
+func cardOptions(texts, correct []string) []models.CardOption {
+	options := make([]models.CardOption, len(texts))
+	for i, text := range texts {
+		options[i] = models.CardOption{Text: text}
+	}
+	for _, c := range correct {
+		i, err := strconv.Atoi(c)
+		if err != nil || i < 0 || i >= len(options) {
+			continue
+		}
+		options[i].IsCorrect = true
+	}
+	return options
+}
+
+func (rc ReprtClient) BackOfCard(w http.ResponseWriter, r *http.Request, deckID, cardID string, params api.BackOfCardParams) {
+	logger := rc.logger.With().Str("method", "BackOfCard").Logger()
+	logger.Info().Msgf("getting back of card for deckID and cardID: %s %s", deckID, cardID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	s, err := rc.studySession(r.Context(), username, deckID, params.SessionId)
+	if err != nil {
+		logger.Error().Err(err).Msgf("failed to get session")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "not able to get session",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	if s.Mode == models.TypedMode {
+		logger.Error().Msgf("back of card requested before typing an answer in session %s", s.ID)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusForbidden),
+			Status:     http.StatusText(http.StatusForbidden),
+			Error:      "answer must be typed",
+			Msg:        "Type your answer to see the back of the card.",
+		})
+		return
+	}
+
+	reversed := params.Reversed != nil && *params.Reversed
+	backOfCard, err := rc.deckController.GetBackOfCardByID(r.Context(), deckID, cardID, username, reversed, s.Tags)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting back of card for cardID: %s", s.CurrentCardID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting back of card",
+			Msg:        "Problem processing getting back of card.",
+		})
+		return
+	}
+
+	// The back of a multiple choice card marks the correct options, so it is only shown once they have been picked.
+	if backOfCard.IsChoice() && !s.HasAnswered(backOfCard.CardID, backOfCard.Reversed) {
+		logger.Error().Msgf("back of card %s requested before picking an answer in session %s", backOfCard.CardID, s.ID)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusForbidden),
+			Status:     http.StatusText(http.StatusForbidden),
+			Error:      "answer must be picked",
+			Msg:        "Pick your answer to see the back of the card.",
+		})
+		return
+	}
+
+	err = rc.sessionController.UpdateCardOrientation(r.Context(), s.ID, false)
+	if err != nil {
+		logger.Error().Err(err).Msgf("failed to update card orientation")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	previous, next := studyNeighbours(s, deckID, backOfCard.CardID, backOfCard.Reversed, backOfCard.PreviousCard, backOfCard.NextCard)
+	dumb.BackOfCardDisplay(dumb.CardBack{
+		SessionID:        s.ID,
+		DeckID:           deckID,
+		CardID:           backOfCard.CardID,
+		Reversed:         backOfCard.Reversed,
+		BackContent:      backOfCard.Answer,
+		NextCardID:       next.CardID,
+		NextDeckID:       next.DeckID,
+		NextReversed:     next.Reversed,
+		PreviousCardID:   previous.CardID,
+		PreviousDeckID:   previous.DeckID,
+		PreviousReversed: previous.Reversed,
+		IsUpvoted:        bool(backOfCard.IsUpvotedByUser),
+		IsDownvoted:      bool(backOfCard.IsDownvotedByUser),
+		Choices:          dumb.AnswerChoices(s.ID, backOfCard),
+		Cloze:            dumb.ClozeNote(backOfCard),
+		Media:            backOfCard.Media,
+		Occlusion:        backOfCard.Occlusion,
+		VoteButtonData: dumb.VoteButtonsData{
+			CardID:            backOfCard.CardID,
+			UpvoteClass:       backOfCard.IsUpvotedByUser.UpvotedClass(),
+			DownvoteClass:     backOfCard.IsDownvotedByUser.DownvotedClass(),
+			UpvoteDirection:   backOfCard.IsUpvotedByUser.NextUpvoteDirection(),
+			DownvoteDirection: backOfCard.IsDownvotedByUser.DownvotedClass()},
+	}).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) FrontOfCard(w http.ResponseWriter, r *http.Request, deckID, cardID string, params api.FrontOfCardParams) {
+	logger := rc.logger.With().Str("method", "FrontOfCard").Logger()
+	logger.Info().Msgf("getting front of card with deckID and cardID: %s %s", deckID, cardID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     "Internal Server Error",
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	s, err := rc.studySession(r.Context(), username, deckID, params.SessionId)
+	if err != nil {
+		logger.Error().Err(err).Msgf("failed to get session")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "not able to get session",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	reversed := params.Reversed != nil && *params.Reversed
+	frontOfCard, err := rc.deckController.GetFrontOfCardByID(r.Context(), deckID, cardID, username, reversed, s.Tags)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting front of card for cardID: %s", cardID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting front of card",
+			Msg:        "Something went wrong while getting front of card.",
+		})
+		http.Error(w, "while getting front of card", toStatus(err))
+		return
+	}
+
+	err = rc.sessionController.SetCurrentCard(r.Context(), s.ID, cardID, reversed, true)
+	if err != nil {
+		logger.Error().Err(err).Msgf("failed to update card orientation")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	previous, next := studyNeighbours(s, deckID, frontOfCard.CardID, frontOfCard.Reversed, frontOfCard.PreviousCard, frontOfCard.NextCard)
+	dumb.FrontCardDisplay(dumb.CardFront{
+		SessionID:        s.ID,
+		DeckID:           deckID,
+		CardID:           frontOfCard.CardID,
+		Reversed:         frontOfCard.Reversed,
+		Front:            frontOfCard.Content,
+		NextCardID:       next.CardID,
+		NextDeckID:       next.DeckID,
+		NextReversed:     next.Reversed,
+		PreviousCardID:   previous.CardID,
+		PreviousDeckID:   previous.DeckID,
+		PreviousReversed: previous.Reversed,
+		Downvotes:        strconv.Itoa(frontOfCard.Downvotes),
+		Upvotes:          strconv.Itoa(frontOfCard.Upvotes),
+		CardType:         frontOfCard.Kind.String(),
+		Typed:            s.Mode == models.TypedMode,
+		Choices:          dumb.Choices(s.ID, frontOfCard),
+		Media:            frontOfCard.Media,
+		Occlusion:        frontOfCard.Occlusion,
+	}).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) AnswerCard(w http.ResponseWriter, r *http.Request, sessionID string, grade api.AnswerCardParamsGrade) {
+	logger := rc.logger.With().Str("method", "AnswerCard").Logger()
+	logger.Info().Msgf("answering current card of session %s with grade %s", sessionID, grade)
+
+	if sessionID == "" {
+		logger.Error().Msgf("error updating card without sessionID")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      "answer card without sessionID",
+			Msg:        "Problem processing answering card.",
+		})
+		return
+	}
+
+	answerGrade := models.GradeFromString(string(grade))
+	if !answerGrade.IsValid() {
+		logger.Error().Msgf("invalid grade %s", grade)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      fmt.Sprintf("invalid grade %s", grade),
+			Msg:        "Problem processing answering card.",
+		})
+		return
+	}
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	cardResponse, isFinished, err := rc.deckViewerController.AnswerCurrentCard(r.Context(), sessionID, username, answerGrade)
+	if err != nil {
+		logger.Error().Err(err).Msg("while AnsweringCurrentCard")
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while AnsweringCurrentCard",
+			Msg:        "Problem answering card.",
+		})
+		return
+	}
+
+	if isFinished {
+		w.Header().Set(hxRedirectHeaderKey, path.Join("/page/session-summary/", sessionID))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	cardResponse.Render(r.Context(), w)
+}
+
+func (rc ReprtClient) AnswerCardTyped(w http.ResponseWriter, r *http.Request, sessionID string) {
+	logger := rc.logger.With().Str("method", "AnswerCardTyped").Logger()
+	logger.Info().Msgf("answering current card of session %s by typing", sessionID)
+
+	err := r.ParseForm()
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to parse form")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      "unable to parse form",
+			Msg:        "Problem processing answering card.",
+		})
+		return
+	}
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	result, err := rc.deckViewerController.AnswerCurrentCardTyped(r.Context(), sessionID, username, r.PostForm.Get("answer"))
+	if err != nil {
+		logger.Error().Err(err).Msg("while AnswerCurrentCardTyped")
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while AnswerCurrentCardTyped",
+			Msg:        "Problem answering card.",
+		})
+		return
+	}
+
+	result.Render(r.Context(), w)
+}
+
+func (rc ReprtClient) AnswerCardChoice(w http.ResponseWriter, r *http.Request, sessionID string) {
+	logger := rc.logger.With().Str("method", "AnswerCardChoice").Logger()
+	logger.Info().Msgf("answering current card of session %s by picking options", sessionID)
+
+	err := r.ParseForm()
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to parse form")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      "unable to parse form",
+			Msg:        "Problem processing answering card.",
+		})
+		return
+	}
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	result, err := rc.deckViewerController.AnswerCurrentCardChoice(r.Context(), sessionID, username, r.PostForm["option"])
+	if err != nil {
+		logger.Error().Err(err).Msg("while AnswerCurrentCardChoice")
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while AnswerCurrentCardChoice",
+			Msg:        "Problem answering card.",
+		})
+		return
+	}
+
+	result.Render(r.Context(), w)
+}
+
+func (rc ReprtClient) SessionSummaryPage(w http.ResponseWriter, r *http.Request, sessionID string) {
+	logger := rc.logger.With().Str("method", "SessionSummaryPage").Logger()
+	logger.Info().Msgf("serving summary for session %s", sessionID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	summary, err := rc.sessionController.GetSessionSummary(r.Context(), sessionID, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting summary for session %s", sessionID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting session summary",
+			Msg:        "Problem getting session summary.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Session Summary"}, pages.SessionSummary(sessionSummaryFromModel(summary)), append(cssFileArr, tableStyle, deckViewStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) HistoryPage(w http.ResponseWriter, r *http.Request, params api.HistoryPageParams) {
+	logger := rc.logger.With().Str("method", "HistoryPage").Logger()
+	logger.Info().Msgf("serving session history with: %+v", params)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	var deckID string
+	if params.DeckId != nil {
+		deckID = *params.DeckId
+	}
+	offset := 0
+	if params.Offset != nil && *params.Offset > 0 {
+		offset = *params.Offset
+	}
+
+	// Ask for one more session than is shown to know whether there is an older page.
+	history, err := rc.sessionController.GetSessionHistory(r.Context(), username, deckID, time.Time{}, nil, historyPageSize+1, offset)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting session history for user %s", username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting session history",
+			Msg:        "Problem getting session history.",
+		})
+		return
+	}
+
+	data := pages.HistoryData{
+		DeckID:         deckID,
+		HasPrevious:    offset > 0,
+		PreviousOffset: max(offset-historyPageSize, 0),
+		HasNext:        len(history) > historyPageSize,
+		NextOffset:     offset + historyPageSize,
+	}
+	if data.HasNext {
+		history = history[:historyPageSize]
+	}
+	data.Sessions = make([]pages.SessionHistoryRow, len(history))
+	for i, summary := range history {
+		data.Sessions[i] = pages.SessionHistoryRow{
+			SessionID:      summary.SessionID,
+			DeckName:       summary.DeckName,
+			Date:           summary.StartedAt.Format(time.DateOnly),
+			TotalCards:     summary.TotalCards,
+			PercentCorrect: summary.PercentCorrect(),
+			TimeTaken:      summary.TimeTaken.Round(time.Second).String(),
+		}
+	}
+
+	pages.Page(pages.PageData{Title: "Study History"}, pages.History(data), append(cssFileArr, tableStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) HoldCard(w http.ResponseWriter, r *http.Request, sessionID string, hold api.HoldCardParamsHold) {
+	logger := rc.logger.With().Str("method", "HoldCard").Logger()
+	logger.Info().Msgf("putting a %s hold on current card of session %s", hold, sessionID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	cardResponse, isFinished, err := rc.deckViewerController.HoldCurrentCard(r.Context(), sessionID, username, models.HoldKind(hold))
+	if err != nil {
+		logger.Error().Err(err).Msg("while HoldCurrentCard")
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while HoldCurrentCard",
+			Msg:        "Problem setting card aside.",
+		})
+		return
+	}
+
+	if isFinished {
+		w.Header().Set(hxRedirectHeaderKey, path.Join("/page/session-summary/", sessionID))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	cardResponse.Render(r.Context(), w)
+}
+
+func (rc ReprtClient) SuspendedCardsPage(w http.ResponseWriter, r *http.Request) {
+	logger := rc.logger.With().Str("method", "SuspendedCardsPage").Logger()
+	logger.Info().Msgf("serving suspended cards")
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	cards, err := rc.deckController.GetSuspendedCardsForUser(r.Context(), username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting suspended cards for user %s", username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting suspended cards",
+			Msg:        "Problem getting suspended cards.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Suspended Cards"}, pages.SuspendedCards(suspendedCardsFromModel(cards)), append(cssFileArr, tableStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) UnsuspendCards(w http.ResponseWriter, r *http.Request) {
+	logger := rc.logger.With().Str("method", "UnsuspendCards").Logger()
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to parse form")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
 			Status:     http.StatusText(http.StatusBadRequest),
-			Error:      "get front of card without sessionID",
-			Msg:        "Problem processing answering card.",
+			Error:      "unable to parse form",
+			Msg:        "Problem unsuspending cards.",
+		})
+		return
+	}
+	cardIDs := r.PostForm["card_id"]
+	logger.Info().Msgf("unsuspending %d cards for user %s", len(cardIDs), username)
+
+	err = rc.deckController.UnsuspendCards(r.Context(), username, cardIDs)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while unsuspending cards for user %s", username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while unsuspending cards",
+			Msg:        "Problem unsuspending cards.",
+		})
+		return
+	}
+
+	cards, err := rc.deckController.GetSuspendedCardsForUser(r.Context(), username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting suspended cards for user %s", username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting suspended cards",
+			Msg:        "Problem getting suspended cards.",
+		})
+		return
+	}
+
+	pages.SuspendedCardList(suspendedCardsFromModel(cards)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) CardHistoryPage(w http.ResponseWriter, r *http.Request, cardID string) {
+	logger := rc.logger.With().Str("method", "CardHistoryPage").Logger()
+	logger.Info().Msgf("serving history of card %s", cardID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	history, err := rc.deckController.GetCardHistory(r.Context(), cardID, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting history of card %s for user %s", cardID, username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting card history",
+			Msg:        "Problem getting card history.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Card History"}, pages.CardHistory(cardHistoryFromModel(history)), append(cssFileArr, tableStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) EditCardPage(w http.ResponseWriter, r *http.Request, cardID string) {
+	logger := rc.logger.With().Str("method", "EditCardPage").Logger()
+	logger.Info().Msgf("serving edit page of card %s", cardID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	card, err := rc.deckController.GetCardToEdit(r.Context(), cardID, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting card %s for user %s to edit", cardID, username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting card to edit",
+			Msg:        "Problem getting card.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Edit Card"}, pages.Form(nil, pages.EditCardForm(editCardFromModel(card))), append(cssFileArr, formStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) EditCard(w http.ResponseWriter, r *http.Request, cardID string) {
+	logger := rc.logger.With().Str("method", "EditCard").Logger()
+	logger.Info().Msgf("editing card %s", cardID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to parse form")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusBadRequest),
+			Status:     http.StatusText(http.StatusBadRequest),
+			Error:      "unable to parse form",
+			Msg:        "Problem saving card.",
+		})
+		return
+	}
+
+	// The card is looked up first for its deck, as editing a cloze note can remove the card.
+	card, err := rc.deckController.GetCardToEdit(r.Context(), cardID, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting card %s for user %s to edit", cardID, username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting card to edit",
+			Msg:        "Problem getting card.",
+		})
+		return
+	}
+
+	err = rc.deckController.EditCard(r.Context(), username, models.Card{
+		ID:    cardID,
+		Front: r.PostForm.Get("card-front"),
+		Back:  r.PostForm.Get("card-back"),
+		Tags:  models.ParseTags(r.PostForm.Get("tags")),
+	})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while editing card %s", cardID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      err.Error(),
+			Msg:        "Problem saving card.",
+		})
+		return
+	}
+
+	http.Redirect(w, r, path.Join("/page/create-cards/", card.DeckID), http.StatusSeeOther)
+}
+
+func (rc ReprtClient) StatsPage(w http.ResponseWriter, r *http.Request) {
+	logger := rc.logger.With().Str("method", "StatsPage").Logger()
+	logger.Info().Msgf("serving learning stats")
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
 		})
 		return
 	}
 
-	cardResponse, err := rc.deckViewerController.AnswerCurrentCard(r.Context(), sessionID, false)
+	preferences, err := rc.sessionController.GetStudyPreferences(r.Context(), username)
 	if err != nil {
-		logger.Error().Err(err).Msg("while AnsweringCurrentCard")
+		logger.Error().Err(err).Msgf("while getting study preferences for user %s", username)
+		status := toStatus(err)
 		rc.serveError(w, r, pages.ErrorPageData{
-			StatusCode: strconv.Itoa(http.StatusInternalServerError),
-			Status:     http.StatusText(http.StatusInternalServerError),
-			Error:      "while AnsweringCurrentCard",
-			Msg:        "Problem answering card.",
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting study preferences",
+			Msg:        "Problem getting your stats.",
 		})
 		return
 	}
 
-	cardResponse.Render(r.Context(), w)
+	stats, err := rc.sessionController.GetLearningStats(r.Context(), username, time.Now().In(preferences.Location()), statsDays)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting learning stats for user %s", username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting learning stats",
+			Msg:        "Problem getting your stats.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Stats"}, pages.Stats(learningStatsFromModel(stats, statsTrendDays)), append(cssFileArr, tableStyle)).Render(r.Context(), w)
 }
 
 func (rc ReprtClient) VoteCard(w http.ResponseWriter, r *http.Request, cardID string, direction string) {
@@ -1083,6 +2117,254 @@ func (rc ReprtClient) VoteCard(w http.ResponseWriter, r *http.Request, cardID st
 	}).Render(r.Context(), w)
 }
 
+func (rc ReprtClient) DeckSettingsPage(w http.ResponseWriter, r *http.Request, deckID string) {
+	logger := rc.logger.With().Str("method", "DeckSettingsPage").Logger()
+	logger.Info().Msgf("serving deck settings page for: %s", deckID)
+
+	deck, err := rc.deckController.GetDeckByID(r.Context(), deckID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting deck %s", deckID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting deck",
+			Msg:        "Problem getting deck settings.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Deck Settings"}, pages.Form(nil, pages.DeckSettingsForm(deckSettingsFromModel(deck))), append(cssFileArr, formStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) DeckStatsPage(w http.ResponseWriter, r *http.Request, deckID string) {
+	logger := rc.logger.With().Str("method", "DeckStatsPage").Logger()
+	logger.Info().Msgf("serving deck stats page for: %s", deckID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	stats, err := rc.deckController.GetDeckStats(r.Context(), deckID, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting stats for deck %s", deckID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting deck stats",
+			Msg:        "Problem getting card stats.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Card Stats"}, pages.DeckStats(deckStatsFromModel(stats)), append(cssFileArr, tableStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) UpdateDeckSettings(w http.ResponseWriter, r *http.Request, deckID string) {
+	logger := rc.logger.With().Str("method", "UpdateDeckSettings").Logger()
+	logger.Info().Msgf("updating deck settings for: %s", deckID)
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to parse form")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "unable to parse form",
+			Msg:        "Problem saving deck settings.",
+		})
+		return
+	}
+
+	settings := models.SchedulerSettings{
+		Algorithm:      models.SchedulerAlgorithm(r.PostForm.Get("scheduler")),
+		SuspendLeeches: r.PostForm.Get("suspend-leeches") == "true",
+	}
+	if retention := r.PostForm.Get("target-retention"); retention != "" {
+		settings.TargetRetention, err = strconv.ParseFloat(retention, 64)
+		if err != nil {
+			logger.Error().Err(err).Msgf("invalid target retention: %s", retention)
+			rc.serveError(w, r, pages.ErrorPageData{
+				StatusCode: strconv.Itoa(http.StatusBadRequest),
+				Status:     http.StatusText(http.StatusBadRequest),
+				Error:      "target retention must be a number",
+				Msg:        "Problem saving deck settings.",
+			})
+			return
+		}
+	}
+
+	if threshold := r.PostForm.Get("leech-threshold"); threshold != "" {
+		settings.LeechThreshold, err = strconv.Atoi(threshold)
+		if err != nil {
+			logger.Error().Err(err).Msgf("invalid leech threshold: %s", threshold)
+			rc.serveError(w, r, pages.ErrorPageData{
+				StatusCode: strconv.Itoa(http.StatusBadRequest),
+				Status:     http.StatusText(http.StatusBadRequest),
+				Error:      "leech threshold must be a whole number",
+				Msg:        "Problem saving deck settings.",
+			})
+			return
+		}
+	}
+
+	err = rc.deckController.UpdateDeckScheduler(r.Context(), deckID, username, settings)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while updating scheduler for deck %s", deckID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      err.Error(),
+			Msg:        "Problem saving deck settings.",
+		})
+		return
+	}
+
+	deck, err := rc.deckController.GetDeckByID(r.Context(), deckID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting deck %s", deckID)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting deck",
+			Msg:        "Problem getting deck settings.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Deck Settings"}, pages.Form(pages.Banner("Deck Settings Saved"), pages.DeckSettingsForm(deckSettingsFromModel(deck))), append(cssFileArr, formStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) StudySettingsPage(w http.ResponseWriter, r *http.Request) {
+	logger := rc.logger.With().Str("method", "StudySettingsPage").Logger()
+	logger.Info().Msgf("serving study settings page")
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	preferences, err := rc.sessionController.GetStudyPreferences(r.Context(), username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting study preferences for user %s", username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting study preferences",
+			Msg:        "Problem getting study settings.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Study Settings"}, pages.Form(nil, pages.StudySettingsForm(studySettingsFromModel(preferences))), append(cssFileArr, formStyle)).Render(r.Context(), w)
+}
+
+func (rc ReprtClient) UpdateStudySettings(w http.ResponseWriter, r *http.Request) {
+	logger := rc.logger.With().Str("method", "UpdateStudySettings").Logger()
+	logger.Info().Msgf("updating study settings")
+
+	username, ok := reptrCtx.Username(r.Context())
+	if !ok {
+		logger.Error().Msgf("username is not on context")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "username not on context",
+			Msg:        "Try logging back in.",
+		})
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to parse form")
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(http.StatusInternalServerError),
+			Status:     http.StatusText(http.StatusInternalServerError),
+			Error:      "unable to parse form",
+			Msg:        "Problem saving study settings.",
+		})
+		return
+	}
+
+	preferences := models.StudyPreferences{
+		TimeZone:  strings.TrimSpace(r.PostForm.Get("time-zone")),
+		DailyGoal: models.DailyGoal{Kind: models.GoalKind(r.PostForm.Get("goal-kind"))},
+	}
+	if target := r.PostForm.Get("goal-target"); target != "" {
+		preferences.DailyGoal.Target, err = strconv.Atoi(target)
+		if err != nil {
+			logger.Error().Err(err).Msgf("invalid goal target: %s", target)
+			rc.serveError(w, r, pages.ErrorPageData{
+				StatusCode: strconv.Itoa(http.StatusBadRequest),
+				Status:     http.StatusText(http.StatusBadRequest),
+				Error:      "daily target must be a whole number",
+				Msg:        "Problem saving study settings.",
+			})
+			return
+		}
+	}
+
+	err = rc.sessionController.UpdateStudyPreferences(r.Context(), username, preferences)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while updating study preferences for user %s", username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      err.Error(),
+			Msg:        "Problem saving study settings.",
+		})
+		return
+	}
+
+	preferences, err = rc.sessionController.GetStudyPreferences(r.Context(), username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting study preferences for user %s", username)
+		status := toStatus(err)
+		rc.serveError(w, r, pages.ErrorPageData{
+			StatusCode: strconv.Itoa(status),
+			Status:     http.StatusText(status),
+			Error:      "while getting study preferences",
+			Msg:        "Problem getting study settings.",
+		})
+		return
+	}
+
+	pages.Page(pages.PageData{Title: "Study Settings"}, pages.Form(pages.Banner("Study Settings Saved"), pages.StudySettingsForm(studySettingsFromModel(preferences))), append(cssFileArr, formStyle)).Render(r.Context(), w)
+}
+
 func (rc ReprtClient) serveError(w http.ResponseWriter, r *http.Request, data pages.ErrorPageData) {
 	code, err := strconv.Atoi(data.StatusCode)
 	if err != nil {
@@ -1098,9 +2380,43 @@ func toStatus(err error) int {
 		errors.Is(err, decks.ErrInvalidGroupName),
 		errors.Is(err, decks.ErrInvalidDeckName),
 		errors.Is(err, decks.ErrEmptyGroupID),
-		errors.Is(err, decks.ErrEmptyDeckID):
+		errors.Is(err, decks.ErrEmptyDeckID),
+		errors.Is(err, decks.ErrEmptyCardID),
+		errors.Is(err, decks.ErrEmptyCardFront),
+		errors.Is(err, decks.ErrEmptyCardBack),
+		errors.Is(err, decks.ErrInvalidScheduler),
+		errors.Is(err, decks.ErrInvalidRetention),
+		errors.Is(err, decks.ErrInvalidLeech),
+		errors.Is(err, session.ErrNothingMissed),
+		errors.Is(err, session.ErrInvalidStatsRange),
+		errors.Is(err, session.ErrInvalidTimeZone),
+		errors.Is(err, session.ErrInvalidGoal),
+		errors.Is(err, decks.ErrInvalidWindow),
+		errors.Is(err, decks.ErrInvalidMetric),
+		errors.Is(err, deck_viewer.ErrTypedAnswerRequired),
+		errors.Is(err, deck_viewer.ErrNotTypedSession),
+		errors.Is(err, deck_viewer.ErrNotMultipleChoice),
+		errors.Is(err, deck_viewer.ErrChoiceRequired),
+		errors.Is(err, deck_viewer.ErrAnswerShown),
+		errors.Is(err, decks.ErrTooFewOptions),
+		errors.Is(err, decks.ErrNoCorrectOption),
+		errors.Is(err, decks.ErrNoClozeDeletions),
+		errors.Is(err, decks.ErrNoOcclusionImage),
+		errors.Is(err, decks.ErrNoOcclusionMasks),
+		errors.Is(err, decks.ErrEmptyTags),
+		errors.Is(err, deck_viewer.ErrInvalidHold),
+		errors.Is(err, media.ErrEmptyMedia),
+		errors.Is(err, http.ErrMissingFile):
 		return http.StatusBadRequest
-	case errors.Is(err, database.ErrNoResults):
+	case errors.Is(err, media.ErrMediaTooLarge), errors.As(err, new(*http.MaxBytesError)):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, media.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, decks.ErrNotDeckOwner):
+		return http.StatusForbidden
+	case errors.Is(err, session.ErrNotFinished):
+		return http.StatusConflict
+	case errors.Is(err, database.ErrNoResults), errors.Is(err, session.ErrNoTaggedCards):
 		return http.StatusNotFound
 	default:
 		return http.StatusInternalServerError
@@ -1152,3 +2468,253 @@ func groupDecksFromDecks(fromService []models.GetDeckResults) []dumb.Deck {
 	}
 	return apiDecks
 }
+
+func editCardFromModel(card models.Card) pages.EditCardData {
+	data := pages.EditCardData{
+		CardID:  card.ID,
+		DeckID:  card.DeckID,
+		Front:   card.Front,
+		Back:    card.Back,
+		IsCloze: card.Kind == models.Cloze,
+		HasBack: card.Kind == models.BasicCard,
+		Tags:    card.Tags,
+	}
+	if data.IsCloze {
+		data.Front = card.Note
+	}
+	return data
+}
+
+func deckSettingsFromModel(deck models.Deck) pages.DeckSettingsData {
+	settings := pages.DeckSettingsData{
+		DeckID:         deck.ID,
+		DeckName:       deck.Name,
+		Scheduler:      deck.Algorithm,
+		LeechThreshold: strconv.Itoa(deck.LeechThresholdOrDefault()),
+		SuspendLeeches: deck.SuspendLeeches,
+	}
+	if deck.Algorithm == models.FSRSScheduler {
+		retention := deck.TargetRetention
+		if retention == 0 {
+			retention = models.DefaultTargetRetention
+		}
+		settings.TargetRetention = strconv.FormatFloat(retention, 'f', 2, 64)
+	}
+	return settings
+}
+
+// leaderboardFromModel numbers the ranked members of the leaderboard and marks the row of the member looking at it.
+func leaderboardFromModel(leaderboard models.Leaderboard, metric models.LeaderboardMetric, username string) pages.LeaderboardData {
+	rows := make([]pages.LeaderboardRow, len(leaderboard.Entries))
+	for i, entry := range leaderboard.Entries {
+		rows[i] = pages.LeaderboardRow{
+			Rank:           i + 1,
+			Username:       entry.Username,
+			Reviews:        entry.Reviews,
+			PercentCorrect: entry.PercentCorrect(),
+			Streak:         entry.Streak,
+			IsYou:          entry.Username == username,
+		}
+	}
+	return pages.LeaderboardData{
+		GroupID:   leaderboard.GroupID,
+		Window:    leaderboard.Window,
+		Metric:    metric,
+		UpdatedAt: leaderboard.ComputedAt.UTC().Format("2 Jan 15:04 UTC"),
+		Rows:      rows,
+	}
+}
+
+func studySettingsFromModel(preferences models.StudyPreferences) pages.StudySettingsData {
+	return pages.StudySettingsData{
+		TimeZone:   preferences.Location().String(),
+		GoalKind:   preferences.DailyGoal.Kind,
+		GoalTarget: strconv.Itoa(preferences.DailyGoal.Target),
+	}
+}
+
+func studyProgressFromModel(progress models.StudyProgress) pages.StudyProgressData {
+	return pages.StudyProgressData{
+		CurrentStreak: progress.Streak.Current,
+		LongestStreak: progress.Streak.Longest,
+		HasGoal:       progress.Goal.IsSet(),
+		GoalMet:       progress.GoalMet(),
+		Done:          progress.Done,
+		Target:        progress.Goal.Target,
+		Unit:          progress.Goal.Kind.String(),
+		Percent:       progress.PercentOfGoal(),
+	}
+}
+
+// studyNeighbours returns the cards before and after cardID in the session. Sessions with a queue step through it,
+// deck sessions step through the deck.
+func studyNeighbours(s models.DeckSession, deckID, cardID string, reversed bool, previousCardID, nextCardID string) (previous, next models.SessionCard) {
+	if s.HasQueue() {
+		return s.Neighbours(cardID, reversed)
+	}
+	return models.SessionCard{CardID: previousCardID, DeckID: deckID}, models.SessionCard{CardID: nextCardID, DeckID: deckID}
+}
+
+func sessionSummaryFromModel(summary models.SessionSummary) pages.SessionSummaryData {
+	missed := make([]dumb.CardDisplay, len(summary.MissedCards))
+	for i, card := range summary.MissedCards {
+		missed[i] = dumb.CardDisplay{
+			ID:    card.ID,
+			Front: card.Front,
+			Back:  card.Back,
+		}
+	}
+	answered := make([]pages.AnsweredCardRow, len(summary.AnsweredCards))
+	for i, card := range summary.AnsweredCards {
+		front := card.Card.Front
+		if card.Reversed {
+			front = card.Card.Back
+		}
+		answered[i] = pages.AnsweredCardRow{
+			Front:         front,
+			IsCorrect:     card.IsCorrect,
+			RevealTime:    formatLatency(card.Latency.Reveal),
+			AnswerTime:    formatLatency(card.Latency.Answer),
+			AverageAnswer: formatLatency(card.Average.Answer),
+		}
+	}
+	return pages.SessionSummaryData{
+		SessionID:         summary.SessionID,
+		DeckID:            summary.DeckID,
+		DeckName:          summary.DeckName,
+		TotalCards:        summary.TotalCards,
+		PercentCorrect:    summary.PercentCorrect(),
+		TimeTaken:         summary.TimeTaken.Round(time.Second).String(),
+		AverageAnswerTime: formatLatency(summary.AverageLatency.Answer),
+		MissedCards:       missed,
+		AnsweredCards:     answered,
+	}
+}
+
+func deckStatsFromModel(stats models.DeckStats) pages.DeckStatsData {
+	cards := make([]pages.CardStatsRow, len(stats.Cards))
+	for i, card := range stats.Cards {
+		average := card.Stats.AverageLatency()
+		cards[i] = pages.CardStatsRow{
+			Front:         card.Card.Front,
+			Answered:      card.Stats.Answered,
+			Accuracy:      int(card.Stats.Accuracy() * 100),
+			AverageReveal: formatLatency(average.Reveal),
+			AverageAnswer: formatLatency(average.Answer),
+		}
+	}
+	leeches := make([]pages.LeechRow, len(stats.Leeches))
+	for i, leech := range stats.Leeches {
+		leeches[i] = pages.LeechRow{
+			Front:    leech.Card.Front,
+			Learners: leech.LeechCount.Learners,
+			Failures: leech.LeechCount.Failures,
+		}
+	}
+	return pages.DeckStatsData{
+		DeckID:   stats.DeckID,
+		DeckName: stats.DeckName,
+		Cards:    cards,
+		Leeches:  leeches,
+	}
+}
+
+func cardHistoryFromModel(history models.CardHistory) pages.CardHistoryData {
+	answers := make([]pages.CardAnswerRow, len(history.Answers))
+	for i, record := range history.Answers {
+		answers[i] = pages.CardAnswerRow{
+			SessionID: record.SessionID,
+			DeckName:  record.DeckName,
+			Date:      record.Answer.UpdatedAt.Format(time.DateTime),
+			Correct:   record.Answer.IsCorrect,
+			Grade:     record.Answer.Grade.Label(),
+			Reversed:  record.Answer.Reversed,
+		}
+	}
+	votes := history.Votes
+	return pages.CardHistoryData{
+		DeckID:    history.Card.DeckID,
+		Front:     history.Card.Front,
+		Back:      history.Card.Back,
+		Upvotes:   votes.Upvotes,
+		Downvotes: votes.Downvotes,
+		Votes: dumb.VoteButtonsData{
+			CardID:            history.Card.ID,
+			UpvoteClass:       votes.IsUpvotedByUser.UpvotedClass(),
+			DownvoteClass:     votes.IsDownvotedByUser.DownvotedClass(),
+			UpvoteDirection:   votes.IsUpvotedByUser.NextUpvoteDirection(),
+			DownvoteDirection: votes.IsDownvotedByUser.NextDownvoteDirection(),
+		},
+		Answered:       len(history.Answers),
+		PercentCorrect: int(history.Accuracy() * 100),
+		Streak:         history.Streak(),
+		Answers:        answers,
+	}
+}
+
+// learningStatsFromModel charts the user's reviews over every day of the stats, and their accuracy, cards learned and
+// time studied over the last trendDays of them.
+func learningStatsFromModel(stats models.LearningStats, trendDays int) pages.StatsData {
+	cells := make([]chart.Cell, len(stats.Days))
+	for i, day := range stats.Days {
+		cells[i] = chart.Cell{Day: day.Date, Value: float64(day.Reviews)}
+	}
+
+	trendDays = min(trendDays, len(stats.Days))
+	earlier, trend := stats.Days[:len(stats.Days)-trendDays], stats.Days[len(stats.Days)-trendDays:]
+	learned := stats.LearnedBefore
+	for _, day := range earlier {
+		learned += day.Learned
+	}
+
+	var (
+		accuracy  = chart.Series{Name: "Accuracy per day", Max: 100, Unit: "% correct"}
+		learnedBy = chart.Series{Name: "Cards learned over time", Unit: "cards"}
+		studyTime = chart.Series{Name: "Time studied per day", Unit: "minutes"}
+	)
+	for _, day := range trend {
+		label := day.Date.Format("2 Jan")
+		// Days without reviews have no accuracy, rather than an accuracy of 0.
+		if day.Reviews > 0 {
+			accuracy.Points = append(accuracy.Points, chart.Point{Label: label, Value: float64(day.PercentCorrect())})
+		}
+		learned += day.Learned
+		learnedBy.Points = append(learnedBy.Points, chart.Point{Label: label, Value: float64(learned)})
+		studyTime.Points = append(studyTime.Points, chart.Point{Label: label, Value: day.TimeStudied.Minutes()})
+	}
+
+	totals := models.LearningStats{Days: trend}.Totals()
+	return pages.StatsData{
+		TrendDays:      trendDays,
+		Reviews:        totals.Reviews,
+		PercentCorrect: totals.PercentCorrect(),
+		Learned:        totals.Learned,
+		TimeStudied:    totals.TimeStudied.Round(time.Minute).String(),
+		Heatmap:        chart.Heatmap(cells, "reviews"),
+		Accuracy:       chart.Line(accuracy),
+		LearnedOver:    chart.Line(learnedBy),
+		StudyTime:      chart.Bars(studyTime),
+	}
+}
+
+func suspendedCardsFromModel(cards []models.HeldCard) []pages.SuspendedCard {
+	suspended := make([]pages.SuspendedCard, len(cards))
+	for i, held := range cards {
+		suspended[i] = pages.SuspendedCard{
+			CardID:   held.Card.ID,
+			Front:    held.Card.Front,
+			Back:     held.Card.Back,
+			Leech:    held.Hold.Leech,
+			Failures: held.Hold.Failures,
+		}
+	}
+	return suspended
+}
+
+// formatLatency shows a latency to a tenth of a second. Answers that were not timed have no latency to show.
+func formatLatency(latency time.Duration) string {
+	if latency <= 0 {
+		return "-"
+	}
+	return latency.Round(100 * time.Millisecond).String()
+}
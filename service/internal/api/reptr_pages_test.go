@@ -1,25 +1,33 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/gkampitakis/go-snaps/snaps"
 	"github.com/google/uuid"
 	"github.com/gorilla/sessions"
+	"github.com/rmarken/reptr/api"
 	mocks "github.com/rmarken/reptr/service/internal/api/middlewares/mocks"
 	reptrCtx "github.com/rmarken/reptr/service/internal/context"
 	"github.com/rmarken/reptr/service/internal/database"
 	mockAuth "github.com/rmarken/reptr/service/internal/logic/auth/mocks"
+	"github.com/rmarken/reptr/service/internal/logic/decks"
 	mockLogic "github.com/rmarken/reptr/service/internal/logic/decks/mocks"
+	mockSession "github.com/rmarken/reptr/service/internal/logic/decks/session/mocks"
+	mockMedia "github.com/rmarken/reptr/service/internal/logic/media/mocks"
 	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/oauth2"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 )
@@ -411,6 +419,7 @@ func TestReprtClient_HomePage(t *testing.T) {
 	)
 	testCases := map[string]struct {
 		mockController   func(mock *mockLogic.MockController)
+		mockSession      func(mock *mockSession.MockController)
 		haveHomePageData models.HomePageData
 		wantStatus       int
 		wantUserName     string
@@ -419,6 +428,13 @@ func TestReprtClient_HomePage(t *testing.T) {
 			mockController: func(mock *mockLogic.MockController) {
 				mock.EXPECT().GetHomepageData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(haveHomePageData, nil)
 			},
+			mockSession: func(mock *mockSession.MockController) {
+				mock.EXPECT().GetStudyProgress(gomock.Any(), "hello", gomock.Any()).Return(models.StudyProgress{
+					Goal:   models.DailyGoal{Kind: models.GoalCards, Target: 20},
+					Done:   5,
+					Streak: models.Streak{Current: 2, Longest: 4},
+				}, nil)
+			},
 			wantUserName: "hello",
 			wantStatus:   http.StatusOK,
 		},
@@ -446,10 +462,15 @@ func TestReprtClient_HomePage(t *testing.T) {
 			if tc.mockController != nil {
 				tc.mockController(mock)
 			}
+			sessionMock := mockSession.NewMockController(ctrl)
+			if tc.mockSession != nil {
+				tc.mockSession(sessionMock)
+			}
 
 			reprt := ReprtClient{
-				deckController: mock,
-				logger:         zerolog.Nop(),
+				deckController:    mock,
+				sessionController: sessionMock,
+				logger:            zerolog.Nop(),
 			}
 			// Create a request object with necessary parameters
 			req, err := http.NewRequest(http.MethodGet, "/page/home", nil)
@@ -574,3 +595,214 @@ func TestReprtClient_CreateGroupPage(t *testing.T) {
 		})
 	}
 }
+
+func TestReprtClient_BackOfCard(t *testing.T) {
+	var (
+		username  = "hello"
+		sessionID = "session-id"
+		choice    = models.BackOfCard{
+			DeckID: "deck-id",
+			CardID: "card-id",
+			Answer: "Paris",
+			Kind:   models.MultipleChoice,
+			Options: []models.CardOption{
+				{ID: "a", Text: "Paris", IsCorrect: true},
+				{ID: "b", Text: "Lyon"},
+			},
+		}
+	)
+	testCases := map[string]struct {
+		haveSession    models.DeckSession
+		mockController func(mock *mockLogic.MockController)
+		mockSession    func(mock *mockSession.MockController)
+		wantStatus     int
+	}{
+		"should refuse the back of a multiple choice card before its answer is picked": {
+			haveSession: models.DeckSession{ID: sessionID, Username: username, DeckID: "deck-id", CurrentCardID: "card-id", IsFront: true},
+			mockController: func(mock *mockLogic.MockController) {
+				mock.EXPECT().GetBackOfCardByID(gomock.Any(), "deck-id", "card-id", username, false, gomock.Any()).Return(choice, nil)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		"should show the back of a multiple choice card once its answer is picked": {
+			haveSession: models.DeckSession{
+				ID:            sessionID,
+				Username:      username,
+				DeckID:        "deck-id",
+				CurrentCardID: "card-2",
+				IsFront:       true,
+				CardAnswers:   []models.CardAnswer{{CardID: "card-id", IsCorrect: true, Grade: models.GradeGood}},
+			},
+			mockController: func(mock *mockLogic.MockController) {
+				mock.EXPECT().GetBackOfCardByID(gomock.Any(), "deck-id", "card-id", username, false, gomock.Any()).Return(choice, nil)
+			},
+			mockSession: func(mock *mockSession.MockController) {
+				mock.EXPECT().UpdateCardOrientation(gomock.Any(), sessionID, false).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := mockLogic.NewMockController(ctrl)
+			tc.mockController(mock)
+			sessionMock := mockSession.NewMockController(ctrl)
+			sessionMock.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(tc.haveSession, nil)
+			if tc.mockSession != nil {
+				tc.mockSession(sessionMock)
+			}
+
+			reprt := ReprtClient{
+				deckController:    mock,
+				sessionController: sessionMock,
+				logger:            zerolog.Nop(),
+			}
+			req, err := http.NewRequest(http.MethodGet, "/page/back-of-card/deck-id/card-id", nil)
+			require.NoError(t, err)
+			req = req.WithContext(reptrCtx.AddUsername(req.Context(), username))
+
+			rr := httptest.NewRecorder()
+
+			id := sessionID
+			reprt.BackOfCard(rr, req, "deck-id", "card-id", api.BackOfCardParams{SessionId: &id})
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+			snaps.MatchSnapshot(t, rr.Body.String())
+		})
+	}
+}
+
+func TestReprtClient_CreateCardForDeck(t *testing.T) {
+	username := "hello"
+	testCases := map[string]struct {
+		mockController func(mock *mockLogic.MockController)
+		mockMedia      func(mock *mockMedia.MockController)
+		wantStatus     int
+	}{
+		"should create the card with its attachments": {
+			mockController: func(mock *mockLogic.MockController) {
+				mock.EXPECT().AddCardToDeck(gomock.Any(), "deck-id", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, card models.Card) error {
+						assert.Equal(t, []models.MediaRef{{ID: "media-id", ContentType: "image/png"}}, card.FrontMedia)
+						return nil
+					})
+			},
+			mockMedia: func(mock *mockMedia.MockController) {
+				mock.EXPECT().Upload(gomock.Any(), username, "heart.png", gomock.Any()).
+					Return(models.Media{ID: "media-id", ContentType: "image/png"}, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		"should delete the attachments of a card that could not be created": {
+			mockController: func(mock *mockLogic.MockController) {
+				mock.EXPECT().AddCardToDeck(gomock.Any(), "deck-id", gomock.Any()).Return(database.ErrNoResults)
+			},
+			mockMedia: func(mock *mockMedia.MockController) {
+				mock.EXPECT().Upload(gomock.Any(), username, "heart.png", gomock.Any()).
+					Return(models.Media{ID: "media-id", ContentType: "image/png"}, nil)
+				mock.EXPECT().Delete(gomock.Any(), "media-id").Return(nil)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := mockLogic.NewMockController(ctrl)
+			tc.mockController(mock)
+			mediaMock := mockMedia.NewMockController(ctrl)
+			tc.mockMedia(mediaMock)
+
+			reprt := ReprtClient{
+				deckController:  mock,
+				mediaController: mediaMock,
+				logger:          zerolog.Nop(),
+			}
+
+			var body bytes.Buffer
+			form := multipart.NewWriter(&body)
+			require.NoError(t, form.WriteField("card-front", "front"))
+			require.NoError(t, form.WriteField("card-back", "back"))
+			file, err := form.CreateFormFile("front-media", "heart.png")
+			require.NoError(t, err)
+			_, err = file.Write([]byte("\x89PNG\r\n\x1a\n"))
+			require.NoError(t, err)
+			require.NoError(t, form.Close())
+
+			req, err := http.NewRequest(http.MethodPost, "/page/create-cards/deck-id", &body)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", form.FormDataContentType())
+			req = req.WithContext(reptrCtx.AddUsername(req.Context(), username))
+
+			rr := httptest.NewRecorder()
+			reprt.CreateCardForDeck(rr, req, "deck-id")
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestReprtClient_EditCard(t *testing.T) {
+	username := "hello"
+	card := models.Card{ID: "card-id", DeckID: "deck-id", Kind: models.Cloze, Note: "{{c1::a}}"}
+	testCases := map[string]struct {
+		mockController func(mock *mockLogic.MockController)
+		wantStatus     int
+		wantLocation   string
+	}{
+		"should save the card and redirect to its deck": {
+			mockController: func(mock *mockLogic.MockController) {
+				mock.EXPECT().GetCardToEdit(gomock.Any(), "card-id", username).Return(card, nil)
+				mock.EXPECT().EditCard(gomock.Any(), username, models.Card{ID: "card-id", Front: "{{c1::b}}", Tags: []string{"aws", "networking"}}).Return(nil)
+			},
+			wantStatus:   http.StatusSeeOther,
+			wantLocation: "/page/create-cards/deck-id",
+		},
+		"should refuse users who do not own the card's deck": {
+			mockController: func(mock *mockLogic.MockController) {
+				mock.EXPECT().GetCardToEdit(gomock.Any(), "card-id", username).Return(models.Card{}, decks.ErrNotDeckOwner)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		"should refuse a cloze note without deletions": {
+			mockController: func(mock *mockLogic.MockController) {
+				mock.EXPECT().GetCardToEdit(gomock.Any(), "card-id", username).Return(card, nil)
+				mock.EXPECT().EditCard(gomock.Any(), username, gomock.Any()).Return(decks.ErrNoClozeDeletions)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := mockLogic.NewMockController(ctrl)
+			tc.mockController(mock)
+
+			reprt := ReprtClient{
+				deckController: mock,
+				logger:         zerolog.Nop(),
+			}
+			form := url.Values{"card-front": {"{{c1::b}}"}, "tags": {"AWS, networking"}}
+			req, err := http.NewRequest(http.MethodPost, "/page/edit-card/card-id", strings.NewReader(form.Encode()))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req = req.WithContext(reptrCtx.AddUsername(req.Context(), username))
+
+			rr := httptest.NewRecorder()
+			reprt.EditCard(rr, req, "card-id")
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+			assert.Equal(t, tc.wantLocation, rr.Header().Get("Location"))
+		})
+	}
+}
@@ -6,10 +6,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/sessions"
 	"github.com/rmarken/reptr/api"
+	reptrCtx "github.com/rmarken/reptr/service/internal/context"
+	"github.com/rmarken/reptr/service/internal/database"
 	"github.com/rmarken/reptr/service/internal/logic/auth"
+	deck_viewer "github.com/rmarken/reptr/service/internal/logic/deck-viewer"
 	"github.com/rmarken/reptr/service/internal/logic/decks"
 	mockLogic "github.com/rmarken/reptr/service/internal/logic/decks/mocks"
 	"github.com/rmarken/reptr/service/internal/logic/decks/session"
+	"github.com/rmarken/reptr/service/internal/logic/media"
 	"github.com/rmarken/reptr/service/internal/logic/provider"
 	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rs/zerolog"
@@ -23,7 +27,7 @@ import (
 )
 
 func TestNew(t *testing.T) {
-	r := New(zerolog.Nop(), &decks.Logic{}, &provider.Logic{}, &auth.Authenticator{}, &session.Logic{}, &sessions.CookieStore{})
+	r := New(zerolog.Nop(), &decks.Logic{}, &provider.Logic{}, &auth.Authenticator{}, &session.Logic{}, &sessions.CookieStore{}, &deck_viewer.Logic{}, &media.Logic{})
 	assert.NotNil(t, r)
 }
 func TestGetGroups(t *testing.T) {
@@ -141,3 +145,52 @@ func TestGetGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGroupLeaderboard(t *testing.T) {
+	var (
+		username = "ada"
+		groupID  = uuid.NewString()
+	)
+	testCases := map[string]struct {
+		mockCtrl     func(mock *mockLogic.MockController)
+		ExpectedCode int
+	}{
+		"should serve the leaderboard to a member of the group": {
+			mockCtrl: func(mock *mockLogic.MockController) {
+				mock.EXPECT().GetGroupLeaderboard(gomock.Any(), groupID, username, models.WeeklyLeaderboard, models.RankByReviews, gomock.Any()).
+					Return(models.Leaderboard{GroupID: groupID, Window: models.WeeklyLeaderboard, Entries: []models.LeaderboardEntry{{Username: username, Reviews: 3}}}, nil)
+			},
+			ExpectedCode: http.StatusOK,
+		},
+		"should not find the leaderboard for anyone else": {
+			mockCtrl: func(mock *mockLogic.MockController) {
+				mock.EXPECT().GetGroupLeaderboard(gomock.Any(), groupID, username, models.WeeklyLeaderboard, models.RankByReviews, gomock.Any()).
+					Return(models.Leaderboard{}, database.ErrNoResults)
+			},
+			ExpectedCode: http.StatusNotFound,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		testName := testName
+		testCase := testCase
+		t.Run(testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			l := mockLogic.NewMockController(ctrl)
+			testCase.mockCtrl(l)
+
+			rc := ReprtClient{
+				logger:         zerolog.Nop(),
+				deckController: l,
+			}
+			req, err := http.NewRequest(http.MethodGet, "/secure/api/v1/group/"+groupID+"/leaderboard", nil)
+			require.NoError(t, err)
+			req = req.WithContext(reptrCtx.AddUsername(req.Context(), username))
+
+			rr := httptest.NewRecorder()
+			rc.GetGroupLeaderboard(rr, req, groupID, api.GetGroupLeaderboardParams{})
+
+			assert.Equal(t, testCase.ExpectedCode, rr.Code)
+		})
+	}
+}
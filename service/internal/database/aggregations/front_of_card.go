@@ -4,13 +4,76 @@ package aggregations
 import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"time"
 )
 
-func GetFront_of_card(deckID string, cardID string, username string) mongo.Pipeline {
+func GetFront_of_card(deckID string, cardID string, username string, reversed bool, tags []string, now time.Time) mongo.Pipeline {
 	return mongo.Pipeline{
 		bson.D{
 			{Key: "$match", Value: bson.D{
 				{Key: "deck_id", Value: deckID},
+				{Key: "$expr", Value: bson.D{
+					{Key: "$setIsSubset", Value: bson.A{
+						bson.D{
+							{Key: "$ifNull", Value: bson.A{
+								tags,
+								bson.A{},
+							}},
+						},
+						bson.D{
+							{Key: "$ifNull", Value: bson.A{
+								"$tags",
+								bson.A{},
+							}},
+						},
+					}},
+				}},
+			}},
+		},
+		bson.D{
+			{Key: "$lookup", Value: bson.D{
+				{Key: "from", Value: "card_holds"},
+				{Key: "let", Value: bson.D{
+					{Key: "card_id", Value: "$_id"},
+				}},
+				{Key: "pipeline", Value: bson.A{
+					bson.D{
+						{Key: "$match", Value: bson.D{
+							{Key: "$expr", Value: bson.D{
+								{Key: "$eq", Value: bson.A{
+									"$card_id",
+									"$$card_id",
+								}},
+							}},
+							{Key: "username", Value: username},
+							{Key: "$or", Value: bson.A{
+								bson.D{
+									{Key: "suspended", Value: true},
+								},
+								bson.D{
+									{Key: "buried_until", Value: bson.D{
+										{Key: "$gt", Value: now},
+									}},
+								},
+							}},
+						}},
+					},
+				}},
+				{Key: "as", Value: "holds"},
+			}},
+		},
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "$or", Value: bson.A{
+					bson.D{
+						{Key: "_id", Value: cardID},
+					},
+					bson.D{
+						{Key: "holds", Value: bson.D{
+							{Key: "$size", Value: 0},
+						}},
+					},
+				}},
 			}},
 		},
 		bson.D{
@@ -101,7 +164,26 @@ func GetFront_of_card(deckID string, cardID string, username string) mongo.Pipel
 		bson.D{
 			{Key: "$project", Value: bson.D{
 				{Key: "card_id", Value: "$_id"},
-				{Key: "content", Value: "$front"},
+				{Key: "reversed", Value: bson.D{
+					{Key: "$literal", Value: reversed},
+				}},
+				{Key: "content", Value: bson.D{
+					{Key: "$cond", Value: bson.A{
+						reversed,
+						"$back",
+						"$front",
+					}},
+				}},
+				{Key: "type", Value: "$type"},
+				{Key: "options", Value: "$options"},
+				{Key: "media", Value: bson.D{
+					{Key: "$cond", Value: bson.A{
+						reversed,
+						"$back_media",
+						"$front_media",
+					}},
+				}},
+				{Key: "occlusion", Value: "$occlusion"},
 				{Key: "deck_id", Value: "$deck_id"},
 				{Key: "previous_card", Value: bson.D{
 					{Key: "$first", Value: "$previousCard._id"},
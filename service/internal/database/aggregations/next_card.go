@@ -4,13 +4,76 @@ package aggregations
 import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"time"
 )
 
-func GetNext_card(deckID string, cardID string, userEmail string) mongo.Pipeline {
+func GetNext_card(deckID string, cardID string, userEmail string, tags []string, now time.Time) mongo.Pipeline {
 	return mongo.Pipeline{
 		bson.D{
 			{Key: "$match", Value: bson.D{
 				{Key: "deck_id", Value: deckID},
+				{Key: "$expr", Value: bson.D{
+					{Key: "$setIsSubset", Value: bson.A{
+						bson.D{
+							{Key: "$ifNull", Value: bson.A{
+								tags,
+								bson.A{},
+							}},
+						},
+						bson.D{
+							{Key: "$ifNull", Value: bson.A{
+								"$tags",
+								bson.A{},
+							}},
+						},
+					}},
+				}},
+			}},
+		},
+		bson.D{
+			{Key: "$lookup", Value: bson.D{
+				{Key: "from", Value: "card_holds"},
+				{Key: "let", Value: bson.D{
+					{Key: "card_id", Value: "$_id"},
+				}},
+				{Key: "pipeline", Value: bson.A{
+					bson.D{
+						{Key: "$match", Value: bson.D{
+							{Key: "$expr", Value: bson.D{
+								{Key: "$eq", Value: bson.A{
+									"$card_id",
+									"$$card_id",
+								}},
+							}},
+							{Key: "username", Value: userEmail},
+							{Key: "$or", Value: bson.A{
+								bson.D{
+									{Key: "suspended", Value: true},
+								},
+								bson.D{
+									{Key: "buried_until", Value: bson.D{
+										{Key: "$gt", Value: now},
+									}},
+								},
+							}},
+						}},
+					},
+				}},
+				{Key: "as", Value: "holds"},
+			}},
+		},
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "$or", Value: bson.A{
+					bson.D{
+						{Key: "_id", Value: cardID},
+					},
+					bson.D{
+						{Key: "holds", Value: bson.D{
+							{Key: "$size", Value: 0},
+						}},
+					},
+				}},
 			}},
 		},
 		bson.D{
@@ -110,6 +173,10 @@ func GetNext_card(deckID string, cardID string, userEmail string) mongo.Pipeline
 			{Key: "$project", Value: bson.D{
 				{Key: "card_id", Value: "$_id"},
 				{Key: "content", Value: "$front"},
+				{Key: "type", Value: "$type"},
+				{Key: "options", Value: "$options"},
+				{Key: "media", Value: "$front_media"},
+				{Key: "occlusion", Value: "$occlusion"},
 				{Key: "deck_id", Value: "$deck_id"},
 				{Key: "upvotes", Value: bson.D{
 					{Key: "$size", Value: "$user_upvotes"},
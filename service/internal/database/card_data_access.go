@@ -9,6 +9,8 @@ import (
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
 )
 
 var _ CardDataAccess = &CardDAO{}
@@ -17,9 +19,16 @@ type (
 	CardDataAccess interface {
 		InsertCards(ctx context.Context, card []models.Card) error
 		UpdateCard(ctx context.Context, card models.Card) error
-		GetFrontOfCardByID(ctx context.Context, deckID, cardID, username string) (models.FrontOfCard, error)
-		GetFrontOfNextCardByID(ctx context.Context, deckID, cardID, username string) (models.FrontOfCard, error)
-		GetBackOfCardByID(ctx context.Context, deckID, cardID, username string) (models.BackOfCard, error)
+		GetFrontOfCardByID(ctx context.Context, deckID, cardID, username string, reversed bool, tags []string) (models.FrontOfCard, error)
+		GetFrontOfNextCardByID(ctx context.Context, deckID, cardID, username string, tags []string) (models.FrontOfCard, error)
+		GetFrontOfNextDueCard(ctx context.Context, deckID, username string, tags, excludeCardIDs []string, dueBy time.Time) (models.FrontOfCard, error)
+		GetCardsByIDs(ctx context.Context, cardIDs []string) ([]models.Card, error)
+		GetCardsByNoteID(ctx context.Context, noteID string) ([]models.Card, error)
+		GetCardsByTags(ctx context.Context, deckIDs, tags []string) ([]models.Card, error)
+		GetCardsByMediaID(ctx context.Context, mediaID string) ([]models.Card, error)
+		DeleteCards(ctx context.Context, cardIDs []string) error
+		GetDueCards(ctx context.Context, deckIDs []string, username string, dueBy time.Time) ([]models.DueCard, error)
+		GetBackOfCardByID(ctx context.Context, deckID, cardID, username string, reversed bool, tags []string) (models.BackOfCard, error)
 		AddUserToUpvoteForCard(ctx context.Context, primaryKey, userID string) error
 		RemoveUserFromUpvoteForCard(ctx context.Context, primaryKey, userID string) error
 		AddUserToDownvoteForCard(ctx context.Context, primaryKey, userID string) error
@@ -76,11 +85,13 @@ func (d *CardDAO) UpdateCard(ctx context.Context, card models.Card) error {
 	return nil
 }
 
-func (d *CardDAO) GetFrontOfCardByID(ctx context.Context, deckID, cardID, username string) (models.FrontOfCard, error) {
+// GetFrontOfCardByID returns the prompt side of a card: its front, or its back when the card is studied reversed.
+// When tags are given, the card must have every one of them. The neighbouring cards skip those the user holds.
+func (d *CardDAO) GetFrontOfCardByID(ctx context.Context, deckID, cardID, username string, reversed bool, tags []string) (models.FrontOfCard, error) {
 	logger := d.log.With().Str("method", "GetFrontOfCardByID").Logger()
 	logger.Info().Msgf("getting front of card by id: %s", cardID)
 
-	pipeline := aggregations.GetFront_of_card(deckID, cardID, username)
+	pipeline := aggregations.GetFront_of_card(deckID, cardID, username, reversed, tags, time.Now())
 
 	cursor, err := d.collection.Aggregate(ctx, pipeline)
 	if err != nil {
@@ -103,11 +114,12 @@ func (d *CardDAO) GetFrontOfCardByID(ctx context.Context, deckID, cardID, userna
 
 }
 
-func (d *CardDAO) GetBackOfCardByID(ctx context.Context, deckID, cardID, username string) (models.BackOfCard, error) {
+// GetBackOfCardByID returns the answer side of a card: its back, or its front when the card is studied reversed.
+func (d *CardDAO) GetBackOfCardByID(ctx context.Context, deckID, cardID, username string, reversed bool, tags []string) (models.BackOfCard, error) {
 	logger := d.log.With().Str("method", "GetBackOfCardByID").Logger()
 	logger.Info().Msgf("getting back of card by id: %s", cardID)
 
-	pipeline := aggregations.GetBack_of_card(deckID, cardID, username)
+	pipeline := aggregations.GetBack_of_card(deckID, cardID, username, reversed, tags, time.Now())
 
 	cursor, err := d.collection.Aggregate(ctx, pipeline)
 	if err != nil {
@@ -211,11 +223,11 @@ func (d *CardDAO) RemoveUserFromDownvoteForCard(ctx context.Context, cardID, use
 	return nil
 }
 
-func (d *CardDAO) GetFrontOfNextCardByID(ctx context.Context, deckID, cardID, username string) (models.FrontOfCard, error) {
+func (d *CardDAO) GetFrontOfNextCardByID(ctx context.Context, deckID, cardID, username string, tags []string) (models.FrontOfCard, error) {
 	logger := d.log.With().Str("method", "GetFrontOfNextCardByID").Logger()
 	logger.Info().Msgf("getting front of next card by for deck - %s card - %s", deckID, cardID)
 
-	pipeline := aggregations.GetNext_card(deckID, cardID, username)
+	pipeline := aggregations.GetNext_card(deckID, cardID, username, tags, time.Now())
 
 	cursor, err := d.collection.Aggregate(ctx, pipeline)
 	if err != nil {
@@ -240,3 +252,326 @@ func (d *CardDAO) GetFrontOfNextCardByID(ctx context.Context, deckID, cardID, us
 	return res[0], nil
 
 }
+
+// GetFrontOfNextDueCard returns the front of the most overdue card in a deck for a user.
+// Cards the user has never reviewed are treated as due at dueBy, so reviews that are already late come first.
+// Only reviews of the front-to-back direction count, as deck sessions walk the deck forwards. When tags are given, only
+// cards with every one of them are considered. Cards the user has suspended or buried are skipped.
+func (d *CardDAO) GetFrontOfNextDueCard(ctx context.Context, deckID, username string, tags, excludeCardIDs []string, dueBy time.Time) (models.FrontOfCard, error) {
+	logger := d.log.With().Str("method", "GetFrontOfNextDueCard").Logger()
+	logger.Info().Msgf("getting front of next due card for deck - %s user - %s", deckID, username)
+
+	if excludeCardIDs == nil {
+		excludeCardIDs = []string{}
+	}
+
+	match := bson.D{
+		{"deck_id", deckID},
+		{"_id", bson.D{{"$nin", excludeCardIDs}}},
+	}
+	if len(tags) > 0 {
+		match = append(match, bson.E{Key: "tags", Value: bson.D{{"$all", tags}}})
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", match}},
+	}
+	pipeline = append(pipeline, withoutHeldCards(username, dueBy)...)
+	pipeline = append(pipeline, mongo.Pipeline{
+		bson.D{{"$lookup", bson.D{
+			{"from", reviewStateCollection},
+			{"let", bson.D{{"card_id", "$_id"}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{
+					{"$expr", bson.D{{"$eq", bson.A{"$card_id", "$$card_id"}}}},
+					{"username", username},
+					{"reversed", bson.D{{"$ne", true}}},
+				}}},
+			}},
+			{"as", "review"},
+		}}},
+		bson.D{{"$set", bson.D{
+			{"due_at", bson.D{{"$ifNull", bson.A{bson.D{{"$first", "$review.due_at"}}, dueBy}}}},
+		}}},
+		bson.D{{"$match", bson.D{{"due_at", bson.D{{"$lte", dueBy}}}}}},
+		bson.D{{"$sort", bson.D{{"due_at", 1}, {"created_at", 1}}}},
+		bson.D{{"$limit", 1}},
+		bson.D{{"$project", bson.D{
+			{"card_id", "$_id"},
+			{"content", "$front"},
+			{"type", "$type"},
+			{"options", "$options"},
+			{"media", "$front_media"},
+			{"occlusion", "$occlusion"},
+			{"deck_id", "$deck_id"},
+			{"upvotes", bson.D{{"$size", bson.D{{"$ifNull", bson.A{"$user_upvotes", bson.A{}}}}}}},
+			{"downvotes", bson.D{{"$size", bson.D{{"$ifNull", bson.A{"$user_downvotes", bson.A{}}}}}}},
+		}}},
+	}...)
+
+	cursor, err := d.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting cursor")
+		return models.FrontOfCard{}, errors.Join(err, ErrAggregate)
+	}
+	defer cursor.Close(ctx)
+
+	var res []models.FrontOfCard
+	err = cursor.All(ctx, &res)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while unmarshalling to FrontOfCard")
+		return models.FrontOfCard{}, errors.Join(err, ErrAggregate)
+	}
+	if len(res) == 0 {
+		return models.FrontOfCard{}, ErrNoResults
+	}
+
+	return res[0], nil
+}
+
+// GetDueCards returns every card in the given decks that the user should review by dueBy, most overdue first.
+// Cards the user has never reviewed are always due, and a card studied in reverse is due once for each direction.
+// Cards the user has suspended or buried are not due.
+func (d *CardDAO) GetDueCards(ctx context.Context, deckIDs []string, username string, dueBy time.Time) ([]models.DueCard, error) {
+	logger := d.log.With().Str("method", "GetDueCards").Logger()
+	logger.Info().Msgf("getting due cards in %d decks for user - %s", len(deckIDs), username)
+
+	if len(deckIDs) == 0 {
+		return nil, ErrNoResults
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bson.D{
+			{"deck_id", bson.D{{"$in", deckIDs}}},
+		}}},
+	}
+	pipeline = append(pipeline, withoutHeldCards(username, dueBy)...)
+	pipeline = append(pipeline, mongo.Pipeline{
+		bson.D{{"$lookup", bson.D{
+			{"from", reviewStateCollection},
+			{"let", bson.D{{"card_id", "$_id"}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{
+					{"$expr", bson.D{{"$eq", bson.A{"$card_id", "$$card_id"}}}},
+					{"username", username},
+					{"reversed", bson.D{{"$ne", true}}},
+				}}},
+			}},
+			{"as", "review"},
+		}}},
+		bson.D{{"$lookup", bson.D{
+			{"from", reviewStateCollection},
+			{"let", bson.D{{"card_id", "$_id"}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{
+					{"$expr", bson.D{{"$eq", bson.A{"$card_id", "$$card_id"}}}},
+					{"username", username},
+					{"reversed", true},
+				}}},
+			}},
+			{"as", "reversed_review"},
+		}}},
+		// Every card is reviewed front to back, and back to front as well once it has been studied in reverse.
+		bson.D{{"$set", bson.D{
+			{"items", bson.D{{"$concatArrays", bson.A{
+				bson.A{bson.D{
+					{"reversed", false},
+					{"due_at", bson.D{{"$ifNull", bson.A{bson.D{{"$first", "$review.due_at"}}, dueBy}}}},
+				}},
+				bson.D{{"$map", bson.D{
+					{"input", "$reversed_review"},
+					{"in", bson.D{
+						{"reversed", true},
+						{"due_at", "$$this.due_at"},
+					}},
+				}}},
+			}}}},
+		}}},
+		bson.D{{"$unwind", "$items"}},
+		bson.D{{"$match", bson.D{{"items.due_at", bson.D{{"$lte", dueBy}}}}}},
+		bson.D{{"$sort", bson.D{{"items.due_at", 1}, {"created_at", 1}, {"items.reversed", 1}}}},
+		bson.D{{"$project", bson.D{
+			{"card_id", "$_id"},
+			{"deck_id", "$deck_id"},
+			{"reversed", "$items.reversed"},
+			{"due_at", "$items.due_at"},
+		}}},
+	}...)
+
+	cursor, err := d.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting cursor")
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	defer cursor.Close(ctx)
+
+	var res []models.DueCard
+	err = cursor.All(ctx, &res)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while unmarshalling to DueCard")
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	if len(res) == 0 {
+		return nil, ErrNoResults
+	}
+
+	return res, nil
+}
+
+// GetCardsByIDs returns the cards with the given IDs in the order the IDs are given. IDs without a card are skipped.
+func (d *CardDAO) GetCardsByIDs(ctx context.Context, cardIDs []string) ([]models.Card, error) {
+	logger := d.log.With().Str("method", "GetCardsByIDs").Logger()
+	logger.Info().Msgf("getting %d cards by id", len(cardIDs))
+
+	if len(cardIDs) == 0 {
+		return nil, ErrNoResults
+	}
+
+	cursor, err := d.collection.Find(ctx, bson.D{{"_id", bson.D{{"$in", cardIDs}}}})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while finding cards")
+		return nil, errors.Join(err, ErrFind)
+	}
+	defer cursor.Close(ctx)
+
+	var found []models.Card
+	err = cursor.All(ctx, &found)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while unmarshalling to Card")
+		return nil, errors.Join(err, ErrFind)
+	}
+	if len(found) == 0 {
+		return nil, ErrNoResults
+	}
+
+	byID := make(map[string]models.Card, len(found))
+	for _, card := range found {
+		byID[card.ID] = card
+	}
+	cards := make([]models.Card, 0, len(found))
+	for _, id := range cardIDs {
+		if card, ok := byID[id]; ok {
+			cards = append(cards, card)
+		}
+	}
+
+	return cards, nil
+}
+
+// GetCardsByNoteID returns the cards made from a cloze note, in order of their deletion index.
+func (d *CardDAO) GetCardsByNoteID(ctx context.Context, noteID string) ([]models.Card, error) {
+	logger := d.log.With().Str("method", "GetCardsByNoteID").Logger()
+	logger.Info().Msgf("getting cards for note %s", noteID)
+
+	opts := options.Find().SetSort(bson.D{{"cloze_index", 1}})
+	cursor, err := d.collection.Find(ctx, bson.D{{"note_id", noteID}}, opts)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while finding cards")
+		return nil, errors.Join(err, ErrFind)
+	}
+	defer cursor.Close(ctx)
+
+	var cards []models.Card
+	err = cursor.All(ctx, &cards)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while unmarshalling to Card")
+		return nil, errors.Join(err, ErrFind)
+	}
+	if len(cards) == 0 {
+		return nil, ErrNoResults
+	}
+	return cards, nil
+}
+
+// GetCardsByTags returns the cards in the given decks that have every one of the tags, oldest first.
+func (d *CardDAO) GetCardsByTags(ctx context.Context, deckIDs, tags []string) ([]models.Card, error) {
+	logger := d.log.With().Str("method", "GetCardsByTags").Logger()
+	logger.Info().Msgf("getting cards in %d decks tagged %v", len(deckIDs), tags)
+
+	if len(deckIDs) == 0 || len(tags) == 0 {
+		return nil, ErrNoResults
+	}
+
+	filter := bson.D{
+		{"deck_id", bson.D{{"$in", deckIDs}}},
+		{"tags", bson.D{{"$all", tags}}},
+	}
+	opts := options.Find().SetSort(bson.D{{"created_at", 1}})
+	cursor, err := d.collection.Find(ctx, filter, opts)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while finding cards")
+		return nil, errors.Join(err, ErrFind)
+	}
+	defer cursor.Close(ctx)
+
+	var cards []models.Card
+	err = cursor.All(ctx, &cards)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while unmarshalling to Card")
+		return nil, errors.Join(err, ErrFind)
+	}
+	if len(cards) == 0 {
+		return nil, ErrNoResults
+	}
+	return cards, nil
+}
+
+// GetCardsByMediaID returns the cards the attachment is on, whether on a side of the card or as its occlusion diagram.
+func (d *CardDAO) GetCardsByMediaID(ctx context.Context, mediaID string) ([]models.Card, error) {
+	logger := d.log.With().Str("method", "GetCardsByMediaID").Logger()
+	logger.Info().Msgf("getting cards with media %s", mediaID)
+
+	filter := bson.D{{"$or", bson.A{
+		bson.D{{"front_media.id", mediaID}},
+		bson.D{{"back_media.id", mediaID}},
+		bson.D{{"occlusion.image.id", mediaID}},
+	}}}
+	cursor, err := d.collection.Find(ctx, filter)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while finding cards")
+		return nil, errors.Join(err, ErrFind)
+	}
+	defer cursor.Close(ctx)
+
+	var cards []models.Card
+	err = cursor.All(ctx, &cards)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while unmarshalling to Card")
+		return nil, errors.Join(err, ErrFind)
+	}
+	if len(cards) == 0 {
+		return nil, ErrNoResults
+	}
+	return cards, nil
+}
+
+// DeleteCards removes the cards with the given IDs.
+func (d *CardDAO) DeleteCards(ctx context.Context, cardIDs []string) error {
+	logger := d.log.With().Str("method", "DeleteCards").Logger()
+	logger.Info().Msgf("deleting %d cards", len(cardIDs))
+
+	if len(cardIDs) == 0 {
+		return nil
+	}
+
+	_, err := d.collection.DeleteMany(ctx, bson.D{{"_id", bson.D{{"$in", cardIDs}}}})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while deleting cards %v", cardIDs)
+		return errors.Join(fmt.Errorf("error deleting cards: %w", err), ErrDelete)
+	}
+	return nil
+}
+
+// EnsureIndexes creates the indexes card lookups rely on. Creating an index that already exists is a no-op.
+func (d *CardDAO) EnsureIndexes(ctx context.Context) error {
+	logger := d.log.With().Str("method", "EnsureIndexes").Logger()
+
+	_, err := d.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"deck_id", 1}, {"tags", 1}},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while creating deck and tags index")
+		return errors.Join(fmt.Errorf("error creating card indexes: %w", err), ErrInsert)
+	}
+	return nil
+}
@@ -2,9 +2,11 @@ package database
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"testing"
 	"time"
@@ -152,3 +154,345 @@ func TestDAO_UpdateCard(t *testing.T) {
 		})
 	}
 }
+
+func TestCardDAO_GetDueCards(t *testing.T) {
+	var (
+		db       = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		username = uuid.NewString()
+		dueAt    = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveDeckIDs []string
+		mockMongo   func(mt *mtest.T)
+		wantDue     []models.DueCard
+		wantErr     error
+	}{
+		"should return due cards": {
+			haveDeckIDs: []string{"deck-1", "deck-2"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch,
+					bson.D{{Key: "card_id", Value: "card-1"}, {Key: "deck_id", Value: "deck-2"}, {Key: "due_at", Value: dueAt}},
+					bson.D{{Key: "card_id", Value: "card-2"}, {Key: "deck_id", Value: "deck-1"}, {Key: "due_at", Value: dueAt}},
+					bson.D{{Key: "card_id", Value: "card-2"}, {Key: "deck_id", Value: "deck-1"}, {Key: "reversed", Value: true}, {Key: "due_at", Value: dueAt}},
+				))
+			},
+			wantDue: []models.DueCard{
+				{CardID: "card-1", DeckID: "deck-2", DueAt: dueAt},
+				{CardID: "card-2", DeckID: "deck-1", DueAt: dueAt},
+				{CardID: "card-2", DeckID: "deck-1", Reversed: true, DueAt: dueAt},
+			},
+		},
+		"should return ErrNoResults when nothing is due": {
+			haveDeckIDs: []string{"deck-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrNoResults without querying when there are no decks": {
+			wantErr: ErrNoResults,
+		},
+		"should return ErrAggregate when mongo errors": {
+			haveDeckIDs: []string{"deck-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "aggregate error",
+				}))
+			},
+			wantErr: ErrAggregate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			if tc.mockMongo != nil {
+				tc.mockMongo(mt)
+			}
+
+			dao := CardDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			got, err := dao.GetDueCards(context.Background(), tc.haveDeckIDs, username, dueAt)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantDue, got)
+		})
+	}
+}
+
+func TestCardDAO_GetCardsByIDs(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveCardIDs []string
+		mockMongo   func(mt *mtest.T)
+		wantCards   []models.Card
+		wantErr     error
+	}{
+		"should return cards in the requested order": {
+			haveCardIDs: []string{"card-2", "card-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch,
+					bson.D{{Key: "_id", Value: "card-1"}, {Key: "front", Value: "front 1"}},
+					bson.D{{Key: "_id", Value: "card-2"}, {Key: "front", Value: "front 2"}},
+				))
+			},
+			wantCards: []models.Card{
+				{ID: "card-2", Front: "front 2"},
+				{ID: "card-1", Front: "front 1"},
+			},
+		},
+		"should return ErrNoResults when no card matches": {
+			haveCardIDs: []string{"card-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrNoResults without querying when there are no ids": {
+			wantErr: ErrNoResults,
+		},
+		"should return ErrFind when mongo errors": {
+			haveCardIDs: []string{"card-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "find error",
+				}))
+			},
+			wantErr: ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			if tc.mockMongo != nil {
+				tc.mockMongo(mt)
+			}
+
+			dao := CardDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			got, err := dao.GetCardsByIDs(context.Background(), tc.haveCardIDs)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCards, got)
+		})
+	}
+}
+
+func TestCardDAO_GetCardsByNoteID(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo func(mt *mtest.T)
+		wantCards []models.Card
+		wantErr   error
+	}{
+		"should return the cards of the note": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch,
+					bson.D{{Key: "_id", Value: "card-1"}, {Key: "note_id", Value: "note-id"}, {Key: "cloze_index", Value: 1}},
+					bson.D{{Key: "_id", Value: "card-2"}, {Key: "note_id", Value: "note-id"}, {Key: "cloze_index", Value: 2}},
+				))
+			},
+			wantCards: []models.Card{
+				{ID: "card-1", NoteID: "note-id", ClozeIndex: 1},
+				{ID: "card-2", NoteID: "note-id", ClozeIndex: 2},
+			},
+		},
+		"should return ErrNoResults when the note has no cards": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrFind when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "find error",
+				}))
+			},
+			wantErr: ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := CardDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			got, err := dao.GetCardsByNoteID(context.Background(), "note-id")
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCards, got)
+		})
+	}
+}
+
+func TestCardDAO_GetCardsByMediaID(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo func(mt *mtest.T)
+		wantCards []models.Card
+		wantErr   error
+	}{
+		"should return the cards the media is on": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch,
+					bson.D{{Key: "_id", Value: "card-1"}, {Key: "deck_id", Value: "deck-id"}},
+				))
+			},
+			wantCards: []models.Card{{ID: "card-1", DeckID: "deck-id"}},
+		},
+		"should return ErrNoResults when the media is on no card": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrFind when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "find error",
+				}))
+			},
+			wantErr: ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := CardDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			got, err := dao.GetCardsByMediaID(context.Background(), "media-id")
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCards, got)
+		})
+	}
+}
+
+func TestCardDAO_GetCardsByTags(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveDeckIDs []string
+		haveTags    []string
+		mockMongo   func(mt *mtest.T)
+		wantCards   []models.Card
+		wantErr     error
+	}{
+		"should return the tagged cards": {
+			haveDeckIDs: []string{"deck-1", "deck-2"},
+			haveTags:    []string{"networking"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch,
+					bson.D{{Key: "_id", Value: "card-1"}, {Key: "deck_id", Value: "deck-1"}, {Key: "tags", Value: bson.A{"aws", "networking"}}},
+					bson.D{{Key: "_id", Value: "card-2"}, {Key: "deck_id", Value: "deck-2"}, {Key: "tags", Value: bson.A{"networking"}}},
+				))
+			},
+			wantCards: []models.Card{
+				{ID: "card-1", DeckID: "deck-1", Tags: []string{"aws", "networking"}},
+				{ID: "card-2", DeckID: "deck-2", Tags: []string{"networking"}},
+			},
+		},
+		"should return ErrNoResults without looking up when there are no tags": {
+			haveDeckIDs: []string{"deck-1"},
+			mockMongo:   func(mt *mtest.T) {},
+			wantErr:     ErrNoResults,
+		},
+		"should return ErrNoResults when no card has the tags": {
+			haveDeckIDs: []string{"deck-1"},
+			haveTags:    []string{"networking"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.cards", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrFind when mongo errors": {
+			haveDeckIDs: []string{"deck-1"},
+			haveTags:    []string{"networking"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "find error",
+				}))
+			},
+			wantErr: ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := CardDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			got, err := dao.GetCardsByTags(context.Background(), tc.haveDeckIDs, tc.haveTags)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCards, got)
+		})
+	}
+}
+
+func TestCardDAO_DeleteCards(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveCardIDs []string
+		mockMongo   func(mt *mtest.T)
+		wantErr     error
+	}{
+		"should delete the cards": {
+			haveCardIDs: []string{"card-1", "card-2"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}))
+			},
+		},
+		"should do nothing without ids": {},
+		"should return ErrDelete when mongo errors": {
+			haveCardIDs: []string{"card-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "delete error",
+				}))
+			},
+			wantErr: ErrDelete,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			if tc.mockMongo != nil {
+				tc.mockMongo(mt)
+			}
+
+			dao := CardDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			err := dao.DeleteCards(context.Background(), tc.haveCardIDs)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const cardHoldCollection = "card_holds"
+
+var _ CardHoldDataAccess = new(CardHoldDAO)
+
+type (
+	CardHoldDataAccess interface {
+		SuspendCard(ctx context.Context, username, deckID, cardID string) error
+		BuryCard(ctx context.Context, username, deckID, cardID string, until time.Time) error
+		UnsuspendCards(ctx context.Context, username string, cardIDs []string) error
+		GetSuspendedCards(ctx context.Context, username string) ([]models.CardHold, error)
+		GetHeldCardIDs(ctx context.Context, username string, now time.Time) ([]string, error)
+		MarkLeech(ctx context.Context, username, deckID, cardID string, failures int, suspend bool) error
+		GetLeechCounts(ctx context.Context, deckID string) ([]models.LeechCount, error)
+	}
+
+	CardHoldDAO struct {
+		collection *mongo.Collection
+		log        zerolog.Logger
+	}
+)
+
+// NewCardHoldDataAccess returns a DAO for the cards each user has suspended or buried.
+func NewCardHoldDataAccess(db *mongo.Database, log zerolog.Logger) *CardHoldDAO {
+	logger := log.With().Str("module", "CardHoldDAO").Logger()
+	collection := db.Collection(cardHoldCollection)
+	return &CardHoldDAO{
+		collection: collection,
+		log:        logger,
+	}
+}
+
+// SuspendCard keeps the card out of the user's sessions until it is unsuspended.
+func (h *CardHoldDAO) SuspendCard(ctx context.Context, username, deckID, cardID string) error {
+	log := h.log.With().Str("method", "SuspendCard").Logger()
+	log.Info().Msgf("suspending card %s for user %s", cardID, username)
+
+	return h.upsertHold(ctx, username, deckID, cardID, bson.D{{"suspended", true}})
+}
+
+// BuryCard keeps the card out of the user's sessions until the given time.
+func (h *CardHoldDAO) BuryCard(ctx context.Context, username, deckID, cardID string, until time.Time) error {
+	log := h.log.With().Str("method", "BuryCard").Logger()
+	log.Info().Msgf("burying card %s for user %s until %s", cardID, username, until)
+
+	return h.upsertHold(ctx, username, deckID, cardID, bson.D{{"buried_until", until}})
+}
+
+// MarkLeech marks the card as a leech for the user, who has failed it the given number of times, and suspends it
+// when told to.
+func (h *CardHoldDAO) MarkLeech(ctx context.Context, username, deckID, cardID string, failures int, suspend bool) error {
+	log := h.log.With().Str("method", "MarkLeech").Logger()
+	log.Info().Msgf("marking card %s as a leech for user %s after %d failures", cardID, username, failures)
+
+	set := bson.D{
+		{"leech", true},
+		{"failures", failures},
+	}
+	if suspend {
+		set = append(set, bson.E{Key: "suspended", Value: true})
+	}
+	return h.upsertHold(ctx, username, deckID, cardID, set)
+}
+
+// upsertHold sets fields of the user's hold on the card, creating the hold when the card has none.
+func (h *CardHoldDAO) upsertHold(ctx context.Context, username, deckID, cardID string, set bson.D) error {
+	now := time.Now()
+	filter := bson.D{
+		{"username", username},
+		{"card_id", cardID},
+	}
+	update := bson.D{
+		{"$set", append(set, bson.E{Key: "updated_at", Value: now})},
+		{"$setOnInsert", bson.D{
+			{"_id", uuid.NewString()},
+			{"deck_id", deckID},
+			{"created_at", now},
+		}},
+	}
+
+	_, err := h.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		h.log.Error().Err(err).Msgf("while holding card %s for user %s", cardID, username)
+		return errors.Join(fmt.Errorf("error holding card: %w", err), ErrUpdate)
+	}
+	return nil
+}
+
+// UnsuspendCards lets the user study the cards again.
+func (h *CardHoldDAO) UnsuspendCards(ctx context.Context, username string, cardIDs []string) error {
+	log := h.log.With().Str("method", "UnsuspendCards").Logger()
+	log.Info().Msgf("unsuspending %d cards for user %s", len(cardIDs), username)
+
+	if len(cardIDs) == 0 {
+		return nil
+	}
+
+	filter := bson.D{
+		{"username", username},
+		{"card_id", bson.D{{"$in", cardIDs}}},
+	}
+	update := bson.D{{"$set", bson.D{
+		{"suspended", false},
+		{"updated_at", time.Now()},
+	}}}
+
+	_, err := h.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		log.Error().Err(err).Msgf("while unsuspending cards %v", cardIDs)
+		return errors.Join(fmt.Errorf("error unsuspending cards: %w", err), ErrUpdate)
+	}
+	return nil
+}
+
+// GetSuspendedCards returns the user's suspended cards, most recently suspended first.
+func (h *CardHoldDAO) GetSuspendedCards(ctx context.Context, username string) ([]models.CardHold, error) {
+	log := h.log.With().Str("method", "GetSuspendedCards").Logger()
+	log.Info().Msgf("getting suspended cards for user %s", username)
+
+	filter := bson.D{
+		{"username", username},
+		{"suspended", true},
+	}
+	opts := options.Find().SetSort(bson.D{{"updated_at", -1}})
+	cursor, err := h.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Error().Err(err).Msgf("while finding suspended cards")
+		return nil, errors.Join(err, ErrFind)
+	}
+	defer cursor.Close(ctx)
+
+	var holds []models.CardHold
+	err = cursor.All(ctx, &holds)
+	if err != nil {
+		log.Error().Err(err).Msgf("while unmarshalling to CardHold")
+		return nil, errors.Join(err, ErrFind)
+	}
+	if len(holds) == 0 {
+		return nil, ErrNoResults
+	}
+	return holds, nil
+}
+
+// GetHeldCardIDs returns the IDs of the cards the user has suspended, or buried past the given time. It returns no
+// IDs, rather than ErrNoResults, when the user holds no cards.
+func (h *CardHoldDAO) GetHeldCardIDs(ctx context.Context, username string, now time.Time) ([]string, error) {
+	log := h.log.With().Str("method", "GetHeldCardIDs").Logger()
+
+	cursor, err := h.collection.Find(ctx, heldBy(username, now), options.Find().SetProjection(bson.D{{"card_id", 1}}))
+	if err != nil {
+		log.Error().Err(err).Msgf("while finding held cards for user %s", username)
+		return nil, errors.Join(err, ErrFind)
+	}
+	defer cursor.Close(ctx)
+
+	var holds []models.CardHold
+	err = cursor.All(ctx, &holds)
+	if err != nil {
+		log.Error().Err(err).Msgf("while unmarshalling to CardHold")
+		return nil, errors.Join(err, ErrFind)
+	}
+
+	ids := make([]string, len(holds))
+	for i, hold := range holds {
+		ids[i] = hold.CardID
+	}
+	return ids, nil
+}
+
+// GetLeechCounts returns the cards of the deck that are leeches for at least one learner, those that are leeches for
+// the most learners first.
+func (h *CardHoldDAO) GetLeechCounts(ctx context.Context, deckID string) ([]models.LeechCount, error) {
+	log := h.log.With().Str("method", "GetLeechCounts").Logger()
+	log.Info().Msgf("getting leeches of deck %s", deckID)
+
+	p := mongo.Pipeline{
+		{{"$match", bson.D{
+			{"deck_id", deckID},
+			{"leech", true},
+		}}},
+		{{"$group", bson.D{
+			{"_id", "$card_id"},
+			{"learners", bson.D{{"$sum", 1}}},
+			{"failures", bson.D{{"$sum", "$failures"}}},
+		}}},
+		{{"$sort", bson.D{{"learners", -1}, {"failures", -1}, {"_id", 1}}}},
+	}
+
+	cursor, err := h.collection.Aggregate(ctx, p)
+	if err != nil {
+		log.Error().Err(err).Msgf("while aggregating leeches of deck %s", deckID)
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	defer cursor.Close(ctx)
+
+	var counts []models.LeechCount
+	err = cursor.All(ctx, &counts)
+	if err != nil {
+		log.Error().Err(err).Msgf("while unmarshalling to LeechCount")
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	return counts, nil
+}
+
+// EnsureIndexes creates the indexes holds are looked up by. A user holds a card at most once.
+func (h *CardHoldDAO) EnsureIndexes(ctx context.Context) error {
+	logger := h.log.With().Str("method", "EnsureIndexes").Logger()
+
+	_, err := h.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{"username", 1}, {"card_id", 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{"deck_id", 1}, {"leech", 1}},
+		},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while creating card hold indexes")
+		return errors.Join(fmt.Errorf("error creating card hold indexes: %w", err), ErrInsert)
+	}
+	return nil
+}
+
+// heldBy matches the holds that keep a card out of the user's study at the given time.
+func heldBy(username string, now time.Time) bson.D {
+	return bson.D{
+		{"username", username},
+		{"$or", bson.A{
+			bson.D{{"suspended", true}},
+			bson.D{{"buried_until", bson.D{{"$gt", now}}}},
+		}},
+	}
+}
+
+// withoutHeldCards are the stages that drop the cards the user holds from a pipeline over cards.
+func withoutHeldCards(username string, now time.Time) []bson.D {
+	return []bson.D{
+		{{"$lookup", bson.D{
+			{"from", cardHoldCollection},
+			{"let", bson.D{{"card_id", "$_id"}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", append(bson.D{
+					{"$expr", bson.D{{"$eq", bson.A{"$card_id", "$$card_id"}}}},
+				}, heldBy(username, now)...)}},
+			}},
+			{"as", "holds"},
+		}}},
+		{{"$match", bson.D{{"holds", bson.D{{"$size", 0}}}}}},
+	}
+}
@@ -0,0 +1,158 @@
+package database
+
+import (
+	"context"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"testing"
+	"time"
+)
+
+func TestCardHoldDAO_GetSuspendedCards(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo func(mt *mtest.T)
+		wantHolds []models.CardHold
+		wantErr   error
+	}{
+		"should return the suspended cards": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.card_holds", mtest.FirstBatch,
+					bson.D{{Key: "_id", Value: "hold-1"}, {Key: "username", Value: "user"}, {Key: "card_id", Value: "card-1"}, {Key: "suspended", Value: true}},
+				))
+			},
+			wantHolds: []models.CardHold{{ID: "hold-1", Username: "user", CardID: "card-1", Suspended: true}},
+		},
+		"should return ErrNoResults when no card is suspended": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.card_holds", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrFind when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "find error",
+				}))
+			},
+			wantErr: ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := CardHoldDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			got, err := dao.GetSuspendedCards(context.Background(), "user")
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantHolds, got)
+		})
+	}
+}
+
+func TestCardHoldDAO_GetHeldCardIDs(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo func(mt *mtest.T)
+		wantIDs   []string
+		wantErr   error
+	}{
+		"should return the IDs of the held cards": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.card_holds", mtest.FirstBatch,
+					bson.D{{Key: "card_id", Value: "card-1"}},
+					bson.D{{Key: "card_id", Value: "card-2"}},
+				))
+			},
+			wantIDs: []string{"card-1", "card-2"},
+		},
+		"should return no IDs when no card is held": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.card_holds", mtest.FirstBatch))
+			},
+			wantIDs: []string{},
+		},
+		"should return ErrFind when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "find error",
+				}))
+			},
+			wantErr: ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := CardHoldDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			got, err := dao.GetHeldCardIDs(context.Background(), "user", time.Now())
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantIDs, got)
+		})
+	}
+}
+
+func TestCardHoldDAO_GetLeechCounts(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo  func(mt *mtest.T)
+		wantCounts []models.LeechCount
+		wantErr    error
+	}{
+		"should return how many learners each card is a leech for": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.card_holds", mtest.FirstBatch,
+					bson.D{{Key: "_id", Value: "card-1"}, {Key: "learners", Value: 3}, {Key: "failures", Value: 27}},
+					bson.D{{Key: "_id", Value: "card-2"}, {Key: "learners", Value: 1}, {Key: "failures", Value: 8}},
+				))
+			},
+			wantCounts: []models.LeechCount{
+				{CardID: "card-1", Learners: 3, Failures: 27},
+				{CardID: "card-2", Learners: 1, Failures: 8},
+			},
+		},
+		"should return ErrAggregate when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "aggregate error",
+				}))
+			},
+			wantErr: ErrAggregate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := CardHoldDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			got, err := dao.GetLeechCounts(context.Background(), "deck-1")
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCounts, got)
+		})
+	}
+}
@@ -24,6 +24,8 @@ type (
 		AddUserToDownvoteForDeck(ctx context.Context, primaryKey, userID string) error
 		RemoveUserFromDownvoteForDeck(ctx context.Context, primaryKey, userID string) error
 		GetDecksForUser(ctx context.Context, username string, from time.Time, to *time.Time, limit, offset int) ([]models.GetDeckResults, error)
+		GetDeckByID(ctx context.Context, deckID string) (models.Deck, error)
+		UpdateDeckSchedulerSettings(ctx context.Context, deckID string, settings models.SchedulerSettings) error
 	}
 
 	DeckDAO struct {
@@ -341,3 +343,50 @@ func (d *DeckDAO) GetDecksForUser(ctx context.Context, username string, from tim
 
 	return deckResults, nil
 }
+
+func (d *DeckDAO) GetDeckByID(ctx context.Context, deckID string) (models.Deck, error) {
+	logger := d.log.With().Str("method", "GetDeckByID").Logger()
+	logger.Info().Msgf("getting deck: %s", deckID)
+
+	result := d.collection.FindOne(ctx, bson.D{{"_id", deckID}})
+	if result.Err() != nil {
+		if errors.Is(result.Err(), mongo.ErrNoDocuments) {
+			return models.Deck{}, ErrNoResults
+		}
+		logger.Error().Err(result.Err()).Msgf("while getting deck %s", deckID)
+		return models.Deck{}, errors.Join(result.Err(), ErrFind)
+	}
+
+	var deck models.Deck
+	err := result.Decode(&deck)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while decoding deck %s", deckID)
+		return models.Deck{}, errors.Join(err, ErrFind)
+	}
+
+	return deck, nil
+}
+
+func (d *DeckDAO) UpdateDeckSchedulerSettings(ctx context.Context, deckID string, settings models.SchedulerSettings) error {
+	logger := d.log.With().Str("method", "UpdateDeckSchedulerSettings").Logger()
+	logger.Info().Msgf("updating scheduler for deck %s to %+v", deckID, settings)
+
+	res, err := d.collection.UpdateOne(ctx, bson.D{{"_id", deckID}}, bson.D{
+		{"$set", bson.D{
+			{"scheduler", settings.Algorithm},
+			{"target_retention", settings.TargetRetention},
+			{"leech_threshold", settings.LeechThreshold},
+			{"suspend_leeches", settings.SuspendLeeches},
+			{"updated_at", time.Now()},
+		}},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while updating scheduler for deck %s", deckID)
+		return errors.Join(err, ErrUpdate)
+	}
+	if res.MatchedCount == 0 {
+		return ErrNoResults
+	}
+
+	return nil
+}
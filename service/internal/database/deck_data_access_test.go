@@ -516,3 +516,55 @@ func TestDeckDAO_GetDeckWithCardsByID(t *testing.T) {
 		})
 	}
 }
+
+func TestDeckDAO_UpdateDeckSchedulerSettings(t *testing.T) {
+	var (
+		db = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockDatabase func(mt *mtest.T)
+		wantErr      error
+	}{
+		"should update scheduler settings": {
+			mockDatabase: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(
+					bson.E{Key: "n", Value: 1},
+					bson.E{Key: "nModified", Value: 1},
+				))
+			},
+		},
+		"should return ErrNoResults when deck does not exist": {
+			mockDatabase: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrUpdate when update fails": {
+			mockDatabase: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+					Code:    12345,
+					Message: "update error",
+				}))
+			},
+			wantErr: ErrUpdate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			dao := DeckDAO{collection: mt.Coll, log: zerolog.Nop()}
+			tc.mockDatabase(mt)
+
+			gotErr := dao.UpdateDeckSchedulerSettings(context.Background(), "1", models.SchedulerSettings{
+				Algorithm:       models.FSRSScheduler,
+				TargetRetention: 0.9,
+			})
+
+			assert.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
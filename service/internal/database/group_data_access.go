@@ -16,6 +16,25 @@ import (
 var (
 	_ GroupDataAccess = &GroupDAO{}
 
+	// answerDeckID is the deck of the card a session's unwound answer is to. Deck sessions study a single deck, while
+	// review and tag sessions have none of their own, so the deck is taken from the answered card's queue entry.
+	answerDeckID = bson.D{{"$cond", bson.A{
+		bson.D{{"$ne", bson.A{bson.D{{"$ifNull", bson.A{"$deck_id", ""}}}, ""}}},
+		"$deck_id",
+		bson.D{{"$arrayElemAt", bson.A{
+			bson.D{{"$map", bson.D{
+				{"input", bson.D{{"$filter", bson.D{
+					{"input", bson.D{{"$ifNull", bson.A{"$queue", bson.A{}}}}},
+					{"as", "queued"},
+					{"cond", bson.D{{"$eq", bson.A{"$$queued.card_id", "$card_answers.card_id"}}}},
+				}}}},
+				{"as", "queued"},
+				{"in", "$$queued.deck_id"},
+			}}},
+			0,
+		}}},
+	}}}
+
 	deckFromGroupsLookup = bson.D{
 		{"$lookup",
 			bson.D{
@@ -36,6 +55,7 @@ type (
 		DeleteGroup(ctx context.Context, groupID string) error
 		GetGroupByID(ctx context.Context, groupID string) (models.GroupWithDecks, error)
 		AddDeckToGroup(ctx context.Context, groupID, deckID string) error
+		GetLeaderboardEntries(ctx context.Context, groupID string, from time.Time) ([]models.LeaderboardEntry, error)
 		// AddUserToGroup(ctx context.Context, groupID string, haveUsername string) error
 	}
 	GroupDAO struct {
@@ -370,3 +390,78 @@ func (g *GroupDAO) GetGroupByID(ctx context.Context, groupID string) (models.Gro
 	}
 	return withDecks[0], nil
 }
+
+// GetLeaderboardEntries totals each member's answers since from to the cards of the group's decks, with the days they
+// answered on in their own time zone. Answers count whatever session they were given in, including review and tag
+// sessions that study many decks. Every member has an entry, including those who have not studied. ErrNoResults is returned when there is
+// no such group.
+func (g *GroupDAO) GetLeaderboardEntries(ctx context.Context, groupID string, from time.Time) ([]models.LeaderboardEntry, error) {
+	logger := g.log.With().Str("method", "GetLeaderboardEntries").Logger()
+	logger.Info().Msgf("getting leaderboard entries for group %s from %v", groupID, from)
+
+	p := mongo.Pipeline{
+		{{"$match", bson.D{{"_id", groupID}}}},
+		{{"$unwind", "$members"}},
+		{{"$lookup", bson.D{
+			{"from", usersCollection},
+			{"localField", "members"},
+			{"foreignField", "_id"},
+			{"as", "member"},
+		}}},
+		{{"$addFields", bson.D{
+			{"time_zone", bson.D{{"$ifNull", bson.A{bson.D{{"$arrayElemAt", bson.A{"$member.time_zone", 0}}}, ""}}}},
+		}}},
+		{{"$lookup", bson.D{
+			{"from", deckSessionCollection},
+			{"let", bson.D{
+				{"username", "$members"},
+				{"deck_ids", bson.D{{"$ifNull", bson.A{"$deck_ids", bson.A{}}}}},
+				// Members without a time zone study in UTC.
+				{"timezone", bson.D{{"$cond", bson.A{bson.D{{"$eq", bson.A{"$time_zone", ""}}}, "UTC", "$time_zone"}}}},
+			}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$username", "$$username"}}}}}}},
+				bson.D{{"$unwind", "$card_answers"}},
+				bson.D{{"$match", bson.D{{"card_answers.updated_at", bson.D{{"$gte", from}}}}}},
+				bson.D{{"$addFields", bson.D{{"answer_deck_id", answerDeckID}}}},
+				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$in", bson.A{"$answer_deck_id", "$$deck_ids"}}}}}}},
+				bson.D{{"$group", bson.D{
+					{"_id", bson.D{{"$dateToString", bson.D{
+						{"format", "%Y-%m-%d"},
+						{"date", "$card_answers.updated_at"},
+						{"timezone", "$$timezone"},
+					}}}},
+					{"reviews", bson.D{{"$sum", 1}}},
+					{"correct", bson.D{{"$sum", bson.D{{"$cond", bson.A{"$card_answers.is_correct", 1, 0}}}}}},
+				}}},
+			}},
+			{"as", "days"},
+		}}},
+		{{"$project", bson.D{
+			{"_id", 0},
+			{"username", "$members"},
+			{"time_zone", 1},
+			{"reviews", bson.D{{"$sum", "$days.reviews"}}},
+			{"correct", bson.D{{"$sum", "$days.correct"}}},
+			{"study_days", "$days._id"},
+		}}},
+	}
+
+	cur, err := g.collection.Aggregate(ctx, p)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while aggregating leaderboard for group %s", groupID)
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	defer cur.Close(ctx)
+
+	var entries []models.LeaderboardEntry
+	err = cur.All(ctx, &entries)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while decoding leaderboard for group %s", groupID)
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	if len(entries) == 0 {
+		return nil, ErrNoResults
+	}
+	return entries, nil
+}
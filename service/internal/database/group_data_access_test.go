@@ -441,3 +441,119 @@ func TestAddDeckToGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupDAO_GetLeaderboardEntries(t *testing.T) {
+	var (
+		db     = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger = zerolog.Nop()
+		from   = time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo   func(mt *mtest.T)
+		wantEntries []models.LeaderboardEntry
+		wantStages  []string
+		wantErr     error
+	}{
+		"should return an entry for every member": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.groups", mtest.FirstBatch,
+					bson.D{
+						{Key: "username", Value: "ada"},
+						{Key: "reviews", Value: 4},
+						{Key: "correct", Value: 3},
+						{Key: "study_days", Value: bson.A{"2024-03-01", "2024-03-02"}},
+					},
+					bson.D{
+						{Key: "username", Value: "linus"},
+						{Key: "reviews", Value: 0},
+						{Key: "correct", Value: 0},
+						{Key: "study_days", Value: bson.A{}},
+					},
+				))
+			},
+			wantEntries: []models.LeaderboardEntry{
+				{Username: "ada", Reviews: 4, Correct: 3, StudyDays: []string{"2024-03-01", "2024-03-02"}},
+				{Username: "linus", StudyDays: []string{}},
+			},
+		},
+		"should count answers of cross-deck review sessions by the answered card's deck": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.groups", mtest.FirstBatch,
+					bson.D{
+						{Key: "username", Value: "ada"},
+						{Key: "reviews", Value: 2},
+						{Key: "correct", Value: 1},
+						{Key: "study_days", Value: bson.A{"2024-03-01"}},
+					},
+				))
+			},
+			wantEntries: []models.LeaderboardEntry{
+				{Username: "ada", Reviews: 2, Correct: 1, StudyDays: []string{"2024-03-01"}},
+			},
+			// Review sessions have no deck, so answers are matched by the deck of the card in the session's queue
+			// rather than by the session's deck.
+			wantStages: []string{
+				`{"$match": {"$expr": {"$eq": ["$username","$$username"]}}}`,
+				`"input": {"$ifNull": ["$queue",[]]}`,
+				`"in": "$$queued.deck_id"`,
+				`{"$match": {"$expr": {"$in": ["$answer_deck_id","$$deck_ids"]}}}`,
+			},
+		},
+		"should return each member's time zone and group their days in it": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.groups", mtest.FirstBatch,
+					bson.D{
+						{Key: "username", Value: "kiri"},
+						{Key: "time_zone", Value: "Pacific/Auckland"},
+						{Key: "reviews", Value: 1},
+						{Key: "correct", Value: 1},
+						{Key: "study_days", Value: bson.A{"2024-03-02"}},
+					},
+				))
+			},
+			wantEntries: []models.LeaderboardEntry{
+				{Username: "kiri", TimeZone: "Pacific/Auckland", Reviews: 1, Correct: 1, StudyDays: []string{"2024-03-02"}},
+			},
+			wantStages: []string{
+				`"from": "users"`,
+				`"timezone": "$$timezone"`,
+			},
+		},
+		"should return ErrNoResults when the group does not exist": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.groups", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrAggregate when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "aggregate error",
+				}))
+			},
+			wantErr: ErrAggregate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := GroupDAO{collection: mt.Coll, log: logger}
+
+			got, err := dao.GetLeaderboardEntries(context.Background(), "group-1", from)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantEntries, got)
+
+			pipeline := mt.GetStartedEvent().Command.Lookup("pipeline").String()
+			for _, stage := range tc.wantStages {
+				assert.Contains(t, pipeline, stage)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const leaderboardCollection = "leaderboards"
+
+var _ LeaderboardDataAccess = new(LeaderboardDAO)
+
+type (
+	LeaderboardDataAccess interface {
+		GetLeaderboard(ctx context.Context, groupID string, window models.LeaderboardWindow) (models.Leaderboard, error)
+		SaveLeaderboard(ctx context.Context, leaderboard models.Leaderboard) error
+	}
+
+	LeaderboardDAO struct {
+		collection *mongo.Collection
+		log        zerolog.Logger
+	}
+)
+
+// NewLeaderboardDataAccess returns a DAO for the cached rollups group leaderboards are shown from.
+func NewLeaderboardDataAccess(db *mongo.Database, log zerolog.Logger) *LeaderboardDAO {
+	logger := log.With().Str("module", "LeaderboardDAO").Logger()
+	collection := db.Collection(leaderboardCollection)
+	return &LeaderboardDAO{
+		collection: collection,
+		log:        logger,
+	}
+}
+
+// GetLeaderboard returns the group's cached leaderboard for the window. ErrNoResults is returned when it has not been
+// rolled up yet.
+func (l *LeaderboardDAO) GetLeaderboard(ctx context.Context, groupID string, window models.LeaderboardWindow) (models.Leaderboard, error) {
+	log := l.log.With().Str("method", "GetLeaderboard").Logger()
+
+	res := l.collection.FindOne(ctx, bson.D{{"group_id", groupID}, {"window", window}})
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return models.Leaderboard{}, errors.Join(res.Err(), ErrNoResults)
+		}
+		log.Error().Err(res.Err()).Msgf("while finding %s leaderboard for group %s", window, groupID)
+		return models.Leaderboard{}, errors.Join(res.Err(), ErrFind)
+	}
+
+	var leaderboard models.Leaderboard
+	err := res.Decode(&leaderboard)
+	if err != nil {
+		log.Error().Err(err).Msgf("while decoding %s leaderboard for group %s", window, groupID)
+		return models.Leaderboard{}, errors.Join(err, ErrFind)
+	}
+	return leaderboard, nil
+}
+
+// SaveLeaderboard replaces the group's cached leaderboard for the window.
+func (l *LeaderboardDAO) SaveLeaderboard(ctx context.Context, leaderboard models.Leaderboard) error {
+	log := l.log.With().Str("method", "SaveLeaderboard").Logger()
+	log.Info().Msgf("saving %s leaderboard for group %s", leaderboard.Window, leaderboard.GroupID)
+
+	_, err := l.collection.ReplaceOne(ctx,
+		bson.D{{"group_id", leaderboard.GroupID}, {"window", leaderboard.Window}},
+		leaderboard,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		log.Error().Err(err).Msgf("while saving %s leaderboard for group %s", leaderboard.Window, leaderboard.GroupID)
+		return errors.Join(err, ErrUpdate)
+	}
+	return nil
+}
+
+// EnsureIndexes creates the index leaderboards are looked up by. A group has one leaderboard for each window.
+func (l *LeaderboardDAO) EnsureIndexes(ctx context.Context) error {
+	logger := l.log.With().Str("method", "EnsureIndexes").Logger()
+
+	_, err := l.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{"group_id", 1}, {"window", 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while creating leaderboard indexes")
+		return errors.Join(fmt.Errorf("error creating leaderboard indexes: %w", err), ErrInsert)
+	}
+	return nil
+}
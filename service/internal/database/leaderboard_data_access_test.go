@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"testing"
+	"time"
+)
+
+func TestLeaderboardDAO_GetLeaderboard(t *testing.T) {
+	var (
+		db         = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger     = zerolog.Nop()
+		computedAt = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo       func(mt *mtest.T)
+		wantLeaderboard models.Leaderboard
+		wantErr         error
+	}{
+		"should return the cached leaderboard": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.leaderboards", mtest.FirstBatch,
+					bson.D{
+						{Key: "group_id", Value: "group-1"},
+						{Key: "window", Value: "week"},
+						{Key: "computed_at", Value: computedAt},
+						{Key: "entries", Value: bson.A{
+							bson.D{{Key: "username", Value: "ada"}, {Key: "reviews", Value: 4}, {Key: "correct", Value: 3}, {Key: "streak", Value: 2}},
+						}},
+					},
+				))
+			},
+			wantLeaderboard: models.Leaderboard{
+				GroupID:    "group-1",
+				Window:     models.WeeklyLeaderboard,
+				ComputedAt: computedAt,
+				Entries:    []models.LeaderboardEntry{{Username: "ada", Reviews: 4, Correct: 3, Streak: 2}},
+			},
+		},
+		"should return ErrNoResults when the leaderboard has not been rolled up": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.leaderboards", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrFind when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "find error",
+				}))
+			},
+			wantErr: ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := LeaderboardDAO{collection: mt.Coll, log: logger}
+
+			got, err := dao.GetLeaderboard(context.Background(), "group-1", models.WeeklyLeaderboard)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantLeaderboard, got)
+		})
+	}
+}
+
+func TestLeaderboardDAO_SaveLeaderboard(t *testing.T) {
+	var (
+		db = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo func(mt *mtest.T)
+		wantErr   error
+	}{
+		"should save the leaderboard": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(
+					bson.E{Key: "n", Value: 1},
+					bson.E{Key: "nModified", Value: 1},
+				))
+			},
+		},
+		"should return ErrUpdate when the save fails": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+					Code:    12345,
+					Message: "update error",
+				}))
+			},
+			wantErr: ErrUpdate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := LeaderboardDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+			err := dao.SaveLeaderboard(context.Background(), models.Leaderboard{
+				GroupID:    "group-1",
+				Window:     models.WeeklyLeaderboard,
+				ComputedAt: time.Now(),
+			})
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"io"
+)
+
+var _ MediaDataAccess = &MediaDAO{}
+
+const mediaBucket = "media"
+
+type (
+	MediaDataAccess interface {
+		UploadMedia(ctx context.Context, media models.Media, content io.Reader) error
+		OpenMedia(ctx context.Context, mediaID string) (models.Media, io.ReadCloser, error)
+		DeleteMedia(ctx context.Context, mediaID string) error
+	}
+	MediaDAO struct {
+		db  *mongo.Database
+		log zerolog.Logger
+	}
+
+	// mediaMetadata is what GridFS keeps about an attachment beyond its name, size and upload date.
+	mediaMetadata struct {
+		ContentType string `bson:"content_type"`
+		UploadedBy  string `bson:"uploaded_by"`
+	}
+)
+
+func NewMediaDataAccess(db *mongo.Database, log zerolog.Logger) *MediaDAO {
+	logger := log.With().Str("module", "MediaDAO").Logger()
+	return &MediaDAO{
+		db:  db,
+		log: logger,
+	}
+}
+
+// bucket opens the media bucket with the context's deadline. Buckets hold their deadline, so one is opened for each
+// operation rather than shared.
+func (d *MediaDAO) bucket(ctx context.Context) (*gridfs.Bucket, error) {
+	bucket, err := gridfs.NewBucket(d.db, options.GridFSBucket().SetName(mediaBucket))
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = bucket.SetReadDeadline(deadline)
+		_ = bucket.SetWriteDeadline(deadline)
+	}
+	return bucket, nil
+}
+
+// UploadMedia streams the attachment's content into GridFS. An error reading content aborts the upload, so nothing
+// is kept of it.
+func (d *MediaDAO) UploadMedia(ctx context.Context, media models.Media, content io.Reader) error {
+	logger := d.log.With().Str("method", "UploadMedia").Logger()
+	logger.Info().Msgf("uploading media %s (%s)", media.ID, media.ContentType)
+
+	bucket, err := d.bucket(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("while opening bucket")
+		return errors.Join(err, ErrInsert)
+	}
+
+	opts := options.GridFSUpload().SetMetadata(mediaMetadata{
+		ContentType: media.ContentType,
+		UploadedBy:  media.UploadedBy,
+	})
+	err = bucket.UploadFromStreamWithID(media.ID, media.Filename, content, opts)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while uploading media %s", media.ID)
+		return errors.Join(fmt.Errorf("error uploading media: %w", err), ErrInsert)
+	}
+	return nil
+}
+
+// OpenMedia returns the attachment and a stream of its content, which the caller must close.
+func (d *MediaDAO) OpenMedia(ctx context.Context, mediaID string) (models.Media, io.ReadCloser, error) {
+	logger := d.log.With().Str("method", "OpenMedia").Logger()
+	logger.Info().Msgf("opening media %s", mediaID)
+
+	bucket, err := d.bucket(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("while opening bucket")
+		return models.Media{}, nil, errors.Join(err, ErrFind)
+	}
+
+	stream, err := bucket.OpenDownloadStream(mediaID)
+	if err != nil {
+		if errors.Is(err, gridfs.ErrFileNotFound) {
+			return models.Media{}, nil, ErrNoResults
+		}
+		logger.Error().Err(err).Msgf("while opening media %s", mediaID)
+		return models.Media{}, nil, errors.Join(err, ErrFind)
+	}
+
+	file := stream.GetFile()
+	var metadata mediaMetadata
+	if len(file.Metadata) > 0 {
+		err = bson.Unmarshal(file.Metadata, &metadata)
+		if err != nil {
+			stream.Close()
+			logger.Error().Err(err).Msgf("while unmarshalling metadata of media %s", mediaID)
+			return models.Media{}, nil, errors.Join(err, ErrFind)
+		}
+	}
+
+	return models.Media{
+		ID:          mediaID,
+		Filename:    file.Name,
+		ContentType: metadata.ContentType,
+		Size:        file.Length,
+		UploadedBy:  metadata.UploadedBy,
+		UploadedAt:  file.UploadDate,
+	}, stream, nil
+}
+
+// DeleteMedia removes the attachment and its content from GridFS.
+func (d *MediaDAO) DeleteMedia(ctx context.Context, mediaID string) error {
+	logger := d.log.With().Str("method", "DeleteMedia").Logger()
+	logger.Info().Msgf("deleting media %s", mediaID)
+
+	bucket, err := d.bucket(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("while opening bucket")
+		return errors.Join(err, ErrDelete)
+	}
+
+	err = bucket.Delete(mediaID)
+	if err != nil {
+		if errors.Is(err, gridfs.ErrFileNotFound) {
+			return ErrNoResults
+		}
+		logger.Error().Err(err).Msgf("while deleting media %s", mediaID)
+		return errors.Join(err, ErrDelete)
+	}
+	return nil
+}
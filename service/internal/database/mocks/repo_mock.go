@@ -11,6 +11,7 @@ package database
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -43,6 +44,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AbandonIdleSessions mocks base method.
+func (m *MockRepository) AbandonIdleSessions(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbandonIdleSessions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbandonIdleSessions indicates an expected call of AbandonIdleSessions.
+func (mr *MockRepositoryMockRecorder) AbandonIdleSessions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbandonIdleSessions", reflect.TypeOf((*MockRepository)(nil).AbandonIdleSessions), arg0, arg1)
+}
+
 // AddDeckToGroup mocks base method.
 func (m *MockRepository) AddDeckToGroup(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -127,6 +143,20 @@ func (mr *MockRepositoryMockRecorder) AddUserToUpvoteForDeck(arg0, arg1, arg2 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToUpvoteForDeck", reflect.TypeOf((*MockRepository)(nil).AddUserToUpvoteForDeck), arg0, arg1, arg2)
 }
 
+// BuryCard mocks base method.
+func (m *MockRepository) BuryCard(arg0 context.Context, arg1, arg2, arg3 string, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuryCard", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuryCard indicates an expected call of BuryCard.
+func (mr *MockRepositoryMockRecorder) BuryCard(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuryCard", reflect.TypeOf((*MockRepository)(nil).BuryCard), arg0, arg1, arg2, arg3, arg4)
+}
+
 // CreateSessionForUserDeck mocks base method.
 func (m *MockRepository) CreateSessionForUserDeck(arg0 context.Context, arg1 models.DeckSession) error {
 	m.ctrl.T.Helper()
@@ -141,6 +171,20 @@ func (mr *MockRepositoryMockRecorder) CreateSessionForUserDeck(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSessionForUserDeck", reflect.TypeOf((*MockRepository)(nil).CreateSessionForUserDeck), arg0, arg1)
 }
 
+// DeleteCards mocks base method.
+func (m *MockRepository) DeleteCards(arg0 context.Context, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCards", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCards indicates an expected call of DeleteCards.
+func (mr *MockRepositoryMockRecorder) DeleteCards(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCards", reflect.TypeOf((*MockRepository)(nil).DeleteCards), arg0, arg1)
+}
+
 // DeleteGroup mocks base method.
 func (m *MockRepository) DeleteGroup(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -155,6 +199,20 @@ func (mr *MockRepositoryMockRecorder) DeleteGroup(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockRepository)(nil).DeleteGroup), arg0, arg1)
 }
 
+// DeleteMedia mocks base method.
+func (m *MockRepository) DeleteMedia(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMedia", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMedia indicates an expected call of DeleteMedia.
+func (mr *MockRepositoryMockRecorder) DeleteMedia(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMedia", reflect.TypeOf((*MockRepository)(nil).DeleteMedia), arg0, arg1)
+}
+
 // EndSession mocks base method.
 func (m *MockRepository) EndSession(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -170,33 +228,183 @@ func (mr *MockRepositoryMockRecorder) EndSession(arg0, arg1 any) *gomock.Call {
 }
 
 // GetActiveSessionForUserDeck mocks base method.
-func (m *MockRepository) GetActiveSessionForUserDeck(arg0 context.Context, arg1, arg2 string) (models.DeckSession, error) {
+func (m *MockRepository) GetActiveSessionForUserDeck(arg0 context.Context, arg1, arg2 string, arg3 []string) (models.DeckSession, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActiveSessionForUserDeck", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetActiveSessionForUserDeck", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(models.DeckSession)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetActiveSessionForUserDeck indicates an expected call of GetActiveSessionForUserDeck.
-func (mr *MockRepositoryMockRecorder) GetActiveSessionForUserDeck(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetActiveSessionForUserDeck(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSessionForUserDeck", reflect.TypeOf((*MockRepository)(nil).GetActiveSessionForUserDeck), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSessionForUserDeck", reflect.TypeOf((*MockRepository)(nil).GetActiveSessionForUserDeck), arg0, arg1, arg2, arg3)
+}
+
+// GetActiveSessionForUserKind mocks base method.
+func (m *MockRepository) GetActiveSessionForUserKind(arg0 context.Context, arg1 string, arg2 models.SessionKind, arg3 []string) (models.DeckSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveSessionForUserKind", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.DeckSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveSessionForUserKind indicates an expected call of GetActiveSessionForUserKind.
+func (mr *MockRepositoryMockRecorder) GetActiveSessionForUserKind(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSessionForUserKind", reflect.TypeOf((*MockRepository)(nil).GetActiveSessionForUserKind), arg0, arg1, arg2, arg3)
 }
 
 // GetBackOfCardByID mocks base method.
-func (m *MockRepository) GetBackOfCardByID(arg0 context.Context, arg1, arg2, arg3 string) (models.BackOfCard, error) {
+func (m *MockRepository) GetBackOfCardByID(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool, arg5 []string) (models.BackOfCard, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBackOfCardByID", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetBackOfCardByID", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(models.BackOfCard)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBackOfCardByID indicates an expected call of GetBackOfCardByID.
-func (mr *MockRepositoryMockRecorder) GetBackOfCardByID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetBackOfCardByID(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackOfCardByID", reflect.TypeOf((*MockRepository)(nil).GetBackOfCardByID), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetCardAnswerStats mocks base method.
+func (m *MockRepository) GetCardAnswerStats(arg0 context.Context, arg1 string, arg2 []string) ([]models.CardAnswerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardAnswerStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.CardAnswerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardAnswerStats indicates an expected call of GetCardAnswerStats.
+func (mr *MockRepositoryMockRecorder) GetCardAnswerStats(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardAnswerStats", reflect.TypeOf((*MockRepository)(nil).GetCardAnswerStats), arg0, arg1, arg2)
+}
+
+// GetCardAnswerStatsForAllUsers mocks base method.
+func (m *MockRepository) GetCardAnswerStatsForAllUsers(arg0 context.Context, arg1 []string) ([]models.CardAnswerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardAnswerStatsForAllUsers", arg0, arg1)
+	ret0, _ := ret[0].([]models.CardAnswerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardAnswerStatsForAllUsers indicates an expected call of GetCardAnswerStatsForAllUsers.
+func (mr *MockRepositoryMockRecorder) GetCardAnswerStatsForAllUsers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardAnswerStatsForAllUsers", reflect.TypeOf((*MockRepository)(nil).GetCardAnswerStatsForAllUsers), arg0, arg1)
+}
+
+// GetCardAnswersForUser mocks base method.
+func (m *MockRepository) GetCardAnswersForUser(arg0 context.Context, arg1, arg2 string) ([]models.CardAnswerRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardAnswersForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.CardAnswerRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardAnswersForUser indicates an expected call of GetCardAnswersForUser.
+func (mr *MockRepositoryMockRecorder) GetCardAnswersForUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardAnswersForUser", reflect.TypeOf((*MockRepository)(nil).GetCardAnswersForUser), arg0, arg1, arg2)
+}
+
+// GetCardsByIDs mocks base method.
+func (m *MockRepository) GetCardsByIDs(arg0 context.Context, arg1 []string) ([]models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardsByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardsByIDs indicates an expected call of GetCardsByIDs.
+func (mr *MockRepositoryMockRecorder) GetCardsByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardsByIDs", reflect.TypeOf((*MockRepository)(nil).GetCardsByIDs), arg0, arg1)
+}
+
+// GetCardsByMediaID mocks base method.
+func (m *MockRepository) GetCardsByMediaID(arg0 context.Context, arg1 string) ([]models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardsByMediaID", arg0, arg1)
+	ret0, _ := ret[0].([]models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardsByMediaID indicates an expected call of GetCardsByMediaID.
+func (mr *MockRepositoryMockRecorder) GetCardsByMediaID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardsByMediaID", reflect.TypeOf((*MockRepository)(nil).GetCardsByMediaID), arg0, arg1)
+}
+
+// GetCardsByNoteID mocks base method.
+func (m *MockRepository) GetCardsByNoteID(arg0 context.Context, arg1 string) ([]models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardsByNoteID", arg0, arg1)
+	ret0, _ := ret[0].([]models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardsByNoteID indicates an expected call of GetCardsByNoteID.
+func (mr *MockRepositoryMockRecorder) GetCardsByNoteID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardsByNoteID", reflect.TypeOf((*MockRepository)(nil).GetCardsByNoteID), arg0, arg1)
+}
+
+// GetCardsByTags mocks base method.
+func (m *MockRepository) GetCardsByTags(arg0 context.Context, arg1, arg2 []string) ([]models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardsByTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardsByTags indicates an expected call of GetCardsByTags.
+func (mr *MockRepositoryMockRecorder) GetCardsByTags(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardsByTags", reflect.TypeOf((*MockRepository)(nil).GetCardsByTags), arg0, arg1, arg2)
+}
+
+// GetDailyStudy mocks base method.
+func (m *MockRepository) GetDailyStudy(arg0 context.Context, arg1 string, arg2 time.Time, arg3 string) (models.DailyStudy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyStudy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.DailyStudy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyStudy indicates an expected call of GetDailyStudy.
+func (mr *MockRepositoryMockRecorder) GetDailyStudy(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyStudy", reflect.TypeOf((*MockRepository)(nil).GetDailyStudy), arg0, arg1, arg2, arg3)
+}
+
+// GetDeckByID mocks base method.
+func (m *MockRepository) GetDeckByID(arg0 context.Context, arg1 string) (models.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeckByID", arg0, arg1)
+	ret0, _ := ret[0].(models.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeckByID indicates an expected call of GetDeckByID.
+func (mr *MockRepositoryMockRecorder) GetDeckByID(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackOfCardByID", reflect.TypeOf((*MockRepository)(nil).GetBackOfCardByID), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeckByID", reflect.TypeOf((*MockRepository)(nil).GetDeckByID), arg0, arg1)
 }
 
 // GetDeckWithCardsByID mocks base method.
@@ -229,34 +437,79 @@ func (mr *MockRepositoryMockRecorder) GetDecksForUser(arg0, arg1, arg2, arg3, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecksForUser", reflect.TypeOf((*MockRepository)(nil).GetDecksForUser), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// GetDueCards mocks base method.
+func (m *MockRepository) GetDueCards(arg0 context.Context, arg1 []string, arg2 string, arg3 time.Time) ([]models.DueCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueCards", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.DueCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueCards indicates an expected call of GetDueCards.
+func (mr *MockRepositoryMockRecorder) GetDueCards(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueCards", reflect.TypeOf((*MockRepository)(nil).GetDueCards), arg0, arg1, arg2, arg3)
+}
+
+// GetFinishedSessionsForUser mocks base method.
+func (m *MockRepository) GetFinishedSessionsForUser(arg0 context.Context, arg1, arg2 string, arg3 time.Time, arg4 *time.Time, arg5, arg6 int) ([]models.DeckSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFinishedSessionsForUser", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]models.DeckSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFinishedSessionsForUser indicates an expected call of GetFinishedSessionsForUser.
+func (mr *MockRepositoryMockRecorder) GetFinishedSessionsForUser(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinishedSessionsForUser", reflect.TypeOf((*MockRepository)(nil).GetFinishedSessionsForUser), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // GetFrontOfCardByID mocks base method.
-func (m *MockRepository) GetFrontOfCardByID(arg0 context.Context, arg1, arg2, arg3 string) (models.FrontOfCard, error) {
+func (m *MockRepository) GetFrontOfCardByID(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool, arg5 []string) (models.FrontOfCard, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFrontOfCardByID", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetFrontOfCardByID", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(models.FrontOfCard)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFrontOfCardByID indicates an expected call of GetFrontOfCardByID.
-func (mr *MockRepositoryMockRecorder) GetFrontOfCardByID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetFrontOfCardByID(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontOfCardByID", reflect.TypeOf((*MockRepository)(nil).GetFrontOfCardByID), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontOfCardByID", reflect.TypeOf((*MockRepository)(nil).GetFrontOfCardByID), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // GetFrontOfNextCardByID mocks base method.
-func (m *MockRepository) GetFrontOfNextCardByID(arg0 context.Context, arg1, arg2, arg3 string) (models.FrontOfCard, error) {
+func (m *MockRepository) GetFrontOfNextCardByID(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string) (models.FrontOfCard, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFrontOfNextCardByID", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetFrontOfNextCardByID", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(models.FrontOfCard)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFrontOfNextCardByID indicates an expected call of GetFrontOfNextCardByID.
-func (mr *MockRepositoryMockRecorder) GetFrontOfNextCardByID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetFrontOfNextCardByID(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontOfNextCardByID", reflect.TypeOf((*MockRepository)(nil).GetFrontOfNextCardByID), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetFrontOfNextDueCard mocks base method.
+func (m *MockRepository) GetFrontOfNextDueCard(arg0 context.Context, arg1, arg2 string, arg3, arg4 []string, arg5 time.Time) (models.FrontOfCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFrontOfNextDueCard", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(models.FrontOfCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFrontOfNextDueCard indicates an expected call of GetFrontOfNextDueCard.
+func (mr *MockRepositoryMockRecorder) GetFrontOfNextDueCard(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontOfNextCardByID", reflect.TypeOf((*MockRepository)(nil).GetFrontOfNextCardByID), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontOfNextDueCard", reflect.TypeOf((*MockRepository)(nil).GetFrontOfNextDueCard), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // GetGroupByID mocks base method.
@@ -304,6 +557,81 @@ func (mr *MockRepositoryMockRecorder) GetGroupsWithDecks(arg0, arg1, arg2, arg3,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsWithDecks", reflect.TypeOf((*MockRepository)(nil).GetGroupsWithDecks), arg0, arg1, arg2, arg3, arg4)
 }
 
+// GetHeldCardIDs mocks base method.
+func (m *MockRepository) GetHeldCardIDs(arg0 context.Context, arg1 string, arg2 time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeldCardIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeldCardIDs indicates an expected call of GetHeldCardIDs.
+func (mr *MockRepositoryMockRecorder) GetHeldCardIDs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeldCardIDs", reflect.TypeOf((*MockRepository)(nil).GetHeldCardIDs), arg0, arg1, arg2)
+}
+
+// GetLeaderboard mocks base method.
+func (m *MockRepository) GetLeaderboard(arg0 context.Context, arg1 string, arg2 models.LeaderboardWindow) (models.Leaderboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboard", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Leaderboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboard indicates an expected call of GetLeaderboard.
+func (mr *MockRepositoryMockRecorder) GetLeaderboard(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MockRepository)(nil).GetLeaderboard), arg0, arg1, arg2)
+}
+
+// GetLeaderboardEntries mocks base method.
+func (m *MockRepository) GetLeaderboardEntries(arg0 context.Context, arg1 string, arg2 time.Time) ([]models.LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboardEntries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboardEntries indicates an expected call of GetLeaderboardEntries.
+func (mr *MockRepositoryMockRecorder) GetLeaderboardEntries(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboardEntries", reflect.TypeOf((*MockRepository)(nil).GetLeaderboardEntries), arg0, arg1, arg2)
+}
+
+// GetLeechCounts mocks base method.
+func (m *MockRepository) GetLeechCounts(arg0 context.Context, arg1 string) ([]models.LeechCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeechCounts", arg0, arg1)
+	ret0, _ := ret[0].([]models.LeechCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeechCounts indicates an expected call of GetLeechCounts.
+func (mr *MockRepositoryMockRecorder) GetLeechCounts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeechCounts", reflect.TypeOf((*MockRepository)(nil).GetLeechCounts), arg0, arg1)
+}
+
+// GetReviewState mocks base method.
+func (m *MockRepository) GetReviewState(arg0 context.Context, arg1, arg2 string, arg3 bool) (models.ReviewState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewState", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.ReviewState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewState indicates an expected call of GetReviewState.
+func (mr *MockRepositoryMockRecorder) GetReviewState(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewState", reflect.TypeOf((*MockRepository)(nil).GetReviewState), arg0, arg1, arg2, arg3)
+}
+
 // GetSessionByID mocks base method.
 func (m *MockRepository) GetSessionByID(arg0 context.Context, arg1 string) (models.DeckSession, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +647,21 @@ func (mr *MockRepositoryMockRecorder) GetSessionByID(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByID", reflect.TypeOf((*MockRepository)(nil).GetSessionByID), arg0, arg1)
 }
 
+// GetSuspendedCards mocks base method.
+func (m *MockRepository) GetSuspendedCards(arg0 context.Context, arg1 string) ([]models.CardHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuspendedCards", arg0, arg1)
+	ret0, _ := ret[0].([]models.CardHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuspendedCards indicates an expected call of GetSuspendedCards.
+func (mr *MockRepositoryMockRecorder) GetSuspendedCards(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspendedCards", reflect.TypeOf((*MockRepository)(nil).GetSuspendedCards), arg0, arg1)
+}
+
 // GetUserByUsername mocks base method.
 func (m *MockRepository) GetUserByUsername(arg0 context.Context, arg1 string) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -437,6 +780,36 @@ func (mr *MockRepositoryMockRecorder) InsertUserSubjectPair(arg0, arg1, arg2 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserSubjectPair", reflect.TypeOf((*MockRepository)(nil).InsertUserSubjectPair), arg0, arg1, arg2)
 }
 
+// MarkLeech mocks base method.
+func (m *MockRepository) MarkLeech(arg0 context.Context, arg1, arg2, arg3 string, arg4 int, arg5 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLeech", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkLeech indicates an expected call of MarkLeech.
+func (mr *MockRepositoryMockRecorder) MarkLeech(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLeech", reflect.TypeOf((*MockRepository)(nil).MarkLeech), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// OpenMedia mocks base method.
+func (m *MockRepository) OpenMedia(arg0 context.Context, arg1 string) (models.Media, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenMedia", arg0, arg1)
+	ret0, _ := ret[0].(models.Media)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenMedia indicates an expected call of OpenMedia.
+func (mr *MockRepositoryMockRecorder) OpenMedia(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenMedia", reflect.TypeOf((*MockRepository)(nil).OpenMedia), arg0, arg1)
+}
+
 // RemoveUserFromDownvoteForCard mocks base method.
 func (m *MockRepository) RemoveUserFromDownvoteForCard(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -493,18 +866,60 @@ func (mr *MockRepositoryMockRecorder) RemoveUserFromUpvoteForDeck(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromUpvoteForDeck", reflect.TypeOf((*MockRepository)(nil).RemoveUserFromUpvoteForDeck), arg0, arg1, arg2)
 }
 
+// SaveLeaderboard mocks base method.
+func (m *MockRepository) SaveLeaderboard(arg0 context.Context, arg1 models.Leaderboard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLeaderboard", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLeaderboard indicates an expected call of SaveLeaderboard.
+func (mr *MockRepositoryMockRecorder) SaveLeaderboard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLeaderboard", reflect.TypeOf((*MockRepository)(nil).SaveLeaderboard), arg0, arg1)
+}
+
 // SetAnswerForCard mocks base method.
-func (m *MockRepository) SetAnswerForCard(arg0 context.Context, arg1, arg2 string, arg3 bool) error {
+func (m *MockRepository) SetAnswerForCard(arg0 context.Context, arg1, arg2 string, arg3 bool, arg4 models.Grade, arg5 models.AnswerLatency) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetAnswerForCard", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "SetAnswerForCard", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetAnswerForCard indicates an expected call of SetAnswerForCard.
-func (mr *MockRepositoryMockRecorder) SetAnswerForCard(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) SetAnswerForCard(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAnswerForCard", reflect.TypeOf((*MockRepository)(nil).SetAnswerForCard), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SuspendCard mocks base method.
+func (m *MockRepository) SuspendCard(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendCard", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendCard indicates an expected call of SuspendCard.
+func (mr *MockRepositoryMockRecorder) SuspendCard(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendCard", reflect.TypeOf((*MockRepository)(nil).SuspendCard), arg0, arg1, arg2, arg3)
+}
+
+// UnsuspendCards mocks base method.
+func (m *MockRepository) UnsuspendCards(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsuspendCards", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsuspendCards indicates an expected call of UnsuspendCards.
+func (mr *MockRepositoryMockRecorder) UnsuspendCards(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAnswerForCard", reflect.TypeOf((*MockRepository)(nil).SetAnswerForCard), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsuspendCards", reflect.TypeOf((*MockRepository)(nil).UnsuspendCards), arg0, arg1, arg2)
 }
 
 // UpdateCard mocks base method.
@@ -536,17 +951,31 @@ func (mr *MockRepositoryMockRecorder) UpdateCardOrientation(arg0, arg1, arg2 any
 }
 
 // UpdateCurrentCard mocks base method.
-func (m *MockRepository) UpdateCurrentCard(arg0 context.Context, arg1, arg2 string, arg3 bool) error {
+func (m *MockRepository) UpdateCurrentCard(arg0 context.Context, arg1, arg2 string, arg3, arg4 bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCurrentCard", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "UpdateCurrentCard", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateCurrentCard indicates an expected call of UpdateCurrentCard.
-func (mr *MockRepositoryMockRecorder) UpdateCurrentCard(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) UpdateCurrentCard(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrentCard", reflect.TypeOf((*MockRepository)(nil).UpdateCurrentCard), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrentCard", reflect.TypeOf((*MockRepository)(nil).UpdateCurrentCard), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateDeckSchedulerSettings mocks base method.
+func (m *MockRepository) UpdateDeckSchedulerSettings(arg0 context.Context, arg1 string, arg2 models.SchedulerSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeckSchedulerSettings", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeckSchedulerSettings indicates an expected call of UpdateDeckSchedulerSettings.
+func (mr *MockRepositoryMockRecorder) UpdateDeckSchedulerSettings(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeckSchedulerSettings", reflect.TypeOf((*MockRepository)(nil).UpdateDeckSchedulerSettings), arg0, arg1, arg2)
 }
 
 // UpdateGroup mocks base method.
@@ -563,6 +992,48 @@ func (mr *MockRepositoryMockRecorder) UpdateGroup(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockRepository)(nil).UpdateGroup), arg0, arg1)
 }
 
+// UpdateStudyPreferences mocks base method.
+func (m *MockRepository) UpdateStudyPreferences(arg0 context.Context, arg1 string, arg2 models.StudyPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStudyPreferences", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStudyPreferences indicates an expected call of UpdateStudyPreferences.
+func (mr *MockRepositoryMockRecorder) UpdateStudyPreferences(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStudyPreferences", reflect.TypeOf((*MockRepository)(nil).UpdateStudyPreferences), arg0, arg1, arg2)
+}
+
+// UploadMedia mocks base method.
+func (m *MockRepository) UploadMedia(arg0 context.Context, arg1 models.Media, arg2 io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadMedia", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadMedia indicates an expected call of UploadMedia.
+func (mr *MockRepositoryMockRecorder) UploadMedia(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadMedia", reflect.TypeOf((*MockRepository)(nil).UploadMedia), arg0, arg1, arg2)
+}
+
+// UpsertReviewState mocks base method.
+func (m *MockRepository) UpsertReviewState(arg0 context.Context, arg1 models.ReviewState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReviewState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertReviewState indicates an expected call of UpsertReviewState.
+func (mr *MockRepositoryMockRecorder) UpsertReviewState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReviewState", reflect.TypeOf((*MockRepository)(nil).UpsertReviewState), arg0, arg1)
+}
+
 // WithTransaction mocks base method.
 func (m *MockRepository) WithTransaction(arg0 context.Context, arg1 func(mongo.SessionContext) (any, error), arg2 ...*options.TransactionOptions) error {
 	m.ctrl.T.Helper()
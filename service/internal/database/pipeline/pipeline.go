@@ -14,14 +14,20 @@ const (
 )
 
 func Paginate(from time.Time, to *time.Time, lim, os int) mongo.Pipeline {
+	return PaginateBy(from, to, Asc, lim, os)
+}
+
+// PaginateBy is Paginate with the documents sorted by created_at in the given order. The offset is skipped before
+// the limit is applied so that pages follow on from one another.
+func PaginateBy(from time.Time, to *time.Time, sortBy SortOrder, lim, os int) mongo.Pipeline {
 	pl := mongo.Pipeline{
 		matchBetweenTimes(from, to),
-		SortBy(Asc),
+		SortBy(sortBy),
+		offset(os),
 	}
 	if lim > 0 {
 		pl = append(pl, limit(lim))
 	}
-	pl = append(pl, offset(os))
 
 	return pl
 }
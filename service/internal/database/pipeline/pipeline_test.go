@@ -29,10 +29,10 @@ func TestPaginate(t *testing.T) {
 				}},
 			},
 			{
-				{"$limit", 10},
+				{"$skip", 5},
 			},
 			{
-				{"$skip", 5},
+				{"$limit", 10},
 			},
 		}
 
@@ -57,10 +57,10 @@ func TestPaginate(t *testing.T) {
 				}},
 			},
 			{
-				{"$limit", 10},
+				{"$skip", 5},
 			},
 			{
-				{"$skip", 5},
+				{"$limit", 10},
 			},
 		}
 
@@ -94,3 +94,32 @@ func TestPaginate(t *testing.T) {
 		assert.EqualValues(t, expectedPipeline, pipeline)
 	})
 }
+
+func TestPaginateBy(t *testing.T) {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedPipeline := mongo.Pipeline{
+		{
+			{"$match", bson.D{
+				{"created_at", bson.D{
+					{"$gte", from},
+				}},
+			}},
+		},
+		{
+			{"$sort", bson.D{
+				{"created_at", -1},
+			}},
+		},
+		{
+			{"$skip", 20},
+		},
+		{
+			{"$limit", 10},
+		},
+	}
+
+	pipeline := PaginateBy(from, nil, Desc, 10, 20)
+
+	assert.Equal(t, expectedPipeline, pipeline)
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -19,6 +20,10 @@ type (
 		ProviderUsersDataAccess
 		UserDataAccess
 		SessionDataAccess
+		ReviewStateDataAccess
+		MediaDataAccess
+		CardHoldDataAccess
+		LeaderboardDataAccess
 		WithTransaction(ctx context.Context, callback func(sessionContext mongo.SessionContext) (interface{}, error), txOptions ...*options.TransactionOptions) error
 	}
 	DataAccessObject struct {
@@ -29,6 +34,10 @@ type (
 		*ProviderUsersDAO
 		*UserDAO
 		*SessionDAO
+		*ReviewStateDAO
+		*MediaDAO
+		*CardHoldDAO
+		*LeaderboardDAO
 	}
 )
 
@@ -42,9 +51,23 @@ func NewRepository(logger zerolog.Logger, db *mongo.Database) *DataAccessObject
 		NewProviderUsersDataAccess(db, l),
 		NewUserDataAccess(db, l),
 		NewSessionDataAccess(db, l),
+		NewReviewStateDataAccess(db, l),
+		NewMediaDataAccess(db, l),
+		NewCardHoldDataAccess(db, l),
+		NewLeaderboardDataAccess(db, l),
 	}
 }
 
+// EnsureIndexes creates the indexes the data access objects rely on.
+func (d *DataAccessObject) EnsureIndexes(ctx context.Context) error {
+	return errors.Join(
+		d.CardDAO.EnsureIndexes(ctx),
+		d.CardHoldDAO.EnsureIndexes(ctx),
+		d.ReviewStateDAO.EnsureIndexes(ctx),
+		d.LeaderboardDAO.EnsureIndexes(ctx),
+	)
+}
+
 func (d *DataAccessObject) WithTransaction(ctx context.Context, callback func(sessionContext mongo.SessionContext) (interface{}, error), txOptions ...*options.TransactionOptions) error {
 	client := d.db.Client()
 	session, err := client.StartSession()
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const reviewStateCollection = "review_states"
+
+var _ ReviewStateDataAccess = new(ReviewStateDAO)
+
+type (
+	ReviewStateDataAccess interface {
+		GetReviewState(ctx context.Context, username, cardID string, reversed bool) (models.ReviewState, error)
+		UpsertReviewState(ctx context.Context, state models.ReviewState) error
+	}
+
+	ReviewStateDAO struct {
+		collection *mongo.Collection
+		log        zerolog.Logger
+	}
+)
+
+// NewReviewStateDataAccess returns a DAO for the per-user spaced repetition state of cards.
+func NewReviewStateDataAccess(db *mongo.Database, log zerolog.Logger) *ReviewStateDAO {
+	logger := log.With().Str("module", "ReviewStateDAO").Logger()
+	collection := db.Collection(reviewStateCollection)
+	return &ReviewStateDAO{
+		collection: collection,
+		log:        logger,
+	}
+}
+
+// GetReviewState returns the user's review state for one direction of a card. The two directions of a card are
+// scheduled separately; states recorded before reverse study existed are front to back.
+func (r *ReviewStateDAO) GetReviewState(ctx context.Context, username, cardID string, reversed bool) (models.ReviewState, error) {
+	log := r.log.With().Str("method", "GetReviewState").Logger()
+	log.Info().Msgf("getting review state for user %s card %s reversed %t", username, cardID, reversed)
+
+	filter := bson.D{
+		{"username", username},
+		{"card_id", cardID},
+		{"reversed", reversedFilter(reversed)},
+	}
+
+	result := r.collection.FindOne(ctx, filter)
+	if result.Err() != nil {
+		if errors.Is(result.Err(), mongo.ErrNoDocuments) {
+			return models.ReviewState{}, ErrNoResults
+		}
+		log.Error().Err(result.Err()).Msgf("while looking up review state for user %s card %s", username, cardID)
+		return models.ReviewState{}, errors.Join(result.Err(), ErrFind)
+	}
+
+	var state models.ReviewState
+	err := result.Decode(&state)
+	if err != nil {
+		log.Error().Err(err).Msgf("while decoding review state for user %s card %s", username, cardID)
+		return models.ReviewState{}, errors.Join(err, ErrFind)
+	}
+	return state, nil
+}
+
+// UpsertReviewState saves the user's review state for one direction of a card, inserting it when the user has none
+// yet. States are keyed by user, card and direction, so there is only ever one for each.
+func (r *ReviewStateDAO) UpsertReviewState(ctx context.Context, state models.ReviewState) error {
+	log := r.log.With().Str("method", "UpsertReviewState").Logger()
+	log.Info().Msgf("upserting review state for user %s card %s", state.Username, state.CardID)
+
+	now := time.Now()
+	if state.CreatedAt.IsZero() {
+		state.CreatedAt = now
+	}
+	if state.ID == "" {
+		state.ID = uuid.NewString()
+	}
+
+	filter := bson.D{
+		{"username", state.Username},
+		{"card_id", state.CardID},
+		{"reversed", reversedFilter(state.Reversed)},
+	}
+	update := bson.D{
+		{"$set", bson.D{
+			{"deck_id", state.DeckID},
+			{"ease", state.Ease},
+			{"interval", state.Interval},
+			{"repetitions", state.Repetitions},
+			{"lapses", state.Lapses},
+			{"stability", state.Stability},
+			{"difficulty", state.Difficulty},
+			{"retrievability", state.Retrievability},
+			{"due_at", state.DueAt},
+			{"last_reviewed_at", state.LastReviewedAt},
+			{"updated_at", now},
+		}},
+		{"$setOnInsert", bson.D{
+			{"_id", state.ID},
+			{"created_at", state.CreatedAt},
+		}},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		log.Error().Err(err).Msgf("while upserting review state for user %s card %s", state.Username, state.CardID)
+		return errors.Join(err, ErrUpdate)
+	}
+
+	return nil
+}
+
+// EnsureIndexes creates the unique index that keeps one review state per user, card and direction. Creating an index
+// that already exists is a no-op.
+func (r *ReviewStateDAO) EnsureIndexes(ctx context.Context) error {
+	logger := r.log.With().Str("method", "EnsureIndexes").Logger()
+
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{"username", 1}, {"card_id", 1}, {"reversed", 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while creating review state indexes")
+		return errors.Join(fmt.Errorf("error creating review state indexes: %w", err), ErrInsert)
+	}
+	return nil
+}
+
+// reversedFilter matches documents recorded in the given direction. Documents without a reversed field were
+// recorded front to back.
+func reversedFilter(reversed bool) interface{} {
+	if reversed {
+		return true
+	}
+	return bson.D{{"$ne", true}}
+}
@@ -0,0 +1,177 @@
+package database
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"testing"
+	"time"
+)
+
+func TestReviewStateDAO_GetReviewState(t *testing.T) {
+	var (
+		db       = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger   = zerolog.Nop()
+		username = uuid.NewString()
+		cardID   = uuid.NewString()
+		dueAt    = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveReversed bool
+		mockMongo    func(mt *mtest.T)
+		wantState    models.ReviewState
+		wantErr      error
+	}{
+		"should return review state for user and card": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(1, "reptr.review_states", mtest.FirstBatch, bson.D{
+					{Key: "_id", Value: "state-id"},
+					{Key: "username", Value: username},
+					{Key: "card_id", Value: cardID},
+					{Key: "ease", Value: 2.5},
+					{Key: "interval", Value: 6},
+					{Key: "repetitions", Value: 2},
+					{Key: "due_at", Value: dueAt},
+				}))
+			},
+			wantState: models.ReviewState{
+				ID:          "state-id",
+				Username:    username,
+				CardID:      cardID,
+				Ease:        2.5,
+				Interval:    6,
+				Repetitions: 2,
+				DueAt:       dueAt,
+			},
+		},
+		"should return review state for the reverse direction of a card": {
+			haveReversed: true,
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.review_states", mtest.FirstBatch, bson.D{
+					{Key: "_id", Value: "state-id"},
+					{Key: "username", Value: username},
+					{Key: "card_id", Value: cardID},
+					{Key: "reversed", Value: true},
+					{Key: "interval", Value: 1},
+					{Key: "due_at", Value: dueAt},
+				}))
+			},
+			wantState: models.ReviewState{
+				ID:       "state-id",
+				Username: username,
+				CardID:   cardID,
+				Reversed: true,
+				Interval: 1,
+				DueAt:    dueAt,
+			},
+		},
+		"should return ErrNoResults when card has never been reviewed": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.review_states", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrFind when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "find error",
+				}))
+			},
+			wantErr: ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := ReviewStateDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			got, err := dao.GetReviewState(context.Background(), username, cardID, tc.haveReversed)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantState, got)
+		})
+	}
+}
+
+func TestReviewStateDAO_UpsertReviewState(t *testing.T) {
+	var (
+		db     = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger = zerolog.Nop()
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo   func(mt *mtest.T)
+		wantErr     error
+		wantCommand []string
+	}{
+		"should upsert review state": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(
+					bson.E{Key: "n", Value: 1},
+					bson.E{Key: "nModified", Value: 1},
+				))
+			},
+		},
+		"should upsert by user, card and direction so each has one review state": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(
+					bson.E{Key: "n", Value: 1},
+					bson.E{Key: "nModified", Value: 1},
+				))
+			},
+			wantCommand: []string{
+				`"q": {"username": "user-1","card_id": "card-1","reversed": {"$ne": true}}`,
+				`"$setOnInsert": {"_id": "state-1"`,
+				`"upsert": true`,
+			},
+		},
+		"should return ErrUpdate when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "update error",
+				}))
+			},
+			wantErr: ErrUpdate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			dao := ReviewStateDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			err := dao.UpsertReviewState(context.Background(), models.ReviewState{
+				ID:       "state-1",
+				Username: "user-1",
+				CardID:   "card-1",
+			})
+			assert.ErrorIs(t, err, tc.wantErr)
+
+			command := mt.GetStartedEvent().Command.String()
+			for _, want := range tc.wantCommand {
+				assert.Contains(t, command, want)
+			}
+		})
+	}
+}
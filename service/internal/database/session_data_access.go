@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/rmarken/reptr/service/internal/database/pipeline"
 	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/bson"
@@ -15,13 +16,20 @@ const deckSessionCollection = "deck_sessions"
 
 type (
 	SessionDataAccess interface {
-		GetActiveSessionForUserDeck(ctx context.Context, username string, deckID string) (models.DeckSession, error)
+		GetActiveSessionForUserDeck(ctx context.Context, username string, deckID string, tags []string) (models.DeckSession, error)
+		GetActiveSessionForUserKind(ctx context.Context, username string, kind models.SessionKind, tags []string) (models.DeckSession, error)
 		GetSessionByID(ctx context.Context, sessionID string) (models.DeckSession, error)
 		CreateSessionForUserDeck(ctx context.Context, session models.DeckSession) error
-		UpdateCurrentCard(ctx context.Context, sessionID, currentCardID string, isFront bool) error
-		SetAnswerForCard(ctx context.Context, sessionID, cardID string, isAnsweredCorrectly bool) error
+		UpdateCurrentCard(ctx context.Context, sessionID, currentCardID string, isReversed, isFront bool) error
+		SetAnswerForCard(ctx context.Context, sessionID, cardID string, reversed bool, grade models.Grade, latency models.AnswerLatency) error
 		UpdateCardOrientation(ctx context.Context, sessionID string, isFront bool) error
 		EndSession(ctx context.Context, sessionID string) error
+		GetCardAnswerStats(ctx context.Context, username string, cardIDs []string) ([]models.CardAnswerStats, error)
+		GetCardAnswerStatsForAllUsers(ctx context.Context, cardIDs []string) ([]models.CardAnswerStats, error)
+		AbandonIdleSessions(ctx context.Context, idleSince time.Time) (int64, error)
+		GetFinishedSessionsForUser(ctx context.Context, username, deckID string, from time.Time, to *time.Time, limit, offset int) ([]models.DeckSession, error)
+		GetCardAnswersForUser(ctx context.Context, username, cardID string) ([]models.CardAnswerRecord, error)
+		GetDailyStudy(ctx context.Context, username string, from time.Time, timezone string) (models.DailyStudy, error)
 	}
 	SessionDAO struct {
 		collection *mongo.Collection
@@ -65,14 +73,18 @@ func (s *SessionDAO) GetSessionByID(ctx context.Context, sessionID string) (mode
 	return session, nil
 }
 
-func (s *SessionDAO) GetActiveSessionForUserDeck(ctx context.Context, username string, deckID string) (models.DeckSession, error) {
+func (s *SessionDAO) GetActiveSessionForUserDeck(ctx context.Context, username string, deckID string, tags []string) (models.DeckSession, error) {
 	log := s.log.With().Str("method", "GetActiveSessionForUserDeck").Logger()
 	log.Info().Msgf("getting active session for user %s deck %s", username, deckID)
 
+	// Sessions with a kind, such as retries, also carry a deck id but are not the deck's own session.
 	filter := bson.D{
 		{"deck_id", deckID},
 		{"username", username},
+		{"kind", nil},
+		{"tags", sessionTags(tags)},
 		{"finished_at", nil},
+		{"abandoned_at", nil},
 	}
 
 	result := s.collection.FindOne(ctx, filter)
@@ -94,6 +106,46 @@ func (s *SessionDAO) GetActiveSessionForUserDeck(ctx context.Context, username s
 	return session, nil
 }
 
+// GetActiveSessionForUserKind returns the user's unfinished session of the given kind over the given tags.
+func (s *SessionDAO) GetActiveSessionForUserKind(ctx context.Context, username string, kind models.SessionKind, tags []string) (models.DeckSession, error) {
+	log := s.log.With().Str("method", "GetActiveSessionForUserKind").Logger()
+	log.Info().Msgf("getting active %s session for user %s", kind, username)
+
+	filter := bson.D{
+		{"kind", kind},
+		{"username", username},
+		{"tags", sessionTags(tags)},
+		{"finished_at", nil},
+		{"abandoned_at", nil},
+	}
+
+	result := s.collection.FindOne(ctx, filter)
+	if result.Err() != nil {
+		if errors.Is(result.Err(), mongo.ErrNoDocuments) {
+			return models.DeckSession{}, ErrNoResults
+		}
+		log.Error().Err(result.Err()).Msgf("while looking up %s session for username %s", kind, username)
+		return models.DeckSession{}, errors.Join(result.Err(), ErrFind)
+	}
+
+	var session = models.DeckSession{}
+	err := result.Decode(&session)
+	if err != nil {
+		log.Error().Err(err).Msgf("while decoding %s session for username %s", kind, username)
+		return models.DeckSession{}, err
+	}
+	return session, nil
+}
+
+// sessionTags matches sessions over exactly the given tags. Tags are stored normalized, so they compare as arrays;
+// sessions without tags have none stored.
+func sessionTags(tags []string) interface{} {
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
 func (s *SessionDAO) CreateSessionForUserDeck(ctx context.Context, session models.DeckSession) error {
 	now := time.Now()
 	log := s.log.With().Str("method", "CreateSessionForUserDeck").Logger()
@@ -109,18 +161,28 @@ func (s *SessionDAO) CreateSessionForUserDeck(ctx context.Context, session model
 	return nil
 }
 
-func (s *SessionDAO) UpdateCurrentCard(ctx context.Context, sessionID, currentCardID string, isFront bool) error {
+// UpdateCurrentCard moves the session to a card. Showing the front of a card restarts the clock its answer is timed
+// against.
+func (s *SessionDAO) UpdateCurrentCard(ctx context.Context, sessionID, currentCardID string, isReversed, isFront bool) error {
 	log := s.log.With().Str("method", "UpdateCurrentCard").Logger()
 
+	now := time.Now()
+	set := bson.D{
+		{"current_card_id", currentCardID},
+		{"is_reversed", isReversed},
+		{"is_front", isFront},
+		{"updated_at", now},
+	}
+	update := bson.D{}
+	if isFront {
+		set = append(set, bson.E{Key: "front_shown_at", Value: now})
+		update = append(update, bson.E{Key: "$unset", Value: bson.D{{"back_shown_at", ""}}})
+	}
+	update = append(update, bson.E{Key: "$set", Value: set})
+
 	_, err := s.collection.UpdateOne(ctx, bson.D{
 		{"_id", sessionID}},
-		bson.D{
-			{"$set", bson.D{
-				{"current_card_id", currentCardID},
-				{"is_front", isFront},
-				{"updated_at", time.Now()},
-			}},
-		})
+		update)
 	if err != nil {
 		log.Error().Err(err).Msgf("while updating session %s", sessionID)
 		return errors.Join(err, ErrUpdate)
@@ -129,19 +191,28 @@ func (s *SessionDAO) UpdateCurrentCard(ctx context.Context, sessionID, currentCa
 	return nil
 }
 
-func (s *SessionDAO) SetAnswerForCard(ctx context.Context, sessionID, cardID string, isAnsweredCorrectly bool) error {
+// SetAnswerForCard records the grade and latency for a card studied in the given direction, replacing any earlier
+// answer to it. A replaced answer counts as given now.
+func (s *SessionDAO) SetAnswerForCard(ctx context.Context, sessionID, cardID string, reversed bool, grade models.Grade, latency models.AnswerLatency) error {
 	log := s.log.With().Str("method", "SetAnswerForCard").Logger()
 
 	filter := bson.D{
 		{"_id", sessionID},
-		{"card_answers.card_id", cardID},
+		{"card_answers", bson.D{{"$elemMatch", bson.D{
+			{"card_id", cardID},
+			{"reversed", reversedFilter(reversed)},
+		}}}},
 	}
 
 	update := bson.D{
 		{"$set", bson.D{
 			{"updated_at", time.Now()},
 			{"card_answers.$.card_id", cardID},
-			{"card_answers.$.is_correct", isAnsweredCorrectly},
+			{"card_answers.$.is_correct", grade.IsCorrect()},
+			{"card_answers.$.grade", grade},
+			{"card_answers.$.reveal_latency", latency.Reveal},
+			{"card_answers.$.answer_latency", latency.Answer},
+			{"card_answers.$.updated_at", time.Now()},
 		}},
 	}
 
@@ -160,7 +231,11 @@ func (s *SessionDAO) SetAnswerForCard(ctx context.Context, sessionID, cardID str
 			{"$addToSet", bson.D{
 				{"card_answers", bson.D{
 					{"card_id", cardID},
-					{"is_correct", isAnsweredCorrectly},
+					{"reversed", reversed},
+					{"is_correct", grade.IsCorrect()},
+					{"grade", grade},
+					{"reveal_latency", latency.Reveal},
+					{"answer_latency", latency.Answer},
 					{"created_at", time.Now()},
 					{"updated_at", time.Now()},
 				}},
@@ -182,15 +257,23 @@ func (s *SessionDAO) SetAnswerForCard(ctx context.Context, sessionID, cardID str
 	return nil
 }
 
+// UpdateCardOrientation flips the current card. Revealing the back records when it was revealed, so the reveal can
+// be timed against when the front was shown.
 func (s *SessionDAO) UpdateCardOrientation(ctx context.Context, sessionID string, isFront bool) error {
 	log := s.log.With().Str("method", "UpdateCardOrientation").Logger()
 
+	now := time.Now()
+	set := bson.D{
+		{"is_front", isFront},
+		{"updated_at", now},
+	}
+	if !isFront {
+		set = append(set, bson.E{Key: "back_shown_at", Value: now})
+	}
+
 	_, err := s.collection.UpdateOne(ctx, bson.D{{"_id", sessionID}},
 		bson.D{
-			{"$set", bson.D{
-				{"is_front", isFront},
-				{"updated_at", time.Now()},
-			}},
+			{"$set", set},
 		})
 	if err != nil {
 		log.Error().Err(err).Msgf("while updating session %s", sessionID)
@@ -216,3 +299,276 @@ func (s *SessionDAO) EndSession(ctx context.Context, sessionID string) error {
 
 	return nil
 }
+
+// AbandonIdleSessions ends every unfinished session that has not been updated since idleSince. The sessions are marked
+// as abandoned rather than finished, so they are never resumed but do not count as studied. It returns how many
+// sessions were abandoned.
+func (s *SessionDAO) AbandonIdleSessions(ctx context.Context, idleSince time.Time) (int64, error) {
+	log := s.log.With().Str("method", "AbandonIdleSessions").Logger()
+
+	filter := bson.D{
+		{"finished_at", nil},
+		{"abandoned_at", nil},
+		{"updated_at", bson.D{{"$lt", idleSince}}},
+	}
+	update := bson.D{
+		{"$set", bson.D{
+			{"abandoned_at", time.Now()},
+		}},
+	}
+
+	res, err := s.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		log.Error().Err(err).Msgf("while abandoning sessions idle since %v", idleSince)
+		return 0, errors.Join(err, ErrUpdate)
+	}
+	return res.ModifiedCount, nil
+}
+
+// GetCardAnswerStats totals the user's answers to each of the given cards across all of their sessions.
+// Cards that have never been answered are left out.
+func (s *SessionDAO) GetCardAnswerStats(ctx context.Context, username string, cardIDs []string) ([]models.CardAnswerStats, error) {
+	log := s.log.With().Str("method", "GetCardAnswerStats").Logger()
+	log.Info().Msgf("getting answer stats of %d cards for user %s", len(cardIDs), username)
+
+	stats, err := s.cardAnswerStats(ctx, bson.D{{"username", username}}, cardIDs)
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting answer stats for user %s", username)
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetCardAnswerStatsForAllUsers totals every user's answers to each of the given cards.
+// Cards that have never been answered are left out.
+func (s *SessionDAO) GetCardAnswerStatsForAllUsers(ctx context.Context, cardIDs []string) ([]models.CardAnswerStats, error) {
+	log := s.log.With().Str("method", "GetCardAnswerStatsForAllUsers").Logger()
+	log.Info().Msgf("getting answer stats of %d cards", len(cardIDs))
+
+	stats, err := s.cardAnswerStats(ctx, bson.D{}, cardIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("while getting answer stats")
+		return nil, err
+	}
+	return stats, nil
+}
+
+// cardAnswerStats totals the answers to each of the given cards in the sessions matching filter.
+func (s *SessionDAO) cardAnswerStats(ctx context.Context, filter bson.D, cardIDs []string) ([]models.CardAnswerStats, error) {
+	if len(cardIDs) == 0 {
+		return nil, nil
+	}
+
+	p := bson.A{
+		bson.D{{"$match", append(filter, bson.E{Key: "card_answers.card_id", Value: bson.D{{"$in", cardIDs}}})}},
+		bson.D{{"$unwind", "$card_answers"}},
+		bson.D{{"$match", bson.D{{"card_answers.card_id", bson.D{{"$in", cardIDs}}}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$card_answers.card_id"},
+			{"answered", bson.D{{"$sum", 1}}},
+			{"correct", bson.D{{"$sum", bson.D{{"$cond", bson.A{"$card_answers.is_correct", 1, 0}}}}}},
+			{"reveals_timed", bson.D{{"$sum", bson.D{{"$cond", bson.A{bson.D{{"$gt", bson.A{"$card_answers.reveal_latency", 0}}}, 1, 0}}}}}},
+			{"total_reveal_latency", bson.D{{"$sum", "$card_answers.reveal_latency"}}},
+			{"answers_timed", bson.D{{"$sum", bson.D{{"$cond", bson.A{bson.D{{"$gt", bson.A{"$card_answers.answer_latency", 0}}}, 1, 0}}}}}},
+			{"total_answer_latency", bson.D{{"$sum", "$card_answers.answer_latency"}}},
+		}}},
+	}
+
+	c, err := s.collection.Aggregate(ctx, p)
+	if err != nil {
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	defer c.Close(ctx)
+
+	var stats []models.CardAnswerStats
+	err = c.All(ctx, &stats)
+	if err != nil {
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	return stats, nil
+}
+
+// GetFinishedSessionsForUser pages through the user's finished sessions started between from and to, newest first.
+// Sessions are limited to a single deck when deckID is not empty.
+func (s *SessionDAO) GetFinishedSessionsForUser(ctx context.Context, username, deckID string, from time.Time, to *time.Time, limit, offset int) ([]models.DeckSession, error) {
+	log := s.log.With().Str("method", "GetFinishedSessionsForUser").Logger()
+	log.Info().Msgf("getting finished sessions for user %s deck %q %v - %v, limit: %d offset %d", username, deckID, from, to, limit, offset)
+
+	match := bson.D{
+		{"username", username},
+		{"finished_at", bson.D{{"$ne", nil}}},
+	}
+	if deckID != "" {
+		match = append(match, bson.E{Key: "deck_id", Value: deckID})
+	}
+
+	p := append(mongo.Pipeline{
+		bson.D{{"$match", match}},
+	}, pipeline.PaginateBy(from, to, pipeline.Desc, limit, offset)...)
+	p = append(p, bson.D{{"$project", bson.D{{"queue", 0}}}})
+
+	c, err := s.collection.Aggregate(ctx, p)
+	if err != nil {
+		log.Error().Err(err).Msgf("while aggregating sessions for user %s", username)
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	defer c.Close(ctx)
+
+	var sessions []models.DeckSession
+	err = c.All(ctx, &sessions)
+	if err != nil {
+		log.Error().Err(err).Msgf("while decoding sessions for user %s", username)
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	if len(sessions) == 0 {
+		return nil, ErrNoResults
+	}
+	return sessions, nil
+}
+
+// GetCardAnswersForUser returns every answer the user has given to the card across all of their sessions, most recent
+// first.
+func (s *SessionDAO) GetCardAnswersForUser(ctx context.Context, username, cardID string) ([]models.CardAnswerRecord, error) {
+	log := s.log.With().Str("method", "GetCardAnswersForUser").Logger()
+	log.Info().Msgf("getting answers to card %s for user %s", cardID, username)
+
+	p := mongo.Pipeline{
+		{{"$match", bson.D{
+			{"username", username},
+			{"card_answers.card_id", cardID},
+		}}},
+		{{"$unwind", "$card_answers"}},
+		{{"$match", bson.D{{"card_answers.card_id", cardID}}}},
+		{{"$project", bson.D{
+			{"_id", 0},
+			{"session_id", "$_id"},
+			{"deck_name", "$deck_name"},
+			{"answer", "$card_answers"},
+		}}},
+		{{"$sort", bson.D{{"answer.updated_at", -1}}}},
+	}
+
+	c, err := s.collection.Aggregate(ctx, p)
+	if err != nil {
+		log.Error().Err(err).Msgf("while aggregating answers to card %s", cardID)
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	defer c.Close(ctx)
+
+	var answers []models.CardAnswerRecord
+	err = c.All(ctx, &answers)
+	if err != nil {
+		log.Error().Err(err).Msgf("while decoding answers to card %s", cardID)
+		return nil, errors.Join(err, ErrAggregate)
+	}
+	if len(answers) == 0 {
+		return nil, ErrNoResults
+	}
+	return answers, nil
+}
+
+// GetDailyStudy groups the user's study by day in the given time zone: the cards they answered and the time they spent
+// answering them from the given time on, and the cards they first recalled on each day since they started. Sessions
+// abandoned by the sweeper add no time, since how long they were left open says nothing about study.
+func (s *SessionDAO) GetDailyStudy(ctx context.Context, username string, from time.Time, timezone string) (models.DailyStudy, error) {
+	log := s.log.With().Str("method", "GetDailyStudy").Logger()
+	log.Info().Msgf("getting daily study for user %s from %v in %s", username, from, timezone)
+
+	day := func(field string) bson.D {
+		return bson.D{{"$dateToString", bson.D{
+			{"format", "%Y-%m-%d"},
+			{"date", field},
+			{"timezone", timezone},
+		}}}
+	}
+
+	p := mongo.Pipeline{
+		{{"$match", bson.D{{"username", username}}}},
+		{{"$facet", bson.D{
+			{"reviews", bson.A{
+				bson.D{{"$match", bson.D{{"card_answers.updated_at", bson.D{{"$gte", from}}}}}},
+				bson.D{{"$unwind", "$card_answers"}},
+				bson.D{{"$match", bson.D{{"card_answers.updated_at", bson.D{{"$gte", from}}}}}},
+				bson.D{{"$group", bson.D{
+					{"_id", day("$card_answers.updated_at")},
+					{"reviews", bson.D{{"$sum", 1}}},
+					{"correct", bson.D{{"$sum", bson.D{{"$cond", bson.A{"$card_answers.is_correct", 1, 0}}}}}},
+				}}},
+				bson.D{{"$sort", bson.D{{"_id", 1}}}},
+			}},
+			{"learned", bson.A{
+				bson.D{{"$unwind", "$card_answers"}},
+				bson.D{{"$match", bson.D{{"card_answers.is_correct", true}}}},
+				bson.D{{"$group", bson.D{
+					{"_id", "$card_answers.card_id"},
+					{"learned_at", bson.D{{"$min", "$card_answers.updated_at"}}},
+				}}},
+				bson.D{{"$group", bson.D{
+					{"_id", day("$learned_at")},
+					{"learned", bson.D{{"$sum", 1}}},
+				}}},
+				bson.D{{"$sort", bson.D{{"_id", 1}}}},
+			}},
+			{"study_time", bson.A{
+				bson.D{{"$match", bson.D{
+					{"abandoned_at", nil},
+					{"card_answers.updated_at", bson.D{{"$gte", from}}},
+				}}},
+				// Cards answered again are updated where they were first answered, so the answers are put back in the
+				// order they were given.
+				bson.D{{"$unwind", "$card_answers"}},
+				bson.D{{"$sort", bson.D{{"_id", 1}, {"card_answers.updated_at", 1}}}},
+				bson.D{{"$group", bson.D{
+					{"_id", "$_id"},
+					{"created_at", bson.D{{"$first", "$created_at"}}},
+					{"card_answers", bson.D{{"$push", "$card_answers"}}},
+				}}},
+				// Each answer took the time since the one before it, or since the session started for the first. A
+				// longer gap than models.MaxAnswerGap is time away, so only that much of it counts.
+				bson.D{{"$project", bson.D{{"timeline", bson.D{{"$reduce", bson.D{
+					{"input", "$card_answers"},
+					{"initialValue", bson.D{{"last", "$created_at"}, {"gaps", bson.A{}}}},
+					{"in", bson.D{
+						{"last", "$$this.updated_at"},
+						{"gaps", bson.D{{"$concatArrays", bson.A{"$$value.gaps", bson.A{bson.D{
+							{"at", "$$this.updated_at"},
+							{"milliseconds", bson.D{{"$min", bson.A{
+								bson.D{{"$max", bson.A{bson.D{{"$subtract", bson.A{"$$this.updated_at", "$$value.last"}}}, 0}}},
+								models.MaxAnswerGap.Milliseconds(),
+							}}}},
+						}}}}}},
+					}},
+				}}}}}}},
+				bson.D{{"$unwind", "$timeline.gaps"}},
+				bson.D{{"$project", bson.D{{"gaps", "$timeline.gaps"}}}},
+				bson.D{{"$match", bson.D{{"gaps.at", bson.D{{"$gte", from}}}}}},
+				bson.D{{"$group", bson.D{
+					{"_id", day("$gaps.at")},
+					{"milliseconds", bson.D{{"$sum", "$gaps.milliseconds"}}},
+				}}},
+				bson.D{{"$sort", bson.D{{"_id", 1}}}},
+			}},
+		}}},
+	}
+
+	c, err := s.collection.Aggregate(ctx, p)
+	if err != nil {
+		log.Error().Err(err).Msgf("while aggregating daily study for user %s", username)
+		return models.DailyStudy{}, errors.Join(err, ErrAggregate)
+	}
+	defer c.Close(ctx)
+
+	var study models.DailyStudy
+	if c.Next(ctx) {
+		err = c.Decode(&study)
+		if err != nil {
+			log.Error().Err(err).Msgf("while decoding daily study for user %s", username)
+			return models.DailyStudy{}, errors.Join(err, ErrAggregate)
+		}
+	}
+	if c.Err() != nil {
+		log.Error().Err(c.Err()).Msgf("while reading daily study for user %s", username)
+		return models.DailyStudy{}, errors.Join(c.Err(), ErrAggregate)
+	}
+	return study, nil
+}
@@ -3,11 +3,14 @@ package database
 import (
 	"context"
 	"github.com/google/uuid"
+	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"testing"
+	"time"
 )
 
 func TestSessionDAO_SetAnswerForCard(t *testing.T) {
@@ -18,16 +21,18 @@ func TestSessionDAO_SetAnswerForCard(t *testing.T) {
 	defer db.Close()
 
 	testCases := map[string]struct {
-		haveSessionID          string
-		haveCardID             string
-		hasIsAnsweredCorrectly bool
-		mockMongo              func(mongo *mtest.T)
-		wantErr                error
+		haveSessionID string
+		haveCardID    string
+		haveReversed  bool
+		haveGrade     models.Grade
+		haveLatency   models.AnswerLatency
+		mockMongo     func(mongo *mtest.T)
+		wantErr       error
 	}{
 		"add new card answer to collection when card_id does not exist in session array": {
-			haveSessionID:          uuid.NewString(),
-			haveCardID:             uuid.NewString(),
-			hasIsAnsweredCorrectly: true,
+			haveSessionID: uuid.NewString(),
+			haveCardID:    uuid.NewString(),
+			haveGrade:     models.GradeGood,
 			mockMongo: func(mt *mtest.T) {
 				mt.AddMockResponses(
 					mtest.CreateSuccessResponse(
@@ -42,9 +47,10 @@ func TestSessionDAO_SetAnswerForCard(t *testing.T) {
 			},
 		},
 		"update card answer in array when card_id exist in session array": {
-			haveSessionID:          uuid.NewString(),
-			haveCardID:             uuid.NewString(),
-			hasIsAnsweredCorrectly: true,
+			haveSessionID: uuid.NewString(),
+			haveCardID:    uuid.NewString(),
+			haveGrade:     models.GradeGood,
+			haveLatency:   models.AnswerLatency{Reveal: 2 * time.Second, Answer: 3 * time.Second},
 			mockMongo: func(mt *mtest.T) {
 				mt.AddMockResponses(
 					mtest.CreateSuccessResponse(
@@ -54,11 +60,29 @@ func TestSessionDAO_SetAnswerForCard(t *testing.T) {
 				)
 			},
 		},
+		"add answer for the reverse direction of a card answered front to back": {
+			haveSessionID: uuid.NewString(),
+			haveCardID:    uuid.NewString(),
+			haveReversed:  true,
+			haveGrade:     models.GradeHard,
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateSuccessResponse(
+						bson.E{Key: "n", Value: 0},
+						bson.E{Key: "nModified", Value: 0},
+					),
+					mtest.CreateSuccessResponse(
+						bson.E{Key: "n", Value: 1},
+						bson.E{Key: "nModified", Value: 1},
+					),
+				)
+			},
+		},
 		"should not update answers when session does not exist": {
-			haveSessionID:          uuid.NewString(),
-			haveCardID:             uuid.NewString(),
-			hasIsAnsweredCorrectly: true,
-			wantErr:                ErrFind,
+			haveSessionID: uuid.NewString(),
+			haveCardID:    uuid.NewString(),
+			haveGrade:     models.GradeGood,
+			wantErr:       ErrFind,
 			mockMongo: func(mt *mtest.T) {
 				mt.AddMockResponses(
 					mtest.CreateSuccessResponse(
@@ -73,9 +97,9 @@ func TestSessionDAO_SetAnswerForCard(t *testing.T) {
 			},
 		},
 		"return error from mongo on first query": {
-			haveSessionID:          uuid.NewString(),
-			haveCardID:             uuid.NewString(),
-			hasIsAnsweredCorrectly: true,
+			haveSessionID: uuid.NewString(),
+			haveCardID:    uuid.NewString(),
+			haveGrade:     models.GradeGood,
 			mockMongo: func(mt *mtest.T) {
 
 				mt.AddMockResponses(mtest.CreateSuccessResponse(
@@ -90,9 +114,9 @@ func TestSessionDAO_SetAnswerForCard(t *testing.T) {
 			wantErr: ErrUpdate,
 		},
 		"return error from mongo on second query": {
-			haveSessionID:          uuid.NewString(),
-			haveCardID:             uuid.NewString(),
-			hasIsAnsweredCorrectly: true,
+			haveSessionID: uuid.NewString(),
+			haveCardID:    uuid.NewString(),
+			haveGrade:     models.GradeGood,
 			mockMongo: func(mt *mtest.T) {
 				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
 					Code:    12345,
@@ -117,8 +141,528 @@ func TestSessionDAO_SetAnswerForCard(t *testing.T) {
 				log:        logger,
 			}
 
-			err := sessionDAO.SetAnswerForCard(ctx, tc.haveSessionID, tc.haveCardID, tc.hasIsAnsweredCorrectly)
+			err := sessionDAO.SetAnswerForCard(ctx, tc.haveSessionID, tc.haveCardID, tc.haveReversed, tc.haveGrade, tc.haveLatency)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestSessionDAO_SetAnswerForCard_AnsweredAgain(t *testing.T) {
+	db := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer db.Close()
+
+	db.Run("should date a card answered again on a later day by the later answer", func(mt *mtest.T) {
+		// The card was first answered yesterday, so the session already has an answer to replace.
+		mt.AddMockResponses(mtest.CreateSuccessResponse(
+			bson.E{Key: "n", Value: 1},
+			bson.E{Key: "nModified", Value: 1},
+		))
+		sessionDAO := SessionDAO{collection: mt.Coll, log: zerolog.Nop()}
+
+		before := time.Now().Truncate(time.Millisecond)
+		err := sessionDAO.SetAnswerForCard(context.Background(), "session-id", "card-id", false, models.GradeGood, models.AnswerLatency{})
+		require.NoError(t, err)
+		after := time.Now()
+
+		set := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("u", "$set").Document()
+		answeredAt := set.Lookup("card_answers.$.updated_at").Time()
+		assert.False(t, answeredAt.Before(before), "answer should be dated by the later answer")
+		assert.False(t, answeredAt.After(after), "answer should be dated by the later answer")
+	})
+}
+
+func TestSessionDAO_GetSessionByID(t *testing.T) {
+	var (
+		db        = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger    = zerolog.Nop()
+		sessionID = uuid.NewString()
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveAnswers bson.A
+		wantAnswers []models.CardAnswer
+	}{
+		"should read graded answers": {
+			haveAnswers: bson.A{
+				bson.D{{Key: "card_id", Value: "card-1"}, {Key: "is_correct", Value: true}, {Key: "grade", Value: models.GradeEasy}},
+				bson.D{{Key: "card_id", Value: "card-2"}, {Key: "is_correct", Value: true}, {Key: "grade", Value: models.GradeHard}},
+			},
+			wantAnswers: []models.CardAnswer{
+				{CardID: "card-1", IsCorrect: true, Grade: models.GradeEasy},
+				{CardID: "card-2", IsCorrect: true, Grade: models.GradeHard},
+			},
+		},
+		"should derive grade from is_correct for answers recorded before grading": {
+			haveAnswers: bson.A{
+				bson.D{{Key: "card_id", Value: "card-1"}, {Key: "is_correct", Value: true}},
+				bson.D{{Key: "card_id", Value: "card-2"}, {Key: "is_correct", Value: false}},
+			},
+			wantAnswers: []models.CardAnswer{
+				{CardID: "card-1", IsCorrect: true, Grade: models.GradeGood},
+				{CardID: "card-2", IsCorrect: false, Grade: models.GradeAgain},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "reptr.sessions", mtest.FirstBatch, bson.D{
+				{Key: "_id", Value: sessionID},
+				{Key: "card_answers", Value: tc.haveAnswers},
+			}))
+
+			sessionDAO := SessionDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			got, err := sessionDAO.GetSessionByID(context.Background(), sessionID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantAnswers, got.CardAnswers)
+		})
+	}
+}
+
+func TestSessionDAO_GetCardAnswerStats(t *testing.T) {
+	var (
+		db       = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger   = zerolog.Nop()
+		username = uuid.NewString()
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveCardIDs []string
+		mockMongo   func(mt *mtest.T)
+		wantStats   []models.CardAnswerStats
+		wantErr     error
+	}{
+		"should return answer totals per card": {
+			haveCardIDs: []string{"card-1", "card-2"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch,
+					bson.D{
+						{Key: "_id", Value: "card-1"},
+						{Key: "answered", Value: 3},
+						{Key: "correct", Value: 1},
+						{Key: "reveals_timed", Value: 2},
+						{Key: "total_reveal_latency", Value: int64(4 * time.Second)},
+						{Key: "answers_timed", Value: 2},
+						{Key: "total_answer_latency", Value: int64(10 * time.Second)},
+					},
+					bson.D{{Key: "_id", Value: "card-2"}, {Key: "answered", Value: 1}, {Key: "correct", Value: 1}},
+				))
+			},
+			wantStats: []models.CardAnswerStats{
+				{CardID: "card-1", Answered: 3, Correct: 1, RevealsTimed: 2, TotalRevealLatency: 4 * time.Second, AnswersTimed: 2, TotalAnswerLatency: 10 * time.Second},
+				{CardID: "card-2", Answered: 1, Correct: 1},
+			},
+		},
+		"should not query without cards": {},
+		"should return ErrAggregate when mongo errors": {
+			haveCardIDs: []string{"card-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "aggregate error",
+				}))
+			},
+			wantErr: ErrAggregate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			if tc.mockMongo != nil {
+				tc.mockMongo(mt)
+			}
+
+			sessionDAO := SessionDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			got, err := sessionDAO.GetCardAnswerStats(context.Background(), username, tc.haveCardIDs)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStats, got)
+		})
+	}
+}
+
+func TestSessionDAO_GetFinishedSessionsForUser(t *testing.T) {
+	var (
+		db         = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger     = zerolog.Nop()
+		username   = uuid.NewString()
+		startedAt  = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+		finishedAt = startedAt.Add(5 * time.Minute)
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveDeckID   string
+		mockMongo    func(mt *mtest.T)
+		wantSessions []models.DeckSession
+		wantErr      error
+	}{
+		"should return finished sessions": {
+			haveDeckID: "deck-1",
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch,
+					bson.D{
+						{Key: "_id", Value: "session-1"},
+						{Key: "username", Value: username},
+						{Key: "deck_id", Value: "deck-1"},
+						{Key: "deck_name", Value: "Deck"},
+						{Key: "finished_at", Value: finishedAt},
+						{Key: "created_at", Value: startedAt},
+					},
+				))
+			},
+			wantSessions: []models.DeckSession{{
+				ID:         "session-1",
+				Username:   username,
+				DeckID:     "deck-1",
+				DeckName:   "Deck",
+				FinishedAt: &finishedAt,
+				CreatedAt:  startedAt,
+			}},
+		},
+		"should return ErrNoResults when there are no sessions": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrAggregate when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "aggregate error",
+				}))
+			},
+			wantErr: ErrAggregate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			sessionDAO := SessionDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			got, err := sessionDAO.GetFinishedSessionsForUser(context.Background(), username, tc.haveDeckID, time.Time{}, nil, 10, 0)
 			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantSessions, got)
+		})
+	}
+}
+
+func TestSessionDAO_GetCardAnswerStatsForAllUsers(t *testing.T) {
+	var (
+		db     = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger = zerolog.Nop()
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		haveCardIDs []string
+		mockMongo   func(mt *mtest.T)
+		wantStats   []models.CardAnswerStats
+		wantErr     error
+	}{
+		"should return answer totals per card": {
+			haveCardIDs: []string{"card-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch,
+					bson.D{
+						{Key: "_id", Value: "card-1"},
+						{Key: "answered", Value: 5},
+						{Key: "correct", Value: 4},
+						{Key: "reveals_timed", Value: 0},
+						{Key: "total_reveal_latency", Value: 0},
+						{Key: "answers_timed", Value: 1},
+						{Key: "total_answer_latency", Value: int64(1500 * time.Millisecond)},
+					},
+				))
+			},
+			wantStats: []models.CardAnswerStats{
+				{CardID: "card-1", Answered: 5, Correct: 4, AnswersTimed: 1, TotalAnswerLatency: 1500 * time.Millisecond},
+			},
+		},
+		"should not query without cards": {},
+		"should return ErrAggregate when mongo errors": {
+			haveCardIDs: []string{"card-1"},
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "aggregate error",
+				}))
+			},
+			wantErr: ErrAggregate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			if tc.mockMongo != nil {
+				tc.mockMongo(mt)
+			}
+
+			sessionDAO := SessionDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			got, err := sessionDAO.GetCardAnswerStatsForAllUsers(context.Background(), tc.haveCardIDs)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStats, got)
+		})
+	}
+}
+
+func TestSessionDAO_AbandonIdleSessions(t *testing.T) {
+	var (
+		db     = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger = zerolog.Nop()
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo     func(mt *mtest.T)
+		wantAbandoned int64
+		wantErr       error
+	}{
+		"should return the number of abandoned sessions": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(
+					bson.E{Key: "n", Value: 2},
+					bson.E{Key: "nModified", Value: 2},
+				))
+			},
+			wantAbandoned: 2,
+		},
+		"should return ErrUpdate when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "update error",
+				}))
+			},
+			wantErr: ErrUpdate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			sessionDAO := SessionDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			got, err := sessionDAO.AbandonIdleSessions(context.Background(), time.Now().Add(-time.Hour))
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantAbandoned, got)
+		})
+	}
+}
+
+func TestSessionDAO_GetCardAnswersForUser(t *testing.T) {
+	var (
+		db         = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger     = zerolog.Nop()
+		username   = uuid.NewString()
+		answeredAt = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo   func(mt *mtest.T)
+		wantAnswers []models.CardAnswerRecord
+		wantErr     error
+	}{
+		"should return the answers with their sessions": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch,
+					bson.D{
+						{Key: "session_id", Value: "session-1"},
+						{Key: "deck_name", Value: "Deck"},
+						{Key: "answer", Value: bson.D{
+							{Key: "card_id", Value: "card-1"},
+							{Key: "is_correct", Value: true},
+							{Key: "grade", Value: models.GradeGood},
+							{Key: "created_at", Value: answeredAt},
+							{Key: "updated_at", Value: answeredAt},
+						}},
+					},
+				))
+			},
+			wantAnswers: []models.CardAnswerRecord{{
+				SessionID: "session-1",
+				DeckName:  "Deck",
+				Answer: models.CardAnswer{
+					CardID:    "card-1",
+					IsCorrect: true,
+					Grade:     models.GradeGood,
+					CreatedAt: answeredAt,
+					UpdatedAt: answeredAt,
+				},
+			}},
+		},
+		"should return ErrNoResults when the card was never answered": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrAggregate when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "aggregate error",
+				}))
+			},
+			wantErr: ErrAggregate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			sessionDAO := SessionDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			got, err := sessionDAO.GetCardAnswersForUser(context.Background(), username, "card-1")
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantAnswers, got)
+		})
+	}
+}
+
+func TestSessionDAO_GetDailyStudy(t *testing.T) {
+	var (
+		db       = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+		logger   = zerolog.Nop()
+		username = uuid.NewString()
+		from     = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockMongo  func(mt *mtest.T)
+		wantStudy  models.DailyStudy
+		wantErr    error
+		wantStages []string
+	}{
+		"should return the study grouped by day": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch,
+					bson.D{
+						{Key: "reviews", Value: bson.A{bson.D{{Key: "_id", Value: "2024-03-01"}, {Key: "reviews", Value: 4}, {Key: "correct", Value: 3}}}},
+						{Key: "learned", Value: bson.A{bson.D{{Key: "_id", Value: "2024-02-27"}, {Key: "learned", Value: 2}}}},
+						{Key: "study_time", Value: bson.A{bson.D{{Key: "_id", Value: "2024-03-01"}, {Key: "milliseconds", Value: int64(90000)}}}},
+					},
+				))
+			},
+			wantStudy: models.DailyStudy{
+				Reviews:   []models.DailyReviews{{Day: "2024-03-01", Reviews: 4, Correct: 3}},
+				Learned:   []models.DailyLearned{{Day: "2024-02-27", Learned: 2}},
+				StudyTime: []models.DailyStudyTime{{Day: "2024-03-01", Milliseconds: 90000}},
+			},
+		},
+		"should time study by the capped gaps between answers of sessions that were not abandoned": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch,
+					bson.D{
+						{Key: "reviews", Value: bson.A{}},
+						{Key: "learned", Value: bson.A{}},
+						{Key: "study_time", Value: bson.A{}},
+					},
+				))
+			},
+			wantStudy: models.DailyStudy{
+				Reviews:   []models.DailyReviews{},
+				Learned:   []models.DailyLearned{},
+				StudyTime: []models.DailyStudyTime{},
+			},
+			wantStages: []string{
+				`"abandoned_at": null`,
+				`"initialValue": {"last": "$created_at","gaps": []}`,
+				`{"$subtract": ["$$this.updated_at","$$value.last"]}`,
+				`{"$numberLong":"300000"}`,
+				`{"$sort": {"_id": {"$numberInt":"1"},"card_answers.updated_at": {"$numberInt":"1"}}}`,
+				`"card_answers": {"$push": "$card_answers"}`,
+				`{"$unwind": "$timeline.gaps"}`,
+				`"_id": {"$dateToString": {"format": "%Y-%m-%d","date": "$gaps.at","timezone": "UTC"}}`,
+			},
+		},
+		"should return no study when the user has never studied": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "reptr.deck_sessions", mtest.FirstBatch,
+					bson.D{
+						{Key: "reviews", Value: bson.A{}},
+						{Key: "learned", Value: bson.A{}},
+						{Key: "study_time", Value: bson.A{}},
+					},
+				))
+			},
+			wantStudy: models.DailyStudy{
+				Reviews:   []models.DailyReviews{},
+				Learned:   []models.DailyLearned{},
+				StudyTime: []models.DailyStudyTime{},
+			},
+		},
+		"should return ErrAggregate when mongo errors": {
+			mockMongo: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+					Code:    12345,
+					Message: "aggregate error",
+				}))
+			},
+			wantErr: ErrAggregate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			tc.mockMongo(mt)
+
+			sessionDAO := SessionDAO{
+				collection: mt.Coll,
+				log:        logger,
+			}
+
+			got, err := sessionDAO.GetDailyStudy(context.Background(), username, from, "UTC")
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStudy, got)
+
+			pipeline := mt.GetStartedEvent().Command.Lookup("pipeline").String()
+			for _, stage := range tc.wantStages {
+				assert.Contains(t, pipeline, stage)
+			}
 		})
 	}
 }
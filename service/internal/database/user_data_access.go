@@ -22,6 +22,7 @@ type (
 		GetUserByUsername(ctx context.Context, username string) (models.User, error)
 		GetGroupsForUser(ctx context.Context, username string, from time.Time, to *time.Time, limit, offset int) ([]models.HomePageGroup, error)
 		AddUserAsMemberOfGroup(ctx context.Context, username string, groupName string) error
+		UpdateStudyPreferences(ctx context.Context, username string, preferences models.StudyPreferences) error
 	}
 
 	UserDAO struct {
@@ -93,6 +94,29 @@ func (u *UserDAO) AddUserAsMemberOfGroup(ctx context.Context, username string, g
 	return nil
 }
 
+// UpdateStudyPreferences replaces the user's time zone and daily goal. ErrNoResults is returned when there is no
+// such user.
+func (u *UserDAO) UpdateStudyPreferences(ctx context.Context, username string, preferences models.StudyPreferences) error {
+	logger := u.log.With().Str("method", "UpdateStudyPreferences").Logger()
+	logger.Info().Msgf("updating study preferences for user %s to %+v", username, preferences)
+
+	res, err := u.collection.UpdateOne(ctx, bson.D{{"_id", username}}, bson.D{
+		{"$set", bson.D{
+			{"time_zone", preferences.TimeZone},
+			{"daily_goal", preferences.DailyGoal},
+		}},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while updating study preferences for user %s", username)
+		return errors.Join(err, ErrUpdate)
+	}
+	if res.MatchedCount == 0 {
+		return ErrNoResults
+	}
+
+	return nil
+}
+
 func (u *UserDAO) GetGroupsForUser(ctx context.Context, username string, from time.Time, to *time.Time, limit, offset int) ([]models.HomePageGroup, error) {
 	logger := u.log.With().Str("method", "GetGroupsWithDecksByUser").Logger()
 	logger.Info().Msgf("getting groups for user: %s", username)
@@ -313,3 +313,55 @@ func TestUserDAO_GetGroupsForUser(t *testing.T) {
 		})
 	}
 }
+
+func TestUserDAO_UpdateStudyPreferences(t *testing.T) {
+	var (
+		db = mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	)
+	defer db.Close()
+
+	testCases := map[string]struct {
+		mockDatabase func(mt *mtest.T)
+		wantErr      error
+	}{
+		"should update study preferences": {
+			mockDatabase: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(
+					bson.E{Key: "n", Value: 1},
+					bson.E{Key: "nModified", Value: 1},
+				))
+			},
+		},
+		"should return ErrNoResults when user does not exist": {
+			mockDatabase: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}))
+			},
+			wantErr: ErrNoResults,
+		},
+		"should return ErrUpdate when update fails": {
+			mockDatabase: func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+					Code:    12345,
+					Message: "update error",
+				}))
+			},
+			wantErr: ErrUpdate,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		db.Run(name, func(mt *mtest.T) {
+			dao := UserDAO{collection: mt.Coll, log: zerolog.Nop()}
+			tc.mockDatabase(mt)
+
+			gotErr := dao.UpdateStudyPreferences(context.Background(), "testuser", models.StudyPreferences{
+				TimeZone:  "Europe/Paris",
+				DailyGoal: models.DailyGoal{Kind: models.GoalCards, Target: 50},
+			})
+
+			assert.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
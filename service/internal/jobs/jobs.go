@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"github.com/rs/zerolog"
+	"sync"
+	"time"
+)
+
+type (
+	// Job is work the server repeats in the background while it is running.
+	Job interface {
+		Name() string
+		Run(ctx context.Context) error
+	}
+
+	// Runner runs jobs on an interval until their context is cancelled.
+	Runner struct {
+		logger zerolog.Logger
+		wg     sync.WaitGroup
+	}
+)
+
+// New returns a pointer to a new Runner instance
+func New(logger zerolog.Logger) *Runner {
+	logger = logger.With().Str("module", "jobs").Logger()
+	return &Runner{
+		logger: logger,
+	}
+}
+
+// Every runs the job once every interval, starting after the first interval, until ctx is cancelled. A failed run is
+// logged and the job is tried again at the next interval.
+func (r *Runner) Every(ctx context.Context, interval time.Duration, job Job) {
+	log := r.logger.With().Str("job", job.Name()).Logger()
+	log.Info().Msgf("running every %s", interval)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("stopped")
+				return
+			case <-ticker.C:
+				if err := job.Run(ctx); err != nil {
+					log.Error().Err(err).Msg("while running job")
+				}
+			}
+		}
+	}()
+}
+
+// Wait blocks until every job has stopped. Jobs stop once the context they were started with is cancelled and any
+// run in progress returns.
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
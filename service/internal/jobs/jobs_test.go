@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingJob struct {
+	runs atomic.Int32
+	err  error
+}
+
+func (j *countingJob) Name() string {
+	return "counting job"
+}
+
+func (j *countingJob) Run(_ context.Context) error {
+	j.runs.Add(1)
+	return j.err
+}
+
+func TestRunner_Every(t *testing.T) {
+	testCases := map[string]struct {
+		haveErr error
+	}{
+		"should run the job until cancelled": {},
+		"should keep running the job after it fails": {
+			haveErr: errors.New("job error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			job := &countingJob{err: tc.haveErr}
+
+			runner := New(zerolog.Nop())
+			runner.Every(ctx, time.Millisecond, job)
+
+			assert.Eventually(t, func() bool { return job.runs.Load() >= 2 }, time.Second, time.Millisecond)
+
+			cancel()
+			runner.Wait()
+			stopped := job.runs.Load()
+			time.Sleep(5 * time.Millisecond)
+			assert.Equal(t, stopped, job.runs.Load())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"github.com/rmarken/reptr/service/internal/logic/decks/session"
+	"time"
+)
+
+// SessionSweeper abandons study sessions that have been left idle for longer than a TTL, so opening a deck starts a
+// fresh session instead of resuming an old one.
+type SessionSweeper struct {
+	sessionController session.Controller
+	ttl               time.Duration
+}
+
+var _ Job = &SessionSweeper{}
+
+// NewSessionSweeper returns a pointer to a new SessionSweeper instance
+func NewSessionSweeper(sessionController session.Controller, ttl time.Duration) *SessionSweeper {
+	return &SessionSweeper{
+		sessionController: sessionController,
+		ttl:               ttl,
+	}
+}
+
+func (s *SessionSweeper) Name() string {
+	return "session sweeper"
+}
+
+func (s *SessionSweeper) Run(ctx context.Context) error {
+	_, err := s.sessionController.ExpireIdleSessions(ctx, s.ttl)
+	return err
+}
@@ -4,106 +4,321 @@ import (
 	"context"
 	"errors"
 	"github.com/a-h/templ"
+	"github.com/google/uuid"
 	"github.com/rmarken/reptr/service/internal/database"
+	"github.com/rmarken/reptr/service/internal/logic/grading"
+	"github.com/rmarken/reptr/service/internal/logic/scheduler"
+	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rmarken/reptr/service/internal/web/components/dumb"
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/mongo"
+	"path"
 	"strconv"
+	"time"
 )
 
 type (
 	Controller interface {
-		AnswerCurrentCard(ctx context.Context, sessionID string, isAnsweredCorrect bool) (templ.Component, error)
+		AnswerCurrentCard(ctx context.Context, sessionID, username string, grade models.Grade) (templ.Component, bool, error)
+		AnswerCurrentCardTyped(ctx context.Context, sessionID, username, typed string) (templ.Component, error)
+		AnswerCurrentCardChoice(ctx context.Context, sessionID, username string, selected []string) (templ.Component, error)
+		HoldCurrentCard(ctx context.Context, sessionID, username string, hold models.HoldKind) (templ.Component, bool, error)
 	}
 
 	Logic struct {
-		logger zerolog.Logger
-		repo   database.Repository
+		logger     zerolog.Logger
+		repo       database.Repository
+		schedulers scheduler.Selector
 	}
 )
 
-func New(log zerolog.Logger, repo database.Repository) *Logic {
+func New(log zerolog.Logger, repo database.Repository, schedulers scheduler.Selector) *Logic {
 	log = log.With().Str("service", "deck-viewer").Logger()
 	return &Logic{
-		logger: log,
-		repo:   repo,
+		logger:     log,
+		repo:       repo,
+		schedulers: schedulers,
 	}
 }
 
-func (l *Logic) AnswerCurrentCard(ctx context.Context, sessionID string, isAnsweredCorrect bool) (templ.Component, error) {
+// AnswerCurrentCard records the grade for the session's current card and returns the front of the next card.
+// When there are no cards left the session is ended and isFinished is true instead. Sessions answered by typing
+// and multiple choice cards cannot be self-graded. Only the session's user can answer its cards.
+func (l *Logic) AnswerCurrentCard(ctx context.Context, sessionID, username string, grade models.Grade) (next templ.Component, isFinished bool, err error) {
 	log := l.logger.With().Str("component", "AnswerCurrentCard").Logger()
 	log.Info().Msgf("updating card correct for session: %s", sessionID)
 
 	session, err := l.repo.GetSessionByID(ctx, sessionID)
 	if err != nil {
 		log.Error().Err(err).Msg("while getting session")
-		return nil, err
+		return nil, false, err
 	}
-
-	frontOfCard, err := l.repo.GetFrontOfNextCardByID(ctx, session.DeckID, session.CurrentCardID, session.Username)
+	if session.Username != username {
+		log.Error().Msgf("session %s does not belong to %s", sessionID, username)
+		return nil, false, database.ErrNoResults
+	}
+	if session.Mode == models.TypedMode {
+		return nil, false, ErrTypedAnswerRequired
+	}
+	backOfCard, err := l.repo.GetBackOfCardByID(ctx, session.CurrentDeckID(), session.CurrentCardID, session.Username, session.IsReversed, session.Tags)
 	if err != nil {
-		// End of session
-		if errors.Is(err, database.ErrNoResults) {
-			err = l.repo.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		log.Error().Err(err).Msg("while getting back of card")
+		return nil, false, err
+	}
+	if backOfCard.IsChoice() {
+		return nil, false, ErrChoiceRequired
+	}
 
-				err2 := l.repo.SetAnswerForCard(sessionContext, sessionID, session.CurrentCardID, isAnsweredCorrect)
-				if err2 != nil {
-					log.Error().Err(err2).Msg("while updating current card")
-					return nil, err2
-				}
+	frontOfCard, isFinished, err := l.recordAnswer(ctx, session, grade, time.Now())
+	if err != nil || isFinished {
+		return nil, isFinished, err
+	}
 
-				err2 = l.repo.UpdateCurrentCard(sessionContext, sessionID, session.CurrentCardID, false)
-				if err2 != nil {
-					log.Error().Err(err2).Msg("while updating current card")
-					return nil, err2
-				}
+	// Return next card
+	return nextFrontDisplay(session, frontOfCard), false, nil
+}
 
-				err2 = l.repo.EndSession(sessionContext, sessionID)
-				if err2 != nil {
-					log.Error().Err(err2).Msg("while ending session")
-					return nil, err2
-				}
-				return nil, nil
-			})
+// HoldCurrentCard suspends or buries the session's current card for the session's user, then moves the session on to
+// the next card without recording an answer and returns its front. When there are no cards left the session is ended
+// and isFinished is true instead. Only the session's user can hold its cards.
+func (l *Logic) HoldCurrentCard(ctx context.Context, sessionID, username string, hold models.HoldKind) (next templ.Component, isFinished bool, err error) {
+	log := l.logger.With().Str("component", "HoldCurrentCard").Logger()
+	log.Info().Msgf("putting a %s hold on the current card of session: %s", hold, sessionID)
 
-			if err != nil {
-				return nil, err
-			}
-			// End of session
-			backOfCard, err := l.repo.GetBackOfCardByID(ctx, session.DeckID, session.CurrentCardID, session.Username)
-			if err != nil {
-				return dumb.BackOfCardDisplay(dumb.CardBack{}), nil
+	if !hold.IsValid() {
+		return nil, false, ErrInvalidHold
+	}
+
+	session, err := l.repo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		log.Error().Err(err).Msg("while getting session")
+		return nil, false, err
+	}
+	if session.Username != username {
+		log.Error().Msgf("session %s does not belong to %s", sessionID, username)
+		return nil, false, database.ErrNoResults
+	}
+
+	now := time.Now()
+	if hold == models.SuspendHold {
+		err = l.repo.SuspendCard(ctx, session.Username, session.CurrentDeckID(), session.CurrentCardID)
+	} else {
+		err = l.repo.BuryCard(ctx, session.Username, session.CurrentDeckID(), session.CurrentCardID, models.BuriedUntil(now))
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("while holding card %s", session.CurrentCardID)
+		return nil, false, err
+	}
+
+	frontOfCard, err := l.nextCard(ctx, session, now)
+	if err != nil && !errors.Is(err, database.ErrNoResults) {
+		log.Error().Err(err).Msg("while getting front of card")
+		return nil, false, err
+	}
+	isFinished = errors.Is(err, database.ErrNoResults)
+
+	err = l.repo.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		if isFinished {
+			err2 := l.repo.UpdateCurrentCard(sessionContext, session.ID, session.CurrentCardID, session.IsReversed, false)
+			if err2 != nil {
+				return nil, err2
 			}
-			return dumb.BackOfCardDisplay(dumb.CardBack{
-				SessionID:      sessionID,
-				DeckID:         session.DeckID,
-				CardID:         backOfCard.CardID,
-				BackContent:    backOfCard.Answer,
-				NextCardID:     backOfCard.NextCard,
-				PreviousCardID: session.CurrentCardID,
-				IsUpvoted:      bool(backOfCard.IsUpvotedByUser),
-				IsDownvoted:    bool(backOfCard.IsDownvotedByUser),
-				VoteButtonData: dumb.VoteButtonsData{
-					CardID:            backOfCard.CardID,
-					UpvoteClass:       backOfCard.IsUpvotedByUser.UpvotedClass(),
-					DownvoteClass:     backOfCard.IsDownvotedByUser.DownvotedClass(),
-					UpvoteDirection:   backOfCard.IsUpvotedByUser.NextUpvoteDirection(),
-					DownvoteDirection: backOfCard.IsDownvotedByUser.DownvotedClass()},
-			}), nil
+			return nil, l.repo.EndSession(sessionContext, session.ID)
 		}
-		log.Error().Err(err).Msg("while getting front of card")
+		return nil, l.repo.UpdateCurrentCard(sessionContext, session.ID, frontOfCard.CardID, frontOfCard.Reversed, true)
+	})
+	if err != nil || isFinished {
+		if err != nil {
+			log.Error().Err(err).Msg("while moving past held card")
+		}
+		return nil, isFinished, err
+	}
+
+	return nextFrontDisplay(session, frontOfCard), false, nil
+}
+
+// nextFrontDisplay shows the front of the card the session moved on to, with the card it moved on from as the
+// previous card.
+func nextFrontDisplay(session models.DeckSession, frontOfCard models.FrontOfCard) templ.Component {
+	upcoming := models.SessionCard{CardID: frontOfCard.NextCard, DeckID: frontOfCard.DeckID}
+	if session.HasQueue() {
+		_, upcoming = session.Neighbours(frontOfCard.CardID, frontOfCard.Reversed)
+	}
+
+	return dumb.FrontCardDisplay(dumb.CardFront{
+		SessionID:        session.ID,
+		DeckID:           frontOfCard.DeckID,
+		CardID:           frontOfCard.CardID,
+		Reversed:         frontOfCard.Reversed,
+		Front:            frontOfCard.Content,
+		NextCardID:       upcoming.CardID,
+		NextDeckID:       upcoming.DeckID,
+		NextReversed:     upcoming.Reversed,
+		PreviousCardID:   session.CurrentCardID,
+		PreviousDeckID:   session.CurrentDeckID(),
+		PreviousReversed: session.IsReversed,
+		Downvotes:        strconv.Itoa(frontOfCard.Downvotes),
+		Upvotes:          strconv.Itoa(frontOfCard.Upvotes),
+		CardType:         frontOfCard.Kind.String(),
+		Typed:            session.Mode == models.TypedMode,
+		Choices:          dumb.Choices(session.ID, frontOfCard),
+		Media:            frontOfCard.Media,
+		Occlusion:        frontOfCard.Occlusion,
+	})
+}
+
+// AnswerCurrentCardTyped grades the answer typed for the session's current card against the card and records it.
+// It returns how the typed answer differs from the expected answer, with a link on to the next card or, once the
+// session has ended, to its summary. Only the session's user can answer its cards, and only before the back of the
+// card has been shown.
+func (l *Logic) AnswerCurrentCardTyped(ctx context.Context, sessionID, username, typed string) (templ.Component, error) {
+	log := l.logger.With().Str("component", "AnswerCurrentCardTyped").Logger()
+	log.Info().Msgf("grading typed answer for session: %s", sessionID)
+
+	session, err := l.repo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		log.Error().Err(err).Msg("while getting session")
+		return nil, err
+	}
+	if session.Username != username {
+		log.Error().Msgf("session %s does not belong to %s", sessionID, username)
+		return nil, database.ErrNoResults
+	}
+	if session.Mode != models.TypedMode {
+		return nil, ErrNotTypedSession
+	}
+	if !session.IsFront {
+		return nil, ErrAnswerShown
+	}
+
+	backOfCard, err := l.repo.GetBackOfCardByID(ctx, session.CurrentDeckID(), session.CurrentCardID, session.Username, session.IsReversed, session.Tags)
+	if err != nil {
+		log.Error().Err(err).Msg("while getting back of card")
 		return nil, err
 	}
 
+	result := grading.Grade(typed, backOfCard.Answer)
+	frontOfCard, isFinished, err := l.recordAnswer(ctx, session, result.Grade, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	data := dumb.TypedAnswerResult{
+		Reversed:    session.IsReversed,
+		Typed:       result.Typed,
+		Expected:    result.Expected,
+		Grade:       result.Grade,
+		Diff:        diffSegments(result.Diff),
+		ContinueURL: continueURL(sessionID, frontOfCard, isFinished),
+		IsFinished:  isFinished,
+	}
+	return dumb.TypedAnswerResultDisplay(data), nil
+}
+
+// AnswerCurrentCardChoice grades the options picked for the session's current multiple choice card and records it.
+// It returns the card's options marked as correct or wrong, with a link on to the next card or, once the session
+// has ended, to its summary. Only the session's user can answer its cards.
+func (l *Logic) AnswerCurrentCardChoice(ctx context.Context, sessionID, username string, selected []string) (templ.Component, error) {
+	log := l.logger.With().Str("component", "AnswerCurrentCardChoice").Logger()
+	log.Info().Msgf("grading picked options for session: %s", sessionID)
+
+	session, err := l.repo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		log.Error().Err(err).Msg("while getting session")
+		return nil, err
+	}
+	if session.Username != username {
+		log.Error().Msgf("session %s does not belong to %s", sessionID, username)
+		return nil, database.ErrNoResults
+	}
+
+	backOfCard, err := l.repo.GetBackOfCardByID(ctx, session.CurrentDeckID(), session.CurrentCardID, session.Username, session.IsReversed, session.Tags)
+	if err != nil {
+		log.Error().Err(err).Msg("while getting back of card")
+		return nil, err
+	}
+	if !backOfCard.IsChoice() {
+		return nil, ErrNotMultipleChoice
+	}
+
+	grade := grading.GradeChoices(backOfCard.Options, selected)
+	frontOfCard, isFinished, err := l.recordAnswer(ctx, session, grade, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return dumb.ChoiceAnswerResultDisplay(dumb.ChoiceAnswerResult{
+		Grade:       grade,
+		Choices:     dumb.ChoiceResults(sessionID, backOfCard.CardID, backOfCard.Options, selected),
+		ContinueURL: continueURL(sessionID, frontOfCard, isFinished),
+		IsFinished:  isFinished,
+	}), nil
+}
+
+// continueURL is where to go after answering a card: the next card, or the summary once the session has ended.
+func continueURL(sessionID string, next models.FrontOfCard, isFinished bool) string {
+	if isFinished {
+		return path.Join("/page/session-summary/", sessionID)
+	}
+	return dumb.FrontOfCardURL(next.DeckID, next.CardID, sessionID, next.Reversed)
+}
+
+// recordAnswer grades the session's current card and moves the session on to the next card, returning its front.
+// When there are no cards left the session is ended and isFinished is true instead. A failed card may become a leech
+// for the session's user.
+func (l *Logic) recordAnswer(ctx context.Context, session models.DeckSession, grade models.Grade, now time.Time) (next models.FrontOfCard, isFinished bool, err error) {
+	log := l.logger.With().Str("component", "recordAnswer").Logger()
+
+	latency := session.LatencyAt(now)
+	deck, err := l.repo.GetDeckByID(ctx, session.CurrentDeckID())
+	if err != nil {
+		log.Error().Err(err).Msg("while getting deck of current card")
+		return models.FrontOfCard{}, false, err
+	}
+	reviewState, err := l.nextReviewState(ctx, session, deck.SchedulerSettings, grade, now)
+	if err != nil {
+		log.Error().Err(err).Msg("while scheduling current card")
+		return models.FrontOfCard{}, false, err
+	}
+
+	frontOfCard, err := l.nextCard(ctx, session, now)
+	if err != nil && !errors.Is(err, database.ErrNoResults) {
+		log.Error().Err(err).Msg("while getting front of card")
+		return models.FrontOfCard{}, false, err
+	}
+	// End of session
+	isFinished = errors.Is(err, database.ErrNoResults)
+
 	err = l.repo.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (interface{}, error) {
 
-		err2 := l.repo.SetAnswerForCard(sessionContext, sessionID, session.CurrentCardID, isAnsweredCorrect)
+		err2 := l.repo.SetAnswerForCard(sessionContext, session.ID, session.CurrentCardID, session.IsReversed, grade, latency)
 		if err2 != nil {
 			log.Error().Err(err2).Msg("while updating current card")
 			return nil, err2
 		}
 
-		err2 = l.repo.UpdateCurrentCard(sessionContext, sessionID, frontOfCard.CardID, true)
+		err2 = l.repo.UpsertReviewState(sessionContext, reviewState)
+		if err2 != nil {
+			log.Error().Err(err2).Msg("while updating review state")
+			return nil, err2
+		}
+
+		if isFinished {
+			err2 = l.repo.UpdateCurrentCard(sessionContext, session.ID, session.CurrentCardID, session.IsReversed, false)
+			if err2 != nil {
+				log.Error().Err(err2).Msg("while updating current card")
+				return nil, err2
+			}
+
+			err2 = l.repo.EndSession(sessionContext, session.ID)
+			if err2 != nil {
+				log.Error().Err(err2).Msg("while ending session")
+				return nil, err2
+			}
+			return nil, nil
+		}
+
+		err2 = l.repo.UpdateCurrentCard(sessionContext, session.ID, frontOfCard.CardID, frontOfCard.Reversed, true)
 		if err2 != nil {
 			log.Error().Err(err2).Msg("while updating current card")
 			return nil, err2
@@ -113,19 +328,93 @@ func (l *Logic) AnswerCurrentCard(ctx context.Context, sessionID string, isAnswe
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("while answering current card")
-		return nil, err
+		return models.FrontOfCard{}, false, err
 	}
 
-	// Return next card
-	return dumb.FrontCardDisplay(dumb.CardFront{
-		SessionID:      sessionID,
-		DeckID:         session.DeckID,
-		CardID:         frontOfCard.CardID,
-		Front:          frontOfCard.Content,
-		NextCardID:     frontOfCard.NextCard,
-		PreviousCardID: session.CurrentCardID,
-		Downvotes:      strconv.Itoa(frontOfCard.Downvotes),
-		Upvotes:        strconv.Itoa(frontOfCard.Upvotes),
-		CardType:       "",
-	}), nil
+	if !grade.IsCorrect() {
+		// The answer is already saved, so a card that could not be flagged is left for its next failure.
+		err = l.flagLeech(ctx, session, deck.SchedulerSettings)
+		if err != nil {
+			log.Error().Err(err).Msgf("while checking whether card %s is a leech", session.CurrentCardID)
+		}
+	}
+	return frontOfCard, isFinished, nil
+}
+
+// flagLeech marks the session's current card as a leech for the user once they have failed it as often as the deck's
+// leech threshold allows, and suspends it when the deck says to. Cards are only suspended as they cross the
+// threshold, so a leech the user unsuspends stays in their study.
+func (l *Logic) flagLeech(ctx context.Context, session models.DeckSession, settings models.SchedulerSettings) error {
+	stats, err := l.repo.GetCardAnswerStats(ctx, session.Username, []string{session.CurrentCardID})
+	if err != nil {
+		return err
+	}
+
+	var failures int
+	for _, stat := range stats {
+		failures += stat.Missed()
+	}
+	threshold := settings.LeechThresholdOrDefault()
+	if failures < threshold {
+		return nil
+	}
+	return l.repo.MarkLeech(ctx, session.Username, session.CurrentDeckID(), session.CurrentCardID, failures, settings.SuspendLeeches && failures == threshold)
+}
+
+// nextCard returns the front of the card to study after the current one. Sessions with a queue follow it,
+// deck sessions move on to the deck's most overdue card with the session's tags. Cards the user holds are skipped.
+func (l *Logic) nextCard(ctx context.Context, session models.DeckSession, now time.Time) (models.FrontOfCard, error) {
+	if !session.HasQueue() {
+		return l.repo.GetFrontOfNextDueCard(ctx, session.DeckID, session.Username, session.Tags, answeredCardIDs(session), now)
+	}
+
+	held, err := l.repo.GetHeldCardIDs(ctx, session.Username, now)
+	if err != nil {
+		return models.FrontOfCard{}, err
+	}
+	next, ok := session.NextInQueue(held)
+	if !ok {
+		return models.FrontOfCard{}, database.ErrNoResults
+	}
+	return l.repo.GetFrontOfCardByID(ctx, next.DeckID, next.CardID, session.Username, next.Reversed, session.Tags)
+}
+
+// nextReviewState applies the answer for the session's current card to the user's review state for that card,
+// using the scheduler the card's deck is configured with.
+func (l *Logic) nextReviewState(ctx context.Context, session models.DeckSession, settings models.SchedulerSettings, grade models.Grade, now time.Time) (models.ReviewState, error) {
+	deckID := session.CurrentDeckID()
+	state, err := l.repo.GetReviewState(ctx, session.Username, session.CurrentCardID, session.IsReversed)
+	if err != nil {
+		if !errors.Is(err, database.ErrNoResults) {
+			return models.ReviewState{}, err
+		}
+		state = scheduler.NewReviewState(uuid.NewString(), session.Username, deckID, session.CurrentCardID, now)
+		state.Reversed = session.IsReversed
+	}
+
+	return l.schedulers.ForDeck(settings).Schedule(state, grade, now), nil
+}
+
+// answeredCardIDs returns the cards that should not be shown again in the session, including the current card.
+func answeredCardIDs(session models.DeckSession) []string {
+	ids := make([]string, 0, len(session.CardAnswers)+1)
+	for _, answer := range session.CardAnswers {
+		ids = append(ids, answer.CardID)
+	}
+	return append(ids, session.CurrentCardID)
+}
+
+// diffSegments marks up a diff of a typed answer for display.
+func diffSegments(diff []grading.Segment) []dumb.DiffSegment {
+	segments := make([]dumb.DiffSegment, len(diff))
+	for i, segment := range diff {
+		segments[i] = dumb.DiffSegment{Text: segment.Text}
+		switch segment.Op {
+		case grading.Missing:
+			segments[i].Class = "diff-missing"
+		case grading.Extra:
+			segments[i].Class = "diff-extra"
+		}
+	}
+	return segments
 }
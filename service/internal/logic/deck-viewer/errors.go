@@ -0,0 +1,12 @@
+package deck_viewer
+
+import "errors"
+
+var (
+	ErrTypedAnswerRequired = errors.New("session is answered by typing")
+	ErrNotTypedSession     = errors.New("session is not answered by typing")
+	ErrNotMultipleChoice   = errors.New("card is not answered by picking options")
+	ErrChoiceRequired      = errors.New("card is answered by picking options")
+	ErrAnswerShown         = errors.New("card's answer has already been shown")
+	ErrInvalidHold         = errors.New("cards can only be suspended or buried")
+)
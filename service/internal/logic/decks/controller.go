@@ -7,7 +7,10 @@ import (
 	"github.com/rmarken/reptr/service/internal/database"
 	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/sync/errgroup"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -24,15 +27,26 @@ type (
 		GetHomepageData(ctx context.Context, username string, from time.Time, to *time.Time, limit, offset int) (models.HomePageData, error)
 		CreateDeck(ctx context.Context, deckName, username string) (string, error)
 		GetDecks(ctx context.Context, from time.Time, to *time.Time, limit, offset int) ([]models.DeckWithCards, error)
-		GetFrontOfCardByID(ctx context.Context, deckID, cardID, username string) (models.FrontOfCard, error)
-		GetBackOfCardByID(ctx context.Context, deckID, cardID, username string) (models.BackOfCard, error)
+		GetFrontOfCardByID(ctx context.Context, deckID, cardID, username string, reversed bool, tags []string) (models.FrontOfCard, error)
+		GetBackOfCardByID(ctx context.Context, deckID, cardID, username string, reversed bool, tags []string) (models.BackOfCard, error)
 		AddCardToDeck(ctx context.Context, deckID string, card models.Card) error
 		UpdateCard(ctx context.Context, card models.Card) error
+		GetCardToEdit(ctx context.Context, cardID, username string) (models.Card, error)
+		EditCard(ctx context.Context, username string, edit models.Card) error
 		UpvoteDeck(ctx context.Context, deckID, userID string) error
 		RemoveUpvoteDeck(ctx context.Context, deckID, userID string) error
 		DownvoteDeck(ctx context.Context, deckID, userID string) error
 		RemoveDownvoteDeck(ctx context.Context, deckID, userID string) error
 		VoteCard(ctx context.Context, vote models.Vote, cardID, userID string) error
+		GetDeckByID(ctx context.Context, deckID string) (models.Deck, error)
+		GetDueCardsForUser(ctx context.Context, username string, dueBy time.Time) ([]models.DueCard, error)
+		GetTaggedCardsForUser(ctx context.Context, username string, tags []string) ([]models.Card, error)
+		GetSuspendedCardsForUser(ctx context.Context, username string) ([]models.HeldCard, error)
+		UnsuspendCards(ctx context.Context, username string, cardIDs []string) error
+		UpdateDeckScheduler(ctx context.Context, deckID, username string, settings models.SchedulerSettings) error
+		GetDeckStats(ctx context.Context, deckID, username string) (models.DeckStats, error)
+		GetCardHistory(ctx context.Context, cardID, username string) (models.CardHistory, error)
+		GetGroupLeaderboard(ctx context.Context, groupID, username string, window models.LeaderboardWindow, metric models.LeaderboardMetric, now time.Time) (models.Leaderboard, error)
 	}
 
 	Logic struct {
@@ -41,19 +55,29 @@ type (
 	}
 )
 
-func (l *Logic) GetFrontOfCardByID(ctx context.Context, deckID, cardID, username string) (models.FrontOfCard, error) {
+const (
+	minimumTargetRetention = 0.7
+	maximumTargetRetention = 0.99
+	minimumLeechThreshold  = 2
+	maximumLeechThreshold  = 99
+
+	// leaderboardTTL is how long a group's leaderboard is shown from its rollup before the sessions are counted again.
+	leaderboardTTL = 10 * time.Minute
+)
+
+func (l *Logic) GetFrontOfCardByID(ctx context.Context, deckID, cardID, username string, reversed bool, tags []string) (models.FrontOfCard, error) {
 	logger := l.logger.With().Str("method", "GetFrontOfCardByID").Logger()
 	logger.Info().Msgf("get front of card for cardID: %s", cardID)
 
-	return l.repo.GetFrontOfCardByID(ctx, deckID, cardID, username)
+	return l.repo.GetFrontOfCardByID(ctx, deckID, cardID, username, reversed, tags)
 
 }
 
-func (l *Logic) GetBackOfCardByID(ctx context.Context, deckID, cardID, username string) (models.BackOfCard, error) {
+func (l *Logic) GetBackOfCardByID(ctx context.Context, deckID, cardID, username string, reversed bool, tags []string) (models.BackOfCard, error) {
 	logger := l.logger.With().Str("method", "GetBackOfCardByID").Logger()
 	logger.Info().Msgf("get back of card for cardID: %s", cardID)
 
-	return l.repo.GetBackOfCardByID(ctx, deckID, cardID, username)
+	return l.repo.GetBackOfCardByID(ctx, deckID, cardID, username, reversed, tags)
 }
 
 func New(logger zerolog.Logger, repo database.Repository) *Logic {
@@ -114,7 +138,36 @@ func (l *Logic) AddCardToDeck(ctx context.Context, deckID string, card models.Ca
 	logger.Info().Msgf("Adding card: %v to deck: %s", card, deckID)
 
 	card.DeckID = deckID
-	err := l.repo.InsertCards(ctx, []models.Card{card})
+	card.Tags = models.NormalizeTags(card.Tags)
+	if card.Kind == models.MultipleChoice {
+		options, err := validOptions(card.Options)
+		if err != nil {
+			logger.Error().Err(err).Msg("while validating options")
+			return err
+		}
+		card.Options = options
+		card.Back = models.CorrectOptionsText(options)
+	}
+
+	cards := []models.Card{card}
+	switch card.Kind {
+	case models.Cloze:
+		var err error
+		cards, err = clozeCards(card)
+		if err != nil {
+			logger.Error().Err(err).Msg("while making cloze cards")
+			return err
+		}
+	case models.ImageOcclusion:
+		var err error
+		cards, err = occlusionCards(card)
+		if err != nil {
+			logger.Error().Err(err).Msg("while making image occlusion cards")
+			return err
+		}
+	}
+
+	err := l.repo.InsertCards(ctx, cards)
 	if err != nil {
 		logger.Error().Err(err).Msg("while inserting card")
 		return err
@@ -123,11 +176,106 @@ func (l *Logic) AddCardToDeck(ctx context.Context, deckID string, card models.Ca
 	return nil
 }
 
-// UpdateCard will update a card
+// validOptions drops options without text and gives the rest IDs, checking enough are left for a multiple choice
+// card with at least one correct option.
+func validOptions(options []models.CardOption) ([]models.CardOption, error) {
+	valid := make([]models.CardOption, 0, len(options))
+	hasCorrect := false
+	for _, option := range options {
+		option.Text = strings.TrimSpace(option.Text)
+		if option.Text == "" {
+			continue
+		}
+		if option.ID == "" {
+			option.ID = uuid.NewString()
+		}
+		hasCorrect = hasCorrect || option.IsCorrect
+		valid = append(valid, option)
+	}
+
+	if len(valid) < 2 {
+		return nil, ErrTooFewOptions
+	}
+	if !hasCorrect {
+		return nil, ErrNoCorrectOption
+	}
+	return valid, nil
+}
+
+// clozeCards makes a card for each deletion index of the note the card is written with.
+func clozeCards(note models.Card) ([]models.Card, error) {
+	indexes := models.ClozeIndexes(note.Note)
+	if len(indexes) == 0 {
+		return nil, ErrNoClozeDeletions
+	}
+	if note.NoteID == "" {
+		note.NoteID = uuid.NewString()
+	}
+
+	cards := make([]models.Card, len(indexes))
+	for i, index := range indexes {
+		cards[i] = clozeCard(note, index)
+		// The first card keeps the ID it was added with.
+		if i > 0 || cards[i].ID == "" {
+			cards[i].ID = uuid.NewString()
+		}
+	}
+	return cards, nil
+}
+
+// clozeCard renders the card for one deletion index of a note.
+func clozeCard(note models.Card, index int) models.Card {
+	note.ClozeIndex = index
+	note.Front = models.ClozeFront(note.Note, index)
+	note.Back = models.ClozeAnswer(note.Note, index)
+	return note
+}
+
+// occlusionCards makes a card for each mask drawn over the diagram the card is written with. Masks without a label
+// or outside the image are dropped.
+func occlusionCards(diagram models.Card) ([]models.Card, error) {
+	if diagram.Occlusion == nil || !diagram.Occlusion.Image.IsImage() {
+		return nil, ErrNoOcclusionImage
+	}
+	masks := make([]models.Region, 0, len(diagram.Occlusion.Masks))
+	for _, mask := range diagram.Occlusion.Masks {
+		mask.Label = strings.TrimSpace(mask.Label)
+		if mask.IsValid() {
+			masks = append(masks, mask)
+		}
+	}
+	if len(masks) == 0 {
+		return nil, ErrNoOcclusionMasks
+	}
+	if diagram.NoteID == "" {
+		diagram.NoteID = uuid.NewString()
+	}
+
+	cards := make([]models.Card, len(masks))
+	for i := range masks {
+		card := diagram
+		card.Occlusion = &models.Occlusion{Image: diagram.Occlusion.Image, Masks: masks, Index: i}
+		card.Back = masks[i].Label
+		// The first card keeps the ID it was added with.
+		if i > 0 || card.ID == "" {
+			card.ID = uuid.NewString()
+		}
+		cards[i] = card
+	}
+	return cards, nil
+}
+
+// UpdateCard will update a card. Updating a cloze card edits its note, adding, updating or removing the note's
+// other cards to match its deletions.
 func (l *Logic) UpdateCard(ctx context.Context, card models.Card) error {
 	logger := l.logger.With().Str("module", "UpdateCard").Logger()
 	logger.Info().Msgf("updating with card: %v", card)
 
+	card.Tags = models.NormalizeTags(card.Tags)
+	if card.Kind == models.Cloze {
+		return l.updateClozeNote(ctx, card)
+	}
+
 	err := l.repo.UpdateCard(ctx, card)
 	if err != nil {
 		logger.Error().Err(err).Msgf("while updating card")
@@ -136,6 +284,151 @@ func (l *Logic) UpdateCard(ctx context.Context, card models.Card) error {
 	return nil
 }
 
+// GetCardToEdit returns a card for the owner of its deck to edit.
+func (l *Logic) GetCardToEdit(ctx context.Context, cardID, username string) (models.Card, error) {
+	logger := l.logger.With().Str("method", "GetCardToEdit").Logger()
+	logger.Info().Msgf("getting card %s for %s to edit", cardID, username)
+
+	if cardID == "" {
+		logger.Error().Err(ErrEmptyCardID)
+		return models.Card{}, ErrEmptyCardID
+	}
+
+	cards, err := l.repo.GetCardsByIDs(ctx, []string{cardID})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting card %s", cardID)
+		return models.Card{}, err
+	}
+	card := cards[0]
+
+	deck, err := l.repo.GetDeckByID(ctx, card.DeckID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting deck %s", card.DeckID)
+		return models.Card{}, err
+	}
+	if deck.CreatedBy != username {
+		logger.Error().Err(ErrNotDeckOwner).Msgf("user %s cannot edit card %s of deck %s", username, cardID, card.DeckID)
+		return models.Card{}, ErrNotDeckOwner
+	}
+	return card, nil
+}
+
+// EditCard saves the text and tags of a card edited by the owner of its deck. The edit's front is the note of a cloze
+// card, whose cards are all rewritten from it and given its tags. Only basic cards have their back edited; the backs
+// of other cards are made from their options, deletions or masks.
+func (l *Logic) EditCard(ctx context.Context, username string, edit models.Card) error {
+	logger := l.logger.With().Str("method", "EditCard").Logger()
+	logger.Info().Msgf("editing card %s for %s", edit.ID, username)
+
+	card, err := l.GetCardToEdit(ctx, edit.ID, username)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(edit.Front) == "" {
+		logger.Error().Err(ErrEmptyCardFront).Msgf("card: %s", edit.ID)
+		return ErrEmptyCardFront
+	}
+	switch card.Kind {
+	case models.Cloze:
+		card.Note = edit.Front
+	case models.BasicCard:
+		if strings.TrimSpace(edit.Back) == "" {
+			logger.Error().Err(ErrEmptyCardBack).Msgf("card: %s", edit.ID)
+			return ErrEmptyCardBack
+		}
+		card.Front = edit.Front
+		card.Back = edit.Back
+	default:
+		card.Front = edit.Front
+	}
+	card.Tags = edit.Tags
+	card.UpdatedAt = time.Now().UTC()
+
+	return l.UpdateCard(ctx, card)
+}
+
+// updateClozeNote rewrites the cards of a note from its edited text and tags. Deletions that are still in the note
+// keep their cards, and so their review history.
+func (l *Logic) updateClozeNote(ctx context.Context, card models.Card) error {
+	logger := l.logger.With().Str("module", "updateClozeNote").Logger()
+
+	indexes := models.ClozeIndexes(card.Note)
+	if len(indexes) == 0 {
+		return ErrNoClozeDeletions
+	}
+
+	noteID := card.NoteID
+	if noteID == "" {
+		found, err := l.repo.GetCardsByIDs(ctx, []string{card.ID})
+		if err != nil {
+			logger.Error().Err(err).Msgf("while getting card %s", card.ID)
+			return err
+		}
+		noteID = found[0].NoteID
+	}
+
+	siblings, err := l.repo.GetCardsByNoteID(ctx, noteID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting cards of note %s", noteID)
+		return err
+	}
+	byIndex := make(map[int]models.Card, len(siblings))
+	for _, sibling := range siblings {
+		byIndex[sibling.ClozeIndex] = sibling
+	}
+
+	now := time.Now().UTC()
+	var updated, added []models.Card
+	for _, index := range indexes {
+		sibling, ok := byIndex[index]
+		if !ok {
+			newCard := siblings[0]
+			newCard.ID = uuid.NewString()
+			newCard.CreatedAt = now
+			sibling = newCard
+		}
+		delete(byIndex, index)
+
+		sibling.Note = card.Note
+		sibling.Tags = card.Tags
+		sibling.UpdatedAt = now
+		sibling = clozeCard(sibling, index)
+		if ok {
+			updated = append(updated, sibling)
+		} else {
+			added = append(added, sibling)
+		}
+	}
+	removed := make([]string, 0, len(byIndex))
+	for _, sibling := range byIndex {
+		removed = append(removed, sibling.ID)
+	}
+
+	return l.repo.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		for _, c := range updated {
+			err2 := l.repo.UpdateCard(sessionContext, c)
+			if err2 != nil {
+				logger.Error().Err(err2).Msgf("while updating card %s", c.ID)
+				return nil, err2
+			}
+		}
+		if len(added) > 0 {
+			err2 := l.repo.InsertCards(sessionContext, added)
+			if err2 != nil {
+				logger.Error().Err(err2).Msg("while inserting cards")
+				return nil, err2
+			}
+		}
+		err2 := l.repo.DeleteCards(sessionContext, removed)
+		if err2 != nil {
+			logger.Error().Err(err2).Msg("while deleting cards")
+			return nil, err2
+		}
+		return nil, nil
+	})
+}
+
 func (l *Logic) UpvoteDeck(ctx context.Context, deckID, userID string) error {
 	logger := l.logger.With().Str("module", "UpvoteDeck").Logger()
 	logger.Info().Msgf("Upvote deck %s for %s", deckID, userID)
@@ -275,10 +568,11 @@ func (l *Logic) GetHomepageData(ctx context.Context, username string, from time.
 	}
 
 	var (
-		groups            []models.HomePageGroup
-		decks             []models.GetDeckResults
-		g, errCtx         = errgroup.WithContext(ctx)
-		groupErr, deckErr error
+		groups                    []models.HomePageGroup
+		decks                     []models.GetDeckResults
+		due                       []models.DueCard
+		g, errCtx                 = errgroup.WithContext(ctx)
+		groupErr, deckErr, dueErr error
 	)
 
 	g.Go(func() error {
@@ -297,13 +591,20 @@ func (l *Logic) GetHomepageData(ctx context.Context, username string, from time.
 		return nil
 	})
 
+	g.Go(func() error {
+		due, dueErr = l.GetDueCardsForUser(errCtx, username, time.Now())
+		return dueErr
+	})
+
 	if err := g.Wait(); err != nil {
 		logger.Error().Err(err).Msgf("while getting home page data for user: %s", username)
 		return models.HomePageData{}, err
 	}
 	return models.HomePageData{
-		Groups: groups,
-		Decks:  decks,
+		Groups:    groups,
+		Decks:     decks,
+		DueByDeck: models.CountDueByDeck(due),
+		NumDue:    len(due),
 	}, nil
 }
 
@@ -319,6 +620,80 @@ func (l *Logic) GetGroupByID(ctx context.Context, groupID string) (models.GroupW
 	return group, nil
 }
 
+// GetGroupLeaderboard returns the group's members ranked by the metric over the window, counting the sessions of the
+// group's decks. Leaderboards are rolled up at most once every leaderboardTTL, so they can be that far behind. Only
+// members of the group can see its leaderboard; to anyone else it is not found.
+func (l *Logic) GetGroupLeaderboard(ctx context.Context, groupID, username string, window models.LeaderboardWindow, metric models.LeaderboardMetric, now time.Time) (models.Leaderboard, error) {
+	logger := l.logger.With().Str("method", "GetGroupLeaderboard").Logger()
+
+	if groupID == "" {
+		return models.Leaderboard{}, ErrEmptyGroupID
+	}
+	if !window.IsValid() {
+		return models.Leaderboard{}, ErrInvalidWindow
+	}
+	if !metric.IsValid() {
+		return models.Leaderboard{}, ErrInvalidMetric
+	}
+
+	group, err := l.repo.GetGroupByID(ctx, groupID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting group %s", groupID)
+		return models.Leaderboard{}, err
+	}
+	if !slices.Contains(group.Members, username) {
+		logger.Error().Msgf("user %s is not a member of group %s", username, groupID)
+		return models.Leaderboard{}, database.ErrNoResults
+	}
+
+	leaderboard, err := l.repo.GetLeaderboard(ctx, groupID, window)
+	if err != nil && !errors.Is(err, database.ErrNoResults) {
+		logger.Error().Err(err).Msgf("while getting %s leaderboard for group %s", window, groupID)
+		return models.Leaderboard{}, err
+	}
+	if err != nil || now.Sub(leaderboard.ComputedAt) >= leaderboardTTL {
+		leaderboard, err = l.rollUpLeaderboard(ctx, groupID, window, now)
+		if err != nil {
+			logger.Error().Err(err).Msgf("while rolling up %s leaderboard for group %s", window, groupID)
+			return models.Leaderboard{}, err
+		}
+	}
+
+	leaderboard.RankBy(metric)
+	return leaderboard, nil
+}
+
+// rollUpLeaderboard counts the sessions of the group's members over the window and caches the result.
+func (l *Logic) rollUpLeaderboard(ctx context.Context, groupID string, window models.LeaderboardWindow, now time.Time) (models.Leaderboard, error) {
+	logger := l.logger.With().Str("method", "rollUpLeaderboard").Logger()
+
+	entries, err := l.repo.GetLeaderboardEntries(ctx, groupID, window.Start(now))
+	if err != nil {
+		return models.Leaderboard{}, err
+	}
+
+	for i := range entries {
+		preferences := models.StudyPreferences{TimeZone: entries[i].TimeZone}
+		today := now.In(preferences.Location()).Format(models.StatsDayFormat)
+		entries[i].Streak = models.StreakOf(entries[i].StudyDays, today).Current
+		// The days are only needed for the streak, so they are left out of the rollup.
+		entries[i].StudyDays = nil
+	}
+
+	leaderboard := models.Leaderboard{
+		GroupID:    groupID,
+		Window:     window,
+		ComputedAt: now,
+		Entries:    entries,
+	}
+	err = l.repo.SaveLeaderboard(ctx, leaderboard)
+	if err != nil {
+		// The leaderboard is still right; it is only rolled up again on the next view.
+		logger.Error().Err(err).Msgf("while saving %s leaderboard for group %s", window, groupID)
+	}
+	return leaderboard, nil
+}
+
 func (l *Logic) GetCardsByDeckID(ctx context.Context, deckID string) (models.DeckWithCards, error) {
 	logger := l.logger.With().Str("method", "GetDeckWithCardsByID").Logger()
 
@@ -347,3 +722,344 @@ func (l *Logic) VoteCard(ctx context.Context, vote models.Vote, cardID, userID s
 		return errors.New("cannot process vote")
 	}
 }
+
+func (l *Logic) GetDeckByID(ctx context.Context, deckID string) (models.Deck, error) {
+	logger := l.logger.With().Str("method", "GetDeckByID").Logger()
+
+	deck, err := l.repo.GetDeckByID(ctx, deckID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting deck %s", deckID)
+		return models.Deck{}, err
+	}
+
+	return deck, nil
+}
+
+// UpdateDeckScheduler changes the algorithm used to schedule reviews of a deck. Only the deck's creator may change it.
+// FSRS decks without a target retention are given [models.DefaultTargetRetention], and decks without a leech
+// threshold are given [models.DefaultLeechThreshold].
+func (l *Logic) UpdateDeckScheduler(ctx context.Context, deckID, username string, settings models.SchedulerSettings) error {
+	logger := l.logger.With().Str("method", "UpdateDeckScheduler").Logger()
+	logger.Info().Msgf("updating scheduler for deck %s to %s", deckID, settings.Algorithm)
+
+	if deckID == "" {
+		logger.Error().Err(ErrEmptyDeckID).Msgf("deck: %s", deckID)
+		return ErrEmptyDeckID
+	}
+	if !settings.Algorithm.IsValid() {
+		logger.Error().Err(ErrInvalidScheduler).Msgf("scheduler: %s", settings.Algorithm)
+		return ErrInvalidScheduler
+	}
+
+	switch settings.Algorithm {
+	case models.FSRSScheduler:
+		if settings.TargetRetention == 0 {
+			settings.TargetRetention = models.DefaultTargetRetention
+		}
+		if settings.TargetRetention < minimumTargetRetention || settings.TargetRetention > maximumTargetRetention {
+			logger.Error().Err(ErrInvalidRetention).Msgf("target retention: %f", settings.TargetRetention)
+			return ErrInvalidRetention
+		}
+	default:
+		settings.TargetRetention = 0
+	}
+
+	settings.LeechThreshold = settings.LeechThresholdOrDefault()
+	if settings.LeechThreshold < minimumLeechThreshold || settings.LeechThreshold > maximumLeechThreshold {
+		logger.Error().Err(ErrInvalidLeech).Msgf("leech threshold: %d", settings.LeechThreshold)
+		return ErrInvalidLeech
+	}
+
+	deck, err := l.repo.GetDeckByID(ctx, deckID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting deck %s", deckID)
+		return err
+	}
+	if deck.CreatedBy != username {
+		logger.Error().Err(ErrNotDeckOwner).Msgf("user %s cannot change deck %s", username, deckID)
+		return ErrNotDeckOwner
+	}
+
+	err = l.repo.UpdateDeckSchedulerSettings(ctx, deckID, settings)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while updating scheduler for deck %s", deckID)
+		return err
+	}
+
+	return nil
+}
+
+// GetDueCardsForUser returns the cards due by dueBy across every deck the user built or can reach through a group.
+func (l *Logic) GetDueCardsForUser(ctx context.Context, username string, dueBy time.Time) ([]models.DueCard, error) {
+	logger := l.logger.With().Str("method", "GetDueCardsForUser").Logger()
+	logger.Info().Msgf("getting due cards for user %s", username)
+
+	if username == "" {
+		logger.Error().Err(ErrEmptyUsername)
+		return nil, ErrEmptyUsername
+	}
+
+	deckIDs, err := l.reachableDeckIDsForUser(ctx, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting decks for user %s", username)
+		return nil, err
+	}
+	if len(deckIDs) == 0 {
+		return nil, nil
+	}
+
+	due, err := l.repo.GetDueCards(ctx, deckIDs, username, dueBy)
+	if err != nil {
+		if errors.Is(err, database.ErrNoResults) {
+			return nil, nil
+		}
+		logger.Error().Err(err).Msgf("while getting due cards for user %s", username)
+		return nil, err
+	}
+
+	return due, nil
+}
+
+// GetTaggedCardsForUser returns the cards with every one of the tags across every deck the user built or can reach
+// through a group, oldest first.
+func (l *Logic) GetTaggedCardsForUser(ctx context.Context, username string, tags []string) ([]models.Card, error) {
+	logger := l.logger.With().Str("method", "GetTaggedCardsForUser").Logger()
+	logger.Info().Msgf("getting cards tagged %v for user %s", tags, username)
+
+	if username == "" {
+		logger.Error().Err(ErrEmptyUsername)
+		return nil, ErrEmptyUsername
+	}
+	tags = models.NormalizeTags(tags)
+	if len(tags) == 0 {
+		return nil, ErrEmptyTags
+	}
+
+	deckIDs, err := l.reachableDeckIDsForUser(ctx, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting decks for user %s", username)
+		return nil, err
+	}
+	if len(deckIDs) == 0 {
+		return nil, nil
+	}
+
+	cards, err := l.repo.GetCardsByTags(ctx, deckIDs, tags)
+	if err != nil {
+		if errors.Is(err, database.ErrNoResults) {
+			return nil, nil
+		}
+		logger.Error().Err(err).Msgf("while getting cards tagged %v", tags)
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+// GetSuspendedCardsForUser returns the cards the user has suspended with their holds, most recently suspended first.
+func (l *Logic) GetSuspendedCardsForUser(ctx context.Context, username string) ([]models.HeldCard, error) {
+	logger := l.logger.With().Str("method", "GetSuspendedCardsForUser").Logger()
+	logger.Info().Msgf("getting suspended cards for user %s", username)
+
+	if username == "" {
+		logger.Error().Err(ErrEmptyUsername)
+		return nil, ErrEmptyUsername
+	}
+
+	holds, err := l.repo.GetSuspendedCards(ctx, username)
+	if err != nil {
+		if errors.Is(err, database.ErrNoResults) {
+			return nil, nil
+		}
+		logger.Error().Err(err).Msgf("while getting suspended cards for user %s", username)
+		return nil, err
+	}
+
+	cardIDs := make([]string, len(holds))
+	for i, hold := range holds {
+		cardIDs[i] = hold.CardID
+	}
+	cards, err := l.repo.GetCardsByIDs(ctx, cardIDs)
+	if err != nil {
+		if errors.Is(err, database.ErrNoResults) {
+			return nil, nil
+		}
+		logger.Error().Err(err).Msgf("while getting suspended cards %v", cardIDs)
+		return nil, err
+	}
+
+	cardsByID := make(map[string]models.Card, len(cards))
+	for _, card := range cards {
+		cardsByID[card.ID] = card
+	}
+	held := make([]models.HeldCard, 0, len(holds))
+	for _, hold := range holds {
+		// Holds outlive the cards they are on, so holds of deleted cards are left out.
+		card, ok := cardsByID[hold.CardID]
+		if !ok {
+			continue
+		}
+		held = append(held, models.HeldCard{Card: card, Hold: hold})
+	}
+	return held, nil
+}
+
+// UnsuspendCards lets the user study the cards again.
+func (l *Logic) UnsuspendCards(ctx context.Context, username string, cardIDs []string) error {
+	logger := l.logger.With().Str("method", "UnsuspendCards").Logger()
+	logger.Info().Msgf("unsuspending %d cards for user %s", len(cardIDs), username)
+
+	if username == "" {
+		logger.Error().Err(ErrEmptyUsername)
+		return ErrEmptyUsername
+	}
+
+	err := l.repo.UnsuspendCards(ctx, username, cardIDs)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while unsuspending cards for user %s", username)
+		return err
+	}
+	return nil
+}
+
+// reachableDeckIDsForUser returns the IDs of the decks the user built or can reach through a group.
+func (l *Logic) reachableDeckIDsForUser(ctx context.Context, username string) ([]string, error) {
+	decks, err := l.repo.GetDecksForUser(ctx, username, time.Time{}, nil, 0, 0)
+	if err != nil && !errors.Is(err, database.ErrNoResults) {
+		return nil, err
+	}
+
+	groups, err := l.repo.GetGroupsForUser(ctx, username, time.Time{}, nil, 0, 0)
+	if err != nil && !errors.Is(err, database.ErrNoResults) {
+		return nil, err
+	}
+
+	return reachableDeckIDs(decks, groups), nil
+}
+
+// reachableDeckIDs returns the IDs of the user's own decks followed by those of their groups, without duplicates.
+func reachableDeckIDs(decks []models.GetDeckResults, groups []models.HomePageGroup) []string {
+	seen := make(map[string]bool)
+	ids := make([]string, 0, len(decks))
+	add := func(id string) {
+		if id == "" || seen[id] {
+			return
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	for _, deck := range decks {
+		add(deck.ID)
+	}
+	for _, group := range groups {
+		for _, id := range group.DeckIDs {
+			add(id)
+		}
+	}
+	return ids
+}
+
+// GetDeckStats reports how every learner has answered each card of the deck, including how long answers
+// take, and which cards are leeches for the most learners. Only the deck's owner can see them.
+func (l *Logic) GetDeckStats(ctx context.Context, deckID, username string) (models.DeckStats, error) {
+	logger := l.logger.With().Str("method", "GetDeckStats").Logger()
+	logger.Info().Msgf("getting card stats for deck %s", deckID)
+
+	deck, err := l.repo.GetDeckWithCardsByID(ctx, deckID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting deck %s", deckID)
+		return models.DeckStats{}, err
+	}
+	if deck.CreatedBy != username {
+		logger.Error().Err(ErrNotDeckOwner).Msgf("user %s cannot see stats of deck %s", username, deckID)
+		return models.DeckStats{}, ErrNotDeckOwner
+	}
+
+	cardIDs := make([]string, len(deck.Cards))
+	for i, card := range deck.Cards {
+		cardIDs[i] = card.ID
+	}
+	stats, err := l.repo.GetCardAnswerStatsForAllUsers(ctx, cardIDs)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting answer stats for deck %s", deckID)
+		return models.DeckStats{}, err
+	}
+
+	statsByCard := make(map[string]models.CardAnswerStats, len(stats))
+	for _, stat := range stats {
+		statsByCard[stat.CardID] = stat
+	}
+	deckStats := models.DeckStats{
+		DeckID:   deck.ID,
+		DeckName: deck.Name,
+		Cards:    make([]models.CardStats, len(deck.Cards)),
+	}
+	cardsByID := make(map[string]models.Card, len(deck.Cards))
+	for i, card := range deck.Cards {
+		deckStats.Cards[i] = models.CardStats{Card: card, Stats: statsByCard[card.ID]}
+		cardsByID[card.ID] = card
+	}
+
+	leeches, err := l.repo.GetLeechCounts(ctx, deckID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting leeches of deck %s", deckID)
+		return models.DeckStats{}, err
+	}
+	for _, leech := range leeches {
+		// Holds outlive the cards they are on, so leeches of deleted cards are left out.
+		card, ok := cardsByID[leech.CardID]
+		if !ok {
+			continue
+		}
+		deckStats.Leeches = append(deckStats.Leeches, models.CardLeech{Card: card, LeechCount: leech})
+	}
+	return deckStats, nil
+}
+
+// GetCardHistory returns every answer the user has given to the card, with the card's votes. Cards of decks the user
+// cannot reach are not found.
+func (l *Logic) GetCardHistory(ctx context.Context, cardID, username string) (models.CardHistory, error) {
+	logger := l.logger.With().Str("method", "GetCardHistory").Logger()
+	logger.Info().Msgf("getting history of card %s for user %s", cardID, username)
+
+	if username == "" {
+		logger.Error().Err(ErrEmptyUsername)
+		return models.CardHistory{}, ErrEmptyUsername
+	}
+	if cardID == "" {
+		logger.Error().Err(ErrEmptyCardID)
+		return models.CardHistory{}, ErrEmptyCardID
+	}
+
+	cards, err := l.repo.GetCardsByIDs(ctx, []string{cardID})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting card %s", cardID)
+		return models.CardHistory{}, err
+	}
+	card := cards[0]
+
+	deckIDs, err := l.reachableDeckIDsForUser(ctx, username)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting decks for user %s", username)
+		return models.CardHistory{}, err
+	}
+	if !slices.Contains(deckIDs, card.DeckID) {
+		logger.Error().Msgf("user %s cannot reach deck %s of card %s", username, card.DeckID, cardID)
+		return models.CardHistory{}, database.ErrNoResults
+	}
+
+	votes, err := l.repo.GetBackOfCardByID(ctx, card.DeckID, cardID, username, false, nil)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting votes of card %s", cardID)
+		return models.CardHistory{}, err
+	}
+
+	answers, err := l.repo.GetCardAnswersForUser(ctx, username, cardID)
+	if err != nil && !errors.Is(err, database.ErrNoResults) {
+		logger.Error().Err(err).Msgf("while getting answers to card %s", cardID)
+		return models.CardHistory{}, err
+	}
+
+	return models.CardHistory{Card: card, Votes: votes, Answers: answers}, nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/rmarken/reptr/service/internal/models"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/mock/gomock"
 	"testing"
 	"time"
@@ -148,9 +150,14 @@ func TestLogic_AddCardToDeck(t *testing.T) {
 		Kind:      1, // Adjust according to your model
 		CreatedAt: timeNow,
 		UpdatedAt: timeNow,
+		Options: []models.CardOption{
+			{ID: "option-1", Text: "Right", IsCorrect: true},
+			{ID: "option-2", Text: "Wrong"},
+		},
 	}
 
 	testCases := map[string]struct {
+		haveOptions            []models.CardOption
 		mockRepositoryResponse func(mockRepo *database.MockRepository)
 		wantErr                error
 	}{
@@ -166,6 +173,33 @@ func TestLogic_AddCardToDeck(t *testing.T) {
 			},
 			wantErr: dbErrors.ErrInsert,
 		},
+		"should drop empty options and answer with the correct ones": {
+			haveOptions: []models.CardOption{
+				{Text: " Mercury ", IsCorrect: true},
+				{Text: "  "},
+				{Text: "Venus"},
+				{Text: "Mars", IsCorrect: true},
+			},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().InsertCards(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, cards []models.Card) error {
+					assert.Len(t, cards[0].Options, 3)
+					for _, option := range cards[0].Options {
+						assert.NotEmpty(t, option.ID)
+					}
+					assert.Equal(t, "Mercury, Mars", cards[0].Back)
+					assert.Equal(t, deckID, cards[0].DeckID)
+					return nil
+				})
+			},
+		},
+		"should return error if multiple choice card has too few options": {
+			haveOptions: []models.CardOption{{Text: "Mercury", IsCorrect: true}, {Text: ""}},
+			wantErr:     ErrTooFewOptions,
+		},
+		"should return error if multiple choice card has no correct option": {
+			haveOptions: []models.CardOption{{Text: "Mercury"}, {Text: "Venus"}},
+			wantErr:     ErrNoCorrectOption,
+		},
 		// Add more test cases to cover other scenarios if needed
 	}
 
@@ -181,8 +215,13 @@ func TestLogic_AddCardToDeck(t *testing.T) {
 				tc.mockRepositoryResponse(mockRepo)
 			}
 
+			card := testCard
+			if tc.haveOptions != nil {
+				card.Options = tc.haveOptions
+			}
+
 			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
-			gotErr := logic.AddCardToDeck(ctx, deckID, testCard)
+			gotErr := logic.AddCardToDeck(ctx, deckID, card)
 
 			assert.ErrorIs(t, gotErr, tc.wantErr)
 		})
@@ -196,7 +235,7 @@ func TestLogic_UpdateCard(t *testing.T) {
 		ID:        "your_card_id",
 		Front:     "Updated front content",
 		Back:      "Updated back content",
-		Kind:      2, // Updated kind value, adjust as per your model
+		Kind:      models.BasicCard,
 		CreatedAt: timeNow,
 		UpdatedAt: timeNow,
 	}
@@ -239,6 +278,383 @@ func TestLogic_UpdateCard(t *testing.T) {
 	}
 }
 
+func TestLogic_AddCardToDeck_ClozeNote(t *testing.T) {
+	ctx := context.Background()
+	deckID := "deck-id"
+
+	testCases := map[string]struct {
+		haveNote  string
+		wantCards []models.Card
+		wantErr   error
+	}{
+		"should make one card for each deletion index": {
+			haveNote: "The {{c1::mitochondria}} is the {{c2::powerhouse::what}} of the {{c1::cell}}",
+			wantCards: []models.Card{
+				{ClozeIndex: 1, Front: "The [...] is the powerhouse of the [...]", Back: "mitochondria, cell"},
+				{ClozeIndex: 2, Front: "The mitochondria is the [what] of the cell", Back: "powerhouse"},
+			},
+		},
+		"should return ErrNoClozeDeletions when the note has no deletions": {
+			haveNote: "The mitochondria is the powerhouse of the cell",
+			wantErr:  ErrNoClozeDeletions,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := database.NewMockRepository(ctrl)
+			if tc.wantErr == nil {
+				mockRepo.EXPECT().InsertCards(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, cards []models.Card) error {
+					assert.Len(t, cards, len(tc.wantCards))
+					for i, card := range cards {
+						assert.Equal(t, tc.wantCards[i].ClozeIndex, card.ClozeIndex)
+						assert.Equal(t, tc.wantCards[i].Front, card.Front)
+						assert.Equal(t, tc.wantCards[i].Back, card.Back)
+						assert.Equal(t, tc.haveNote, card.Note)
+						assert.Equal(t, cards[0].NoteID, card.NoteID)
+						assert.Equal(t, deckID, card.DeckID)
+					}
+					assert.Equal(t, "card-id", cards[0].ID)
+					assert.NotEqual(t, cards[0].ID, cards[1].ID)
+					assert.NotEmpty(t, cards[0].NoteID)
+					return nil
+				})
+			}
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+			gotErr := logic.AddCardToDeck(ctx, deckID, models.Card{ID: "card-id", Kind: models.Cloze, Note: tc.haveNote})
+
+			assert.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
+
+func TestLogic_AddCardToDeck_ImageOcclusion(t *testing.T) {
+	ctx := context.Background()
+	deckID := "deck-id"
+	image := models.MediaRef{ID: "image-id", ContentType: "image/png"}
+	heart := models.Region{X: 10, Y: 10, Width: 20, Height: 10, Label: "Heart"}
+	lung := models.Region{X: 50, Y: 40, Width: 30, Height: 20, Label: " Lung "}
+
+	testCases := map[string]struct {
+		haveOcclusion *models.Occlusion
+		wantBacks     []string
+		wantMasks     []models.Region
+		wantErr       error
+	}{
+		"should make one card for each mask": {
+			haveOcclusion: &models.Occlusion{Image: image, Masks: []models.Region{heart, lung}},
+			wantBacks:     []string{"Heart", "Lung"},
+			wantMasks:     []models.Region{heart, {X: 50, Y: 40, Width: 30, Height: 20, Label: "Lung"}},
+		},
+		"should drop masks without a label or outside the image": {
+			haveOcclusion: &models.Occlusion{Image: image, Masks: []models.Region{
+				heart,
+				{X: 10, Y: 10, Width: 20, Height: 10},
+				{X: 90, Y: 10, Width: 20, Height: 10, Label: "Off the edge"},
+			}},
+			wantBacks: []string{"Heart"},
+			wantMasks: []models.Region{heart},
+		},
+		"should return ErrNoOcclusionMasks when no mask is usable": {
+			haveOcclusion: &models.Occlusion{Image: image, Masks: []models.Region{{X: 10, Y: 10, Label: "Empty"}}},
+			wantErr:       ErrNoOcclusionMasks,
+		},
+		"should return ErrNoOcclusionImage without an image": {
+			wantErr: ErrNoOcclusionImage,
+		},
+		"should return ErrNoOcclusionImage when the media is not an image": {
+			haveOcclusion: &models.Occlusion{Image: models.MediaRef{ID: "audio-id", ContentType: "audio/mpeg"}, Masks: []models.Region{heart}},
+			wantErr:       ErrNoOcclusionImage,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := database.NewMockRepository(ctrl)
+			if tc.wantErr == nil {
+				mockRepo.EXPECT().InsertCards(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, cards []models.Card) error {
+					assert.Len(t, cards, len(tc.wantBacks))
+					for i, card := range cards {
+						assert.Equal(t, tc.wantBacks[i], card.Back)
+						assert.Equal(t, i, card.Occlusion.Index)
+						assert.Equal(t, tc.wantMasks, card.Occlusion.Masks)
+						assert.Equal(t, image, card.Occlusion.Image)
+						assert.Equal(t, cards[0].NoteID, card.NoteID)
+						assert.Equal(t, deckID, card.DeckID)
+					}
+					assert.Equal(t, "card-id", cards[0].ID)
+					assert.NotEmpty(t, cards[0].NoteID)
+					return nil
+				})
+			}
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+			gotErr := logic.AddCardToDeck(ctx, deckID, models.Card{
+				ID:        "card-id",
+				Kind:      models.ImageOcclusion,
+				Front:     "Name the masked organ",
+				Occlusion: tc.haveOcclusion,
+			})
+
+			assert.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
+
+func TestLogic_UpdateCard_ClozeNote(t *testing.T) {
+	ctx := context.Background()
+	siblings := []models.Card{
+		{ID: "card-1", DeckID: "deck-id", Kind: models.Cloze, NoteID: "note-id", ClozeIndex: 1, Note: "{{c1::a}} {{c2::b}}"},
+		{ID: "card-2", DeckID: "deck-id", Kind: models.Cloze, NoteID: "note-id", ClozeIndex: 2, Note: "{{c1::a}} {{c2::b}}"},
+	}
+
+	testCases := map[string]struct {
+		haveCard               models.Card
+		mockRepositoryResponse func(mockRepo *database.MockRepository)
+		wantErr                error
+	}{
+		"should update kept deletions, add new ones and remove dropped ones": {
+			haveCard: models.Card{ID: "card-1", Kind: models.Cloze, NoteID: "note-id", Note: "{{c1::x}} {{c3::c}}"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByNoteID(gomock.Any(), "note-id").Return(siblings, nil)
+				mockRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, callback func(mongo.SessionContext) (interface{}, error), _ ...*options.TransactionOptions) error {
+						_, err := callback(mongo.NewSessionContext(ctx, nil))
+						return err
+					})
+				mockRepo.EXPECT().UpdateCard(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, card models.Card) error {
+					assert.Equal(t, "card-1", card.ID)
+					assert.Equal(t, "[...] c", card.Front)
+					assert.Equal(t, "x", card.Back)
+					return nil
+				})
+				mockRepo.EXPECT().InsertCards(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, cards []models.Card) error {
+					assert.Len(t, cards, 1)
+					assert.Equal(t, 3, cards[0].ClozeIndex)
+					assert.Equal(t, "note-id", cards[0].NoteID)
+					assert.Equal(t, "deck-id", cards[0].DeckID)
+					assert.Equal(t, "x [...]", cards[0].Front)
+					assert.NotContains(t, []string{"card-1", "card-2"}, cards[0].ID)
+					return nil
+				})
+				mockRepo.EXPECT().DeleteCards(gomock.Any(), []string{"card-2"}).Return(nil)
+			},
+		},
+		"should give every card of the note the edited tags": {
+			haveCard: models.Card{ID: "card-1", Kind: models.Cloze, NoteID: "note-id", Note: "{{c1::a}} {{c2::b}}", Tags: []string{"Biology", " cells "}},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByNoteID(gomock.Any(), "note-id").Return(siblings, nil)
+				mockRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, callback func(mongo.SessionContext) (interface{}, error), _ ...*options.TransactionOptions) error {
+						_, err := callback(mongo.NewSessionContext(ctx, nil))
+						return err
+					})
+				mockRepo.EXPECT().UpdateCard(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, card models.Card) error {
+					assert.Equal(t, []string{"biology", "cells"}, card.Tags)
+					return nil
+				}).Times(2)
+				mockRepo.EXPECT().DeleteCards(gomock.Any(), []string{}).Return(nil)
+			},
+		},
+		"should look up the note of a card without a note ID": {
+			haveCard: models.Card{ID: "card-2", Kind: models.Cloze, Note: "{{c1::a}} {{c2::b}}"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-2"}).Return(siblings[1:], nil)
+				mockRepo.EXPECT().GetCardsByNoteID(gomock.Any(), "note-id").Return(siblings, nil)
+				mockRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"should return ErrNoClozeDeletions when every deletion is removed": {
+			haveCard: models.Card{ID: "card-1", Kind: models.Cloze, NoteID: "note-id", Note: "a b"},
+			wantErr:  ErrNoClozeDeletions,
+		},
+		"should return error if getting the note's cards fails": {
+			haveCard: models.Card{ID: "card-1", Kind: models.Cloze, NoteID: "note-id", Note: "{{c1::a}}"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByNoteID(gomock.Any(), "note-id").Return(nil, dbErrors.ErrFind)
+			},
+			wantErr: dbErrors.ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := database.NewMockRepository(ctrl)
+			if tc.mockRepositoryResponse != nil {
+				tc.mockRepositoryResponse(mockRepo)
+			}
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+			gotErr := logic.UpdateCard(ctx, tc.haveCard)
+
+			assert.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
+
+func TestLogic_EditCard(t *testing.T) {
+	ctx := context.Background()
+	deck := models.Deck{ID: "deck-id", CreatedBy: "owner"}
+	basic := models.Card{ID: "card-1", DeckID: "deck-id", Kind: models.BasicCard, Front: "front", Back: "back"}
+	cloze := models.Card{ID: "card-1", DeckID: "deck-id", Kind: models.Cloze, NoteID: "note-id", ClozeIndex: 1, Note: "{{c1::a}}"}
+
+	testCases := map[string]struct {
+		haveUsername           string
+		haveEdit               models.Card
+		mockRepositoryResponse func(mockRepo *database.MockRepository)
+		wantErr                error
+	}{
+		"should save the front and back of a basic card": {
+			haveUsername: "owner",
+			haveEdit:     models.Card{ID: "card-1", Front: "new front", Back: "new back"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{basic}, nil)
+				mockRepo.EXPECT().GetDeckByID(gomock.Any(), "deck-id").Return(deck, nil)
+				mockRepo.EXPECT().UpdateCard(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, card models.Card) error {
+					assert.Equal(t, "card-1", card.ID)
+					assert.Equal(t, "new front", card.Front)
+					assert.Equal(t, "new back", card.Back)
+					assert.Equal(t, models.BasicCard, card.Kind)
+					return nil
+				})
+			},
+		},
+		"should rewrite the note of a cloze card from the edited front": {
+			haveUsername: "owner",
+			haveEdit:     models.Card{ID: "card-1", Front: "{{c1::b}}"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{cloze}, nil)
+				mockRepo.EXPECT().GetDeckByID(gomock.Any(), "deck-id").Return(deck, nil)
+				mockRepo.EXPECT().GetCardsByNoteID(gomock.Any(), "note-id").Return([]models.Card{cloze}, nil)
+				mockRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, callback func(mongo.SessionContext) (interface{}, error), _ ...*options.TransactionOptions) error {
+						_, err := callback(mongo.NewSessionContext(ctx, nil))
+						return err
+					})
+				mockRepo.EXPECT().UpdateCard(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, card models.Card) error {
+					assert.Equal(t, "{{c1::b}}", card.Note)
+					assert.Equal(t, "[...]", card.Front)
+					assert.Equal(t, "b", card.Back)
+					return nil
+				})
+				mockRepo.EXPECT().DeleteCards(gomock.Any(), []string{}).Return(nil)
+			},
+		},
+		"should give every card of a cloze note the edited tags": {
+			haveUsername: "owner",
+			haveEdit:     models.Card{ID: "card-1", Front: "{{c1::a}} {{c2::b}}", Tags: []string{"Biology"}},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{cloze}, nil)
+				mockRepo.EXPECT().GetDeckByID(gomock.Any(), "deck-id").Return(deck, nil)
+				mockRepo.EXPECT().GetCardsByNoteID(gomock.Any(), "note-id").Return([]models.Card{cloze}, nil)
+				mockRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, callback func(mongo.SessionContext) (interface{}, error), _ ...*options.TransactionOptions) error {
+						_, err := callback(mongo.NewSessionContext(ctx, nil))
+						return err
+					})
+				mockRepo.EXPECT().UpdateCard(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, card models.Card) error {
+					assert.Equal(t, []string{"biology"}, card.Tags)
+					return nil
+				})
+				mockRepo.EXPECT().InsertCards(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, cards []models.Card) error {
+					assert.Equal(t, []string{"biology"}, cards[0].Tags)
+					return nil
+				})
+				mockRepo.EXPECT().DeleteCards(gomock.Any(), []string{}).Return(nil)
+			},
+		},
+		"should clear the tags of a card edited without any": {
+			haveUsername: "owner",
+			haveEdit:     models.Card{ID: "card-1", Front: "front", Back: "back"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				tagged := basic
+				tagged.Tags = []string{"aws"}
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{tagged}, nil)
+				mockRepo.EXPECT().GetDeckByID(gomock.Any(), "deck-id").Return(deck, nil)
+				mockRepo.EXPECT().UpdateCard(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, card models.Card) error {
+					assert.Empty(t, card.Tags)
+					return nil
+				})
+			},
+		},
+		"should return ErrNotDeckOwner when the user does not own the card's deck": {
+			haveUsername: "someone",
+			haveEdit:     models.Card{ID: "card-1", Front: "new front", Back: "new back"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{basic}, nil)
+				mockRepo.EXPECT().GetDeckByID(gomock.Any(), "deck-id").Return(deck, nil)
+			},
+			wantErr: ErrNotDeckOwner,
+		},
+		"should return ErrEmptyCardBack when the back of a basic card is removed": {
+			haveUsername: "owner",
+			haveEdit:     models.Card{ID: "card-1", Front: "new front"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{basic}, nil)
+				mockRepo.EXPECT().GetDeckByID(gomock.Any(), "deck-id").Return(deck, nil)
+			},
+			wantErr: ErrEmptyCardBack,
+		},
+		"should return ErrEmptyCardFront when the front is removed": {
+			haveUsername: "owner",
+			haveEdit:     models.Card{ID: "card-1", Front: " ", Back: "new back"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{basic}, nil)
+				mockRepo.EXPECT().GetDeckByID(gomock.Any(), "deck-id").Return(deck, nil)
+			},
+			wantErr: ErrEmptyCardFront,
+		},
+		"should return ErrNoResults when the card does not exist": {
+			haveUsername: "owner",
+			haveEdit:     models.Card{ID: "card-1", Front: "new front", Back: "new back"},
+			mockRepositoryResponse: func(mockRepo *database.MockRepository) {
+				mockRepo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return(nil, dbErrors.ErrNoResults)
+			},
+			wantErr: dbErrors.ErrNoResults,
+		},
+		"should return ErrEmptyCardID without a card ID": {
+			haveUsername: "owner",
+			haveEdit:     models.Card{Front: "new front", Back: "new back"},
+			wantErr:      ErrEmptyCardID,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := database.NewMockRepository(ctrl)
+			if tc.mockRepositoryResponse != nil {
+				tc.mockRepositoryResponse(mockRepo)
+			}
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+			gotErr := logic.EditCard(ctx, tc.haveUsername, tc.haveEdit)
+
+			assert.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
+
 func TestLogic_UpvoteDeck(t *testing.T) {
 	ctx := context.Background()
 	deckID := "your_deck_id"
@@ -689,13 +1105,23 @@ func TestLogic_GetHomePageDataForUser(t *testing.T) {
 		wantErr          error
 	}{
 		"should return groups when database returns result": {
-			haveUser:         username,
-			haveFrom:         time.Time{},
-			haveTo:           &timeNow,
-			wantHomePageData: models.HomePageData{Groups: haveGroups, Decks: haveDecks},
+			haveUser: username,
+			haveFrom: time.Time{},
+			haveTo:   &timeNow,
+			wantHomePageData: models.HomePageData{
+				Groups:    haveGroups,
+				Decks:     haveDecks,
+				DueByDeck: map[string]int{deckOneID: 2, haveDecks[0].ID: 1},
+				NumDue:    3,
+			},
 			mockRepo: func(mock *database.MockRepository) {
-				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(haveGroups, nil)
-				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(haveDecks, nil)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(haveGroups, nil).Times(2)
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(haveDecks, nil).Times(2)
+				mock.EXPECT().GetDueCards(gomock.Any(), []string{haveDecks[0].ID, deckOneID, deckTwoID}, username, gomock.Any()).Return([]models.DueCard{
+					{CardID: uuid.NewString(), DeckID: deckOneID},
+					{CardID: uuid.NewString(), DeckID: haveDecks[0].ID},
+					{CardID: uuid.NewString(), DeckID: deckOneID},
+				}, nil)
 			},
 		}, // Need to figure out the concurrency
 		//"should return error when database returns error": {
@@ -720,10 +1146,10 @@ func TestLogic_GetHomePageDataForUser(t *testing.T) {
 			haveFrom: timeNow,
 			haveTo:   nil,
 			mockRepo: func(mock *database.MockRepository) {
-				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.HomePageGroup(nil), dbErrors.ErrNoResults)
-				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.GetDeckResults(nil), nil)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.HomePageGroup(nil), dbErrors.ErrNoResults).Times(2)
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.GetDeckResults(nil), nil).Times(2)
 			},
-			wantHomePageData: models.HomePageData{Groups: []models.HomePageGroup(nil), Decks: []models.GetDeckResults(nil)},
+			wantHomePageData: models.HomePageData{Groups: []models.HomePageGroup(nil), Decks: []models.GetDeckResults(nil), DueByDeck: map[string]int{}},
 			wantErr:          nil,
 		},
 		"should return ErrEmptyUsername when username is empty": {
@@ -890,3 +1316,711 @@ func TestLogic_GetCardsByDeckID(t *testing.T) {
 		})
 	}
 }
+
+func TestLogic_UpdateDeckScheduler(t *testing.T) {
+	var (
+		haveErr    = errors.New("db error")
+		haveDeckID = uuid.NewString()
+		haveUser   = uuid.NewString()
+	)
+
+	testCases := map[string]struct {
+		mockStore    func(mock *database.MockRepository)
+		haveDeckID   string
+		haveUsername string
+		haveSettings models.SchedulerSettings
+		wantErr      error
+	}{
+		"should default FSRS target retention when not provided": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckByID(gomock.Any(), haveDeckID).Return(models.Deck{ID: haveDeckID, CreatedBy: haveUser}, nil)
+				mock.EXPECT().UpdateDeckSchedulerSettings(gomock.Any(), haveDeckID, models.SchedulerSettings{
+					Algorithm:       models.FSRSScheduler,
+					TargetRetention: models.DefaultTargetRetention,
+					LeechThreshold:  models.DefaultLeechThreshold,
+				}).Return(nil)
+			},
+			haveDeckID:   haveDeckID,
+			haveUsername: haveUser,
+			haveSettings: models.SchedulerSettings{Algorithm: models.FSRSScheduler},
+		},
+		"should clear target retention when switching to SM-2": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckByID(gomock.Any(), haveDeckID).Return(models.Deck{ID: haveDeckID, CreatedBy: haveUser}, nil)
+				mock.EXPECT().UpdateDeckSchedulerSettings(gomock.Any(), haveDeckID, models.SchedulerSettings{
+					Algorithm:      models.SM2Scheduler,
+					LeechThreshold: models.DefaultLeechThreshold,
+				}).Return(nil)
+			},
+			haveDeckID:   haveDeckID,
+			haveUsername: haveUser,
+			haveSettings: models.SchedulerSettings{Algorithm: models.SM2Scheduler, TargetRetention: 0.85},
+		},
+		"should keep the leech settings given": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckByID(gomock.Any(), haveDeckID).Return(models.Deck{ID: haveDeckID, CreatedBy: haveUser}, nil)
+				mock.EXPECT().UpdateDeckSchedulerSettings(gomock.Any(), haveDeckID, models.SchedulerSettings{
+					Algorithm:      models.SM2Scheduler,
+					LeechThreshold: 5,
+					SuspendLeeches: true,
+				}).Return(nil)
+			},
+			haveDeckID:   haveDeckID,
+			haveUsername: haveUser,
+			haveSettings: models.SchedulerSettings{Algorithm: models.SM2Scheduler, LeechThreshold: 5, SuspendLeeches: true},
+		},
+		"should return ErrEmptyDeckID when deckID is empty string": {
+			haveSettings: models.SchedulerSettings{Algorithm: models.SM2Scheduler},
+			wantErr:      ErrEmptyDeckID,
+		},
+		"should return ErrInvalidLeech when leech threshold is out of range": {
+			haveDeckID:   haveDeckID,
+			haveSettings: models.SchedulerSettings{Algorithm: models.SM2Scheduler, LeechThreshold: 1},
+			wantErr:      ErrInvalidLeech,
+		},
+		"should return ErrInvalidScheduler for unknown algorithm": {
+			haveDeckID:   haveDeckID,
+			haveSettings: models.SchedulerSettings{Algorithm: "leitner"},
+			wantErr:      ErrInvalidScheduler,
+		},
+		"should return ErrInvalidRetention when retention is out of range": {
+			haveDeckID:   haveDeckID,
+			haveSettings: models.SchedulerSettings{Algorithm: models.FSRSScheduler, TargetRetention: 0.5},
+			wantErr:      ErrInvalidRetention,
+		},
+		"should return ErrNotDeckOwner when user did not create deck": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckByID(gomock.Any(), haveDeckID).Return(models.Deck{ID: haveDeckID, CreatedBy: uuid.NewString()}, nil)
+			},
+			haveDeckID:   haveDeckID,
+			haveUsername: haveUser,
+			haveSettings: models.SchedulerSettings{Algorithm: models.SM2Scheduler},
+			wantErr:      ErrNotDeckOwner,
+		},
+		"should return err when database layer returns err": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckByID(gomock.Any(), haveDeckID).Return(models.Deck{ID: haveDeckID, CreatedBy: haveUser}, nil)
+				mock.EXPECT().UpdateDeckSchedulerSettings(gomock.Any(), gomock.Any(), gomock.Any()).Return(haveErr)
+			},
+			haveDeckID:   haveDeckID,
+			haveUsername: haveUser,
+			haveSettings: models.SchedulerSettings{Algorithm: models.FSRSScheduler, TargetRetention: 0.95},
+			wantErr:      haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := database.NewMockRepository(ctrl)
+
+			if tc.mockStore != nil {
+				tc.mockStore(mockDB)
+			}
+
+			logic := Logic{repo: mockDB, logger: zerolog.Nop()}
+
+			err := logic.UpdateDeckScheduler(context.Background(), tc.haveDeckID, tc.haveUsername, tc.haveSettings)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestLogic_GetDueCardsForUser(t *testing.T) {
+	var (
+		haveErr   = errors.New("db error")
+		username  = uuid.NewString()
+		ownDeckID = uuid.NewString()
+		groupDeck = uuid.NewString()
+		haveDue   = []models.DueCard{{CardID: uuid.NewString(), DeckID: ownDeckID}}
+	)
+
+	testCases := map[string]struct {
+		haveUser string
+		mockRepo func(mock *database.MockRepository)
+		wantDue  []models.DueCard
+		wantErr  error
+	}{
+		"should get due cards once per deck the user can reach": {
+			haveUser: username,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDecksForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return([]models.GetDeckResults{{ID: ownDeckID}}, nil)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return([]models.HomePageGroup{
+					{DeckIDs: []string{ownDeckID, groupDeck}},
+					{DeckIDs: []string{groupDeck}},
+				}, nil)
+				mock.EXPECT().GetDueCards(gomock.Any(), []string{ownDeckID, groupDeck}, username, gomock.Any()).Return(haveDue, nil)
+			},
+			wantDue: haveDue,
+		},
+		"should return nothing when user has no decks": {
+			haveUser: username,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+			},
+		},
+		"should return nothing when no cards are due": {
+			haveUser: username,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.GetDeckResults{{ID: ownDeckID}}, nil)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+				mock.EXPECT().GetDueCards(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+			},
+		},
+		"should return err when database layer returns err": {
+			haveUser: username,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, haveErr)
+			},
+			wantErr: haveErr,
+		},
+		"should return ErrEmptyUsername when username is empty": {
+			wantErr: ErrEmptyUsername,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := database.NewMockRepository(ctrl)
+
+			if tc.mockRepo != nil {
+				tc.mockRepo(mockDB)
+			}
+
+			logic := Logic{repo: mockDB, logger: zerolog.Nop()}
+
+			got, err := logic.GetDueCardsForUser(context.Background(), tc.haveUser, time.Now())
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantDue, got)
+		})
+	}
+}
+
+func TestLogic_GetTaggedCardsForUser(t *testing.T) {
+	var (
+		haveErr   = errors.New("db error")
+		username  = uuid.NewString()
+		ownDeckID = uuid.NewString()
+		groupDeck = uuid.NewString()
+		haveCards = []models.Card{{ID: uuid.NewString(), DeckID: groupDeck, Tags: []string{"networking"}}}
+	)
+
+	testCases := map[string]struct {
+		haveUser  string
+		haveTags  []string
+		mockRepo  func(mock *database.MockRepository)
+		wantCards []models.Card
+		wantErr   error
+	}{
+		"should get tagged cards from every deck the user can reach": {
+			haveUser: username,
+			haveTags: []string{"#Networking"},
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDecksForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return([]models.GetDeckResults{{ID: ownDeckID}}, nil)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return([]models.HomePageGroup{{DeckIDs: []string{groupDeck}}}, nil)
+				mock.EXPECT().GetCardsByTags(gomock.Any(), []string{ownDeckID, groupDeck}, []string{"networking"}).Return(haveCards, nil)
+			},
+			wantCards: haveCards,
+		},
+		"should return nothing when no card has the tags": {
+			haveUser: username,
+			haveTags: []string{"networking"},
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.GetDeckResults{{ID: ownDeckID}}, nil)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+				mock.EXPECT().GetCardsByTags(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+			},
+		},
+		"should return err when database layer returns err": {
+			haveUser: username,
+			haveTags: []string{"networking"},
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, haveErr)
+			},
+			wantErr: haveErr,
+		},
+		"should return ErrEmptyTags when no tags are given": {
+			haveUser: username,
+			wantErr:  ErrEmptyTags,
+		},
+		"should return ErrEmptyUsername when username is empty": {
+			haveTags: []string{"networking"},
+			wantErr:  ErrEmptyUsername,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := database.NewMockRepository(ctrl)
+
+			if tc.mockRepo != nil {
+				tc.mockRepo(mockDB)
+			}
+
+			logic := Logic{repo: mockDB, logger: zerolog.Nop()}
+
+			got, err := logic.GetTaggedCardsForUser(context.Background(), tc.haveUser, tc.haveTags)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCards, got)
+		})
+	}
+}
+
+func TestLogic_GetSuspendedCardsForUser(t *testing.T) {
+	var (
+		haveErr   = errors.New("db error")
+		username  = uuid.NewString()
+		haveHolds = []models.CardHold{{CardID: "card-1", Suspended: true, Leech: true}, {CardID: "card-2", Suspended: true}}
+	)
+
+	testCases := map[string]struct {
+		haveUser  string
+		mockRepo  func(mock *database.MockRepository)
+		wantCards []models.HeldCard
+		wantErr   error
+	}{
+		"should get the cards the user suspended in the order they were suspended": {
+			haveUser: username,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetSuspendedCards(gomock.Any(), username).Return(haveHolds, nil)
+				mock.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1", "card-2"}).Return([]models.Card{{ID: "card-2"}, {ID: "card-1"}}, nil)
+			},
+			wantCards: []models.HeldCard{
+				{Card: models.Card{ID: "card-1"}, Hold: haveHolds[0]},
+				{Card: models.Card{ID: "card-2"}, Hold: haveHolds[1]},
+			},
+		},
+		"should leave out holds of deleted cards": {
+			haveUser: username,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetSuspendedCards(gomock.Any(), username).Return(haveHolds, nil)
+				mock.EXPECT().GetCardsByIDs(gomock.Any(), gomock.Any()).Return([]models.Card{{ID: "card-2"}}, nil)
+			},
+			wantCards: []models.HeldCard{
+				{Card: models.Card{ID: "card-2"}, Hold: haveHolds[1]},
+			},
+		},
+		"should return nothing when the user suspended no cards": {
+			haveUser: username,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetSuspendedCards(gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+			},
+		},
+		"should return err when database layer returns err": {
+			haveUser: username,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetSuspendedCards(gomock.Any(), gomock.Any()).Return(nil, haveErr)
+			},
+			wantErr: haveErr,
+		},
+		"should return ErrEmptyUsername when username is empty": {
+			wantErr: ErrEmptyUsername,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := database.NewMockRepository(ctrl)
+
+			if tc.mockRepo != nil {
+				tc.mockRepo(mockDB)
+			}
+
+			logic := Logic{repo: mockDB, logger: zerolog.Nop()}
+
+			got, err := logic.GetSuspendedCardsForUser(context.Background(), tc.haveUser)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCards, got)
+		})
+	}
+}
+
+func TestLogic_GetDeckStats(t *testing.T) {
+	var (
+		haveErr    = errors.New("db error")
+		haveUser   = uuid.NewString()
+		haveDeckID = uuid.NewString()
+		haveDeck   = models.DeckWithCards{
+			GetDeckResults: models.GetDeckResults{ID: haveDeckID, Name: "Deck", CreatedBy: haveUser},
+			Cards:          []models.Card{{ID: "card-1"}, {ID: "card-2"}},
+		}
+		haveStats = []models.CardAnswerStats{
+			{CardID: "card-2", Answered: 4, Correct: 3, AnswersTimed: 4, TotalAnswerLatency: 8 * time.Second},
+		}
+	)
+
+	testCases := map[string]struct {
+		mockStore func(mock *database.MockRepository)
+		haveUser  string
+		wantStats models.DeckStats
+		wantErr   error
+	}{
+		"should return stats for every card of the deck": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckWithCardsByID(gomock.Any(), haveDeckID).Return(haveDeck, nil)
+				mock.EXPECT().GetCardAnswerStatsForAllUsers(gomock.Any(), []string{"card-1", "card-2"}).Return(haveStats, nil)
+				mock.EXPECT().GetLeechCounts(gomock.Any(), haveDeckID).Return(nil, nil)
+			},
+			haveUser: haveUser,
+			wantStats: models.DeckStats{
+				DeckID:   haveDeckID,
+				DeckName: "Deck",
+				Cards: []models.CardStats{
+					{Card: models.Card{ID: "card-1"}},
+					{Card: models.Card{ID: "card-2"}, Stats: haveStats[0]},
+				},
+			},
+		},
+		"should list the leeches of cards still in the deck": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckWithCardsByID(gomock.Any(), haveDeckID).Return(haveDeck, nil)
+				mock.EXPECT().GetCardAnswerStatsForAllUsers(gomock.Any(), gomock.Any()).Return(haveStats, nil)
+				mock.EXPECT().GetLeechCounts(gomock.Any(), haveDeckID).Return([]models.LeechCount{
+					{CardID: "card-2", Learners: 3, Failures: 30},
+					{CardID: "deleted", Learners: 2, Failures: 20},
+				}, nil)
+			},
+			haveUser: haveUser,
+			wantStats: models.DeckStats{
+				DeckID:   haveDeckID,
+				DeckName: "Deck",
+				Cards: []models.CardStats{
+					{Card: models.Card{ID: "card-1"}},
+					{Card: models.Card{ID: "card-2"}, Stats: haveStats[0]},
+				},
+				Leeches: []models.CardLeech{
+					{Card: models.Card{ID: "card-2"}, LeechCount: models.LeechCount{CardID: "card-2", Learners: 3, Failures: 30}},
+				},
+			},
+		},
+		"should return ErrNotDeckOwner when user did not create deck": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckWithCardsByID(gomock.Any(), haveDeckID).Return(haveDeck, nil)
+			},
+			haveUser: uuid.NewString(),
+			wantErr:  ErrNotDeckOwner,
+		},
+		"should return err when database layer returns err": {
+			mockStore: func(mock *database.MockRepository) {
+				mock.EXPECT().GetDeckWithCardsByID(gomock.Any(), haveDeckID).Return(haveDeck, nil)
+				mock.EXPECT().GetCardAnswerStatsForAllUsers(gomock.Any(), gomock.Any()).Return(nil, haveErr)
+			},
+			haveUser: haveUser,
+			wantErr:  haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := database.NewMockRepository(ctrl)
+			tc.mockStore(mockDB)
+
+			logic := Logic{repo: mockDB, logger: zerolog.Nop()}
+
+			got, err := logic.GetDeckStats(context.Background(), haveDeckID, tc.haveUser)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStats, got)
+		})
+	}
+}
+
+func TestLogic_GetCardHistory(t *testing.T) {
+	var (
+		haveErr     = errors.New("db error")
+		username    = uuid.NewString()
+		haveDeckID  = uuid.NewString()
+		haveCard    = models.Card{ID: "card-1", DeckID: haveDeckID, Front: "front"}
+		haveVotes   = models.BackOfCard{CardID: "card-1", Upvotes: 2, IsUpvotedByUser: true}
+		haveAnswers = []models.CardAnswerRecord{{SessionID: "session-1", Answer: models.CardAnswer{CardID: "card-1", IsCorrect: true}}}
+	)
+
+	testCases := map[string]struct {
+		haveUser    string
+		haveCardID  string
+		mockRepo    func(mock *database.MockRepository)
+		wantHistory models.CardHistory
+		wantErr     error
+	}{
+		"should get the user's answers to the card with its votes": {
+			haveUser:   username,
+			haveCardID: "card-1",
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{haveCard}, nil)
+				mock.EXPECT().GetDecksForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return([]models.GetDeckResults{{ID: haveDeckID}}, nil)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return(nil, dbErrors.ErrNoResults)
+				mock.EXPECT().GetBackOfCardByID(gomock.Any(), haveDeckID, "card-1", username, false, nil).Return(haveVotes, nil)
+				mock.EXPECT().GetCardAnswersForUser(gomock.Any(), username, "card-1").Return(haveAnswers, nil)
+			},
+			wantHistory: models.CardHistory{Card: haveCard, Votes: haveVotes, Answers: haveAnswers},
+		},
+		"should return no answers when the user never answered the card": {
+			haveUser:   username,
+			haveCardID: "card-1",
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetCardsByIDs(gomock.Any(), gomock.Any()).Return([]models.Card{haveCard}, nil)
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.GetDeckResults{{ID: haveDeckID}}, nil)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+				mock.EXPECT().GetBackOfCardByID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(haveVotes, nil)
+				mock.EXPECT().GetCardAnswersForUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+			},
+			wantHistory: models.CardHistory{Card: haveCard, Votes: haveVotes},
+		},
+		"should return ErrNoResults for a card of a deck the user cannot reach": {
+			haveUser:   username,
+			haveCardID: "card-1",
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetCardsByIDs(gomock.Any(), gomock.Any()).Return([]models.Card{haveCard}, nil)
+				mock.EXPECT().GetDecksForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+				mock.EXPECT().GetGroupsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErrors.ErrNoResults)
+			},
+			wantErr: dbErrors.ErrNoResults,
+		},
+		"should return err when database layer returns err": {
+			haveUser:   username,
+			haveCardID: "card-1",
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetCardsByIDs(gomock.Any(), gomock.Any()).Return(nil, haveErr)
+			},
+			wantErr: haveErr,
+		},
+		"should return ErrEmptyCardID when cardID is empty": {
+			haveUser: username,
+			wantErr:  ErrEmptyCardID,
+		},
+		"should return ErrEmptyUsername when username is empty": {
+			haveCardID: "card-1",
+			wantErr:    ErrEmptyUsername,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := database.NewMockRepository(ctrl)
+
+			if tc.mockRepo != nil {
+				tc.mockRepo(mockDB)
+			}
+
+			logic := Logic{repo: mockDB, logger: zerolog.Nop()}
+
+			got, err := logic.GetCardHistory(context.Background(), tc.haveCardID, tc.haveUser)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantHistory, got)
+		})
+	}
+}
+
+func TestLogic_GetGroupLeaderboard(t *testing.T) {
+	var (
+		haveErr     = errors.New("db error")
+		haveGroupID = uuid.NewString()
+		now         = time.Date(2024, time.March, 3, 12, 0, 0, 0, time.UTC)
+		weekStart   = time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)
+		ada         = models.LeaderboardEntry{Username: "ada", Reviews: 40, Correct: 20, Streak: 1}
+		grace       = models.LeaderboardEntry{Username: "grace", Reviews: 10, Correct: 9, Streak: 3}
+		linus       = models.LeaderboardEntry{Username: "linus"}
+		group       = models.GroupWithDecks{Group: models.Group{ID: haveGroupID, Members: []string{"ada", "grace", "linus"}}}
+		cached      = models.Leaderboard{
+			GroupID:    haveGroupID,
+			Window:     models.WeeklyLeaderboard,
+			ComputedAt: now.Add(-time.Minute),
+			Entries:    []models.LeaderboardEntry{linus, grace, ada},
+		}
+	)
+
+	testCases := map[string]struct {
+		haveGroupID     string
+		haveWindow      models.LeaderboardWindow
+		haveMetric      models.LeaderboardMetric
+		mockRepo        func(mock *database.MockRepository)
+		wantLeaderboard models.Leaderboard
+		wantErr         error
+	}{
+		"should rank a fresh rollup without counting sessions": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.WeeklyLeaderboard,
+			haveMetric:  models.RankByReviews,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetGroupByID(gomock.Any(), haveGroupID).Return(group, nil)
+				mock.EXPECT().GetLeaderboard(gomock.Any(), haveGroupID, models.WeeklyLeaderboard).Return(cached, nil)
+			},
+			wantLeaderboard: models.Leaderboard{
+				GroupID:    haveGroupID,
+				Window:     models.WeeklyLeaderboard,
+				ComputedAt: cached.ComputedAt,
+				Entries:    []models.LeaderboardEntry{ada, grace, linus},
+			},
+		},
+		"should rank by accuracy": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.WeeklyLeaderboard,
+			haveMetric:  models.RankByAccuracy,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetGroupByID(gomock.Any(), haveGroupID).Return(group, nil)
+				mock.EXPECT().GetLeaderboard(gomock.Any(), haveGroupID, models.WeeklyLeaderboard).Return(cached, nil)
+			},
+			wantLeaderboard: models.Leaderboard{
+				GroupID:    haveGroupID,
+				Window:     models.WeeklyLeaderboard,
+				ComputedAt: cached.ComputedAt,
+				Entries:    []models.LeaderboardEntry{grace, ada, linus},
+			},
+		},
+		"should roll up and save the leaderboard when there is none": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.WeeklyLeaderboard,
+			haveMetric:  models.RankByStreak,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetGroupByID(gomock.Any(), haveGroupID).Return(group, nil)
+				mock.EXPECT().GetLeaderboard(gomock.Any(), haveGroupID, models.WeeklyLeaderboard).Return(models.Leaderboard{}, dbErrors.ErrNoResults)
+				mock.EXPECT().GetLeaderboardEntries(gomock.Any(), haveGroupID, weekStart).Return([]models.LeaderboardEntry{
+					{Username: "ada", Reviews: 40, Correct: 20, StudyDays: []string{"2024-02-28", "2024-03-03"}},
+					{Username: "grace", Reviews: 10, Correct: 9, StudyDays: []string{"2024-03-02", "2024-02-29", "2024-03-01"}},
+					{Username: "linus"},
+				}, nil)
+				mock.EXPECT().SaveLeaderboard(gomock.Any(), models.Leaderboard{
+					GroupID:    haveGroupID,
+					Window:     models.WeeklyLeaderboard,
+					ComputedAt: now,
+					Entries:    []models.LeaderboardEntry{ada, grace, linus},
+				}).Return(nil)
+			},
+			wantLeaderboard: models.Leaderboard{
+				GroupID:    haveGroupID,
+				Window:     models.WeeklyLeaderboard,
+				ComputedAt: now,
+				Entries:    []models.LeaderboardEntry{grace, ada, linus},
+			},
+		},
+		"should count streaks up to each member's today in their time zone": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.WeeklyLeaderboard,
+			haveMetric:  models.RankByStreak,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetGroupByID(gomock.Any(), haveGroupID).Return(group, nil)
+				mock.EXPECT().GetLeaderboard(gomock.Any(), haveGroupID, models.WeeklyLeaderboard).Return(models.Leaderboard{}, dbErrors.ErrNoResults)
+				// It is already the 4th in Auckland, so a member there who last studied on the 2nd has lost their streak.
+				mock.EXPECT().GetLeaderboardEntries(gomock.Any(), haveGroupID, weekStart).Return([]models.LeaderboardEntry{
+					{Username: "kiri", TimeZone: "Pacific/Auckland", Reviews: 5, StudyDays: []string{"2024-03-01", "2024-03-02"}},
+					{Username: "ada", Reviews: 5, StudyDays: []string{"2024-03-01", "2024-03-02"}},
+				}, nil)
+				mock.EXPECT().SaveLeaderboard(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantLeaderboard: models.Leaderboard{
+				GroupID:    haveGroupID,
+				Window:     models.WeeklyLeaderboard,
+				ComputedAt: now,
+				Entries: []models.LeaderboardEntry{
+					{Username: "ada", Reviews: 5, Streak: 2},
+					{Username: "kiri", TimeZone: "Pacific/Auckland", Reviews: 5},
+				},
+			},
+		},
+		"should roll up a stale leaderboard and still serve it when it cannot be saved": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.AllTimeLeaderboard,
+			haveMetric:  models.RankByReviews,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetGroupByID(gomock.Any(), haveGroupID).Return(group, nil)
+				mock.EXPECT().GetLeaderboard(gomock.Any(), haveGroupID, models.AllTimeLeaderboard).Return(models.Leaderboard{ComputedAt: now.Add(-time.Hour)}, nil)
+				mock.EXPECT().GetLeaderboardEntries(gomock.Any(), haveGroupID, time.Time{}).Return([]models.LeaderboardEntry{{Username: "ada", Reviews: 3}}, nil)
+				mock.EXPECT().SaveLeaderboard(gomock.Any(), gomock.Any()).Return(haveErr)
+			},
+			wantLeaderboard: models.Leaderboard{
+				GroupID:    haveGroupID,
+				Window:     models.AllTimeLeaderboard,
+				ComputedAt: now,
+				Entries:    []models.LeaderboardEntry{{Username: "ada", Reviews: 3}},
+			},
+		},
+		"should return err when the rollup cannot be read": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.MonthlyLeaderboard,
+			haveMetric:  models.RankByReviews,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetGroupByID(gomock.Any(), haveGroupID).Return(group, nil)
+				mock.EXPECT().GetLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Leaderboard{}, haveErr)
+			},
+			wantErr: haveErr,
+		},
+		"should return ErrNoResults when the group does not exist": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.MonthlyLeaderboard,
+			haveMetric:  models.RankByReviews,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetGroupByID(gomock.Any(), haveGroupID).Return(models.GroupWithDecks{}, dbErrors.ErrNoResults)
+			},
+			wantErr: dbErrors.ErrNoResults,
+		},
+		"should return ErrNoResults when the user is not a member of the group": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.WeeklyLeaderboard,
+			haveMetric:  models.RankByReviews,
+			mockRepo: func(mock *database.MockRepository) {
+				mock.EXPECT().GetGroupByID(gomock.Any(), haveGroupID).Return(models.GroupWithDecks{Group: models.Group{ID: haveGroupID, Members: []string{"grace"}}}, nil)
+			},
+			wantErr: dbErrors.ErrNoResults,
+		},
+		"should return ErrInvalidWindow when the window is unknown": {
+			haveGroupID: haveGroupID,
+			haveWindow:  "year",
+			haveMetric:  models.RankByReviews,
+			wantErr:     ErrInvalidWindow,
+		},
+		"should return ErrInvalidMetric when the metric is unknown": {
+			haveGroupID: haveGroupID,
+			haveWindow:  models.WeeklyLeaderboard,
+			haveMetric:  "votes",
+			wantErr:     ErrInvalidMetric,
+		},
+		"should return ErrEmptyGroupID when groupID is empty": {
+			haveWindow: models.WeeklyLeaderboard,
+			haveMetric: models.RankByReviews,
+			wantErr:    ErrEmptyGroupID,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := database.NewMockRepository(ctrl)
+
+			if tc.mockRepo != nil {
+				tc.mockRepo(mockDB)
+			}
+
+			logic := Logic{repo: mockDB, logger: zerolog.Nop()}
+
+			got, err := logic.GetGroupLeaderboard(context.Background(), tc.haveGroupID, "ada", tc.haveWindow, tc.haveMetric, now)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantLeaderboard, got)
+		})
+	}
+}
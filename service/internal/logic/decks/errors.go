@@ -10,4 +10,19 @@ var (
 	ErrEmptyDeckName       = errors.New("empty deck name")
 	ErrEmptyDeckID         = errors.New("empty deck ID")
 	ErrEmptyUsername       = errors.New("empty username")
+	ErrEmptyCardID         = errors.New("empty card ID")
+	ErrNotDeckOwner        = errors.New("user does not own deck")
+	ErrEmptyCardFront      = errors.New("the front of a card cannot be empty")
+	ErrEmptyCardBack       = errors.New("the back of a card cannot be empty")
+	ErrInvalidScheduler    = errors.New("invalid scheduler")
+	ErrInvalidRetention    = errors.New("target retention must be between 0.7 and 0.99")
+	ErrInvalidLeech        = errors.New("leech threshold must be between 2 and 99")
+	ErrTooFewOptions       = errors.New("multiple choice cards need at least two options")
+	ErrNoCorrectOption     = errors.New("multiple choice cards need a correct option")
+	ErrNoClozeDeletions    = errors.New("cloze notes need at least one deletion such as {{c1::answer}}")
+	ErrNoOcclusionImage    = errors.New("image occlusion cards need an uploaded image")
+	ErrNoOcclusionMasks    = errors.New("image occlusion cards need at least one labelled mask over the image")
+	ErrEmptyTags           = errors.New("at least one tag is needed")
+	ErrInvalidWindow       = errors.New("leaderboard window must be week, month or all")
+	ErrInvalidMetric       = errors.New("leaderboard metric must be reviews, accuracy or streak")
 )
@@ -113,19 +113,63 @@ func (mr *MockControllerMockRecorder) DownvoteDeck(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownvoteDeck", reflect.TypeOf((*MockController)(nil).DownvoteDeck), arg0, arg1, arg2)
 }
 
+// EditCard mocks base method.
+func (m *MockController) EditCard(arg0 context.Context, arg1 string, arg2 models.Card) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditCard", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditCard indicates an expected call of EditCard.
+func (mr *MockControllerMockRecorder) EditCard(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditCard", reflect.TypeOf((*MockController)(nil).EditCard), arg0, arg1, arg2)
+}
+
 // GetBackOfCardByID mocks base method.
-func (m *MockController) GetBackOfCardByID(arg0 context.Context, arg1, arg2, arg3 string) (models.BackOfCard, error) {
+func (m *MockController) GetBackOfCardByID(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool, arg5 []string) (models.BackOfCard, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBackOfCardByID", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetBackOfCardByID", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(models.BackOfCard)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBackOfCardByID indicates an expected call of GetBackOfCardByID.
-func (mr *MockControllerMockRecorder) GetBackOfCardByID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockControllerMockRecorder) GetBackOfCardByID(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackOfCardByID", reflect.TypeOf((*MockController)(nil).GetBackOfCardByID), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetCardHistory mocks base method.
+func (m *MockController) GetCardHistory(arg0 context.Context, arg1, arg2 string) (models.CardHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.CardHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardHistory indicates an expected call of GetCardHistory.
+func (mr *MockControllerMockRecorder) GetCardHistory(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardHistory", reflect.TypeOf((*MockController)(nil).GetCardHistory), arg0, arg1, arg2)
+}
+
+// GetCardToEdit mocks base method.
+func (m *MockController) GetCardToEdit(arg0 context.Context, arg1, arg2 string) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardToEdit", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardToEdit indicates an expected call of GetCardToEdit.
+func (mr *MockControllerMockRecorder) GetCardToEdit(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackOfCardByID", reflect.TypeOf((*MockController)(nil).GetBackOfCardByID), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardToEdit", reflect.TypeOf((*MockController)(nil).GetCardToEdit), arg0, arg1, arg2)
 }
 
 // GetCardsByDeckID mocks base method.
@@ -143,6 +187,36 @@ func (mr *MockControllerMockRecorder) GetCardsByDeckID(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardsByDeckID", reflect.TypeOf((*MockController)(nil).GetCardsByDeckID), arg0, arg1)
 }
 
+// GetDeckByID mocks base method.
+func (m *MockController) GetDeckByID(arg0 context.Context, arg1 string) (models.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeckByID", arg0, arg1)
+	ret0, _ := ret[0].(models.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeckByID indicates an expected call of GetDeckByID.
+func (mr *MockControllerMockRecorder) GetDeckByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeckByID", reflect.TypeOf((*MockController)(nil).GetDeckByID), arg0, arg1)
+}
+
+// GetDeckStats mocks base method.
+func (m *MockController) GetDeckStats(arg0 context.Context, arg1, arg2 string) (models.DeckStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeckStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.DeckStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeckStats indicates an expected call of GetDeckStats.
+func (mr *MockControllerMockRecorder) GetDeckStats(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeckStats", reflect.TypeOf((*MockController)(nil).GetDeckStats), arg0, arg1, arg2)
+}
+
 // GetDecks mocks base method.
 func (m *MockController) GetDecks(arg0 context.Context, arg1 time.Time, arg2 *time.Time, arg3, arg4 int) ([]models.DeckWithCards, error) {
 	m.ctrl.T.Helper()
@@ -158,19 +232,34 @@ func (mr *MockControllerMockRecorder) GetDecks(arg0, arg1, arg2, arg3, arg4 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecks", reflect.TypeOf((*MockController)(nil).GetDecks), arg0, arg1, arg2, arg3, arg4)
 }
 
+// GetDueCardsForUser mocks base method.
+func (m *MockController) GetDueCardsForUser(arg0 context.Context, arg1 string, arg2 time.Time) ([]models.DueCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueCardsForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.DueCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueCardsForUser indicates an expected call of GetDueCardsForUser.
+func (mr *MockControllerMockRecorder) GetDueCardsForUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueCardsForUser", reflect.TypeOf((*MockController)(nil).GetDueCardsForUser), arg0, arg1, arg2)
+}
+
 // GetFrontOfCardByID mocks base method.
-func (m *MockController) GetFrontOfCardByID(arg0 context.Context, arg1, arg2, arg3 string) (models.FrontOfCard, error) {
+func (m *MockController) GetFrontOfCardByID(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool, arg5 []string) (models.FrontOfCard, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFrontOfCardByID", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetFrontOfCardByID", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(models.FrontOfCard)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFrontOfCardByID indicates an expected call of GetFrontOfCardByID.
-func (mr *MockControllerMockRecorder) GetFrontOfCardByID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockControllerMockRecorder) GetFrontOfCardByID(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontOfCardByID", reflect.TypeOf((*MockController)(nil).GetFrontOfCardByID), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontOfCardByID", reflect.TypeOf((*MockController)(nil).GetFrontOfCardByID), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // GetGroupByID mocks base method.
@@ -188,6 +277,21 @@ func (mr *MockControllerMockRecorder) GetGroupByID(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupByID", reflect.TypeOf((*MockController)(nil).GetGroupByID), arg0, arg1)
 }
 
+// GetGroupLeaderboard mocks base method.
+func (m *MockController) GetGroupLeaderboard(arg0 context.Context, arg1, arg2 string, arg3 models.LeaderboardWindow, arg4 models.LeaderboardMetric, arg5 time.Time) (models.Leaderboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupLeaderboard", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(models.Leaderboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupLeaderboard indicates an expected call of GetGroupLeaderboard.
+func (mr *MockControllerMockRecorder) GetGroupLeaderboard(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupLeaderboard", reflect.TypeOf((*MockController)(nil).GetGroupLeaderboard), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // GetGroups mocks base method.
 func (m *MockController) GetGroups(arg0 context.Context, arg1 time.Time, arg2 *time.Time, arg3, arg4 int) ([]models.GroupWithDecks, error) {
 	m.ctrl.T.Helper()
@@ -218,6 +322,36 @@ func (mr *MockControllerMockRecorder) GetHomepageData(arg0, arg1, arg2, arg3, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHomepageData", reflect.TypeOf((*MockController)(nil).GetHomepageData), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// GetSuspendedCardsForUser mocks base method.
+func (m *MockController) GetSuspendedCardsForUser(arg0 context.Context, arg1 string) ([]models.HeldCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuspendedCardsForUser", arg0, arg1)
+	ret0, _ := ret[0].([]models.HeldCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuspendedCardsForUser indicates an expected call of GetSuspendedCardsForUser.
+func (mr *MockControllerMockRecorder) GetSuspendedCardsForUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspendedCardsForUser", reflect.TypeOf((*MockController)(nil).GetSuspendedCardsForUser), arg0, arg1)
+}
+
+// GetTaggedCardsForUser mocks base method.
+func (m *MockController) GetTaggedCardsForUser(arg0 context.Context, arg1 string, arg2 []string) ([]models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaggedCardsForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaggedCardsForUser indicates an expected call of GetTaggedCardsForUser.
+func (mr *MockControllerMockRecorder) GetTaggedCardsForUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaggedCardsForUser", reflect.TypeOf((*MockController)(nil).GetTaggedCardsForUser), arg0, arg1, arg2)
+}
+
 // RemoveDownvoteDeck mocks base method.
 func (m *MockController) RemoveDownvoteDeck(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -246,6 +380,20 @@ func (mr *MockControllerMockRecorder) RemoveUpvoteDeck(arg0, arg1, arg2 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUpvoteDeck", reflect.TypeOf((*MockController)(nil).RemoveUpvoteDeck), arg0, arg1, arg2)
 }
 
+// UnsuspendCards mocks base method.
+func (m *MockController) UnsuspendCards(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsuspendCards", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsuspendCards indicates an expected call of UnsuspendCards.
+func (mr *MockControllerMockRecorder) UnsuspendCards(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsuspendCards", reflect.TypeOf((*MockController)(nil).UnsuspendCards), arg0, arg1, arg2)
+}
+
 // UpdateCard mocks base method.
 func (m *MockController) UpdateCard(arg0 context.Context, arg1 models.Card) error {
 	m.ctrl.T.Helper()
@@ -260,6 +408,20 @@ func (mr *MockControllerMockRecorder) UpdateCard(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCard", reflect.TypeOf((*MockController)(nil).UpdateCard), arg0, arg1)
 }
 
+// UpdateDeckScheduler mocks base method.
+func (m *MockController) UpdateDeckScheduler(arg0 context.Context, arg1, arg2 string, arg3 models.SchedulerSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeckScheduler", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeckScheduler indicates an expected call of UpdateDeckScheduler.
+func (mr *MockControllerMockRecorder) UpdateDeckScheduler(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeckScheduler", reflect.TypeOf((*MockController)(nil).UpdateDeckScheduler), arg0, arg1, arg2, arg3)
+}
+
 // UpvoteDeck mocks base method.
 func (m *MockController) UpvoteDeck(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
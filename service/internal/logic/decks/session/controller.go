@@ -10,15 +10,28 @@ import (
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/sync/errgroup"
+	"strings"
+	"time"
 )
 
+//go:generate mockgen -destination ./mocks/controller_mock.go -package session . Controller
 type (
 	Controller interface {
-		GetActiveSessionForUserAndDeckID(ctx context.Context, username, deckID string) (models.DeckSession, error)
+		GetActiveSessionForUserAndDeckID(ctx context.Context, username, deckID string, tags []string, order models.CardOrder, direction models.StudyDirection, mode models.AnswerMode) (models.DeckSession, error)
 		UpdateSessionState(ctx context.Context, update models.SessionUpdate) error
 		UpdateCardOrientation(ctx context.Context, sessionID string, isFront bool) error
 		GetSessionByID(ctx context.Context, sessionID string) (models.DeckSession, error)
-		SetCurrentCard(ctx context.Context, sessionID, cardID string, isFront bool) error
+		SetCurrentCard(ctx context.Context, sessionID, cardID string, isReversed, isFront bool) error
+		StartReviewSession(ctx context.Context, username string) (models.DeckSession, error)
+		StartTagSession(ctx context.Context, username string, tags []string) (models.DeckSession, error)
+		GetSessionSummary(ctx context.Context, sessionID, username string) (models.SessionSummary, error)
+		StartRetrySession(ctx context.Context, sessionID, username string) (models.DeckSession, error)
+		ExpireIdleSessions(ctx context.Context, ttl time.Duration) (int64, error)
+		GetSessionHistory(ctx context.Context, username, deckID string, from time.Time, to *time.Time, limit, offset int) ([]models.SessionSummary, error)
+		GetLearningStats(ctx context.Context, username string, now time.Time, days int) (models.LearningStats, error)
+		GetStudyPreferences(ctx context.Context, username string) (models.StudyPreferences, error)
+		UpdateStudyPreferences(ctx context.Context, username string, preferences models.StudyPreferences) error
+		GetStudyProgress(ctx context.Context, username string, now time.Time) (models.StudyProgress, error)
 	}
 	Logic struct {
 		logger         zerolog.Logger
@@ -29,6 +42,9 @@ type (
 
 var _ Controller = &Logic{}
 
+// ReviewSessionName is shown in place of a deck name for cross-deck review sessions.
+const ReviewSessionName = "Due Today"
+
 // NewLogic returns a pointer to a new Logic instance
 func NewLogic(logger zerolog.Logger, deckController decks.Controller, repo database.Repository) *Logic {
 	logger = logger.With().Str("module", "Session Logic").Logger()
@@ -39,11 +55,16 @@ func NewLogic(logger zerolog.Logger, deckController decks.Controller, repo datab
 	}
 }
 
-func (l *Logic) GetActiveSessionForUserAndDeckID(ctx context.Context, username, deckID string) (models.DeckSession, error) {
+// GetActiveSessionForUserAndDeckID returns the user's unfinished session for the deck, starting one when there is
+// none. The order, direction and mode only apply to a new session: cards are studied the way the session was started.
+// When tags are given the session only studies the deck's cards with every one of them, and ErrNoTaggedCards is
+// returned when there are none. Cards the user has suspended or buried are left out.
+func (l *Logic) GetActiveSessionForUserAndDeckID(ctx context.Context, username, deckID string, tags []string, order models.CardOrder, direction models.StudyDirection, mode models.AnswerMode) (models.DeckSession, error) {
 	log := l.logger.With().Str("method", "GetSessionForUserAndDeckID").Logger()
 	log.Info().Msgf("getting deck session for username %s and deckID %s", username, deckID)
+	tags = models.NormalizeTags(tags)
 	var session models.DeckSession
-	session, err := l.repo.GetActiveSessionForUserDeck(ctx, username, deckID)
+	session, err := l.repo.GetActiveSessionForUserDeck(ctx, username, deckID, tags)
 	if err != nil {
 		if errors.Is(err, database.ErrNoResults) {
 			log.Debug().Msgf("no session for username %s and deckID %s", username, deckID)
@@ -53,9 +74,28 @@ func (l *Logic) GetActiveSessionForUserAndDeckID(ctx context.Context, username,
 				return models.DeckSession{}, err
 			}
 
+			cards := models.CardsWithTags(deck.Cards, tags)
+			if len(tags) > 0 && len(cards) == 0 {
+				return models.DeckSession{}, ErrNoTaggedCards
+			}
+			cards, err = l.withoutHeldCards(ctx, username, cards)
+			if err != nil {
+				log.Error().Err(err).Msgf("while getting held cards for user %s", username)
+				return models.DeckSession{}, err
+			}
+
 			currentCardID := ""
-			if len(deck.Cards) > 0 {
-				currentCardID = deck.Cards[0].ID
+			if len(cards) > 0 {
+				currentCardID = cards[0].ID
+			}
+			// Start with the card that is most overdue; when nothing is due fall back to the first card of the deck.
+			due, err := l.repo.GetFrontOfNextDueCard(ctx, deckID, username, tags, nil, time.Now())
+			if err != nil && !errors.Is(err, database.ErrNoResults) {
+				log.Error().Err(err).Msgf("while getting next due card for deck %s", deckID)
+				return models.DeckSession{}, err
+			}
+			if err == nil {
+				currentCardID = due.CardID
 			}
 			session = models.DeckSession{
 				ID:            uuid.NewString(),
@@ -65,6 +105,31 @@ func (l *Logic) GetActiveSessionForUserAndDeckID(ctx context.Context, username,
 				CurrentCardID: currentCardID,
 				IsFront:       true,
 				CardAnswers:   make([]models.CardAnswer, 0),
+				Order:         order,
+				Direction:     direction,
+				Mode:          mode,
+				Tags:          tags,
+			}
+
+			if (order != models.DefaultCardOrder || direction != models.ForwardDirection) && len(cards) > 0 {
+				var stats []models.CardAnswerStats
+				if order == models.WeakestFirstCardOrder {
+					cardIDs := make([]string, len(cards))
+					for i, card := range cards {
+						cardIDs[i] = card.ID
+					}
+					stats, err = l.repo.GetCardAnswerStats(ctx, username, cardIDs)
+					if err != nil {
+						log.Error().Err(err).Msgf("while getting answer stats for deck %s", deckID)
+						return models.DeckSession{}, err
+					}
+				}
+				if order == models.ShuffledCardOrder {
+					session.Seed = time.Now().UnixNano()
+				}
+				session.Queue = inDirection(orderCards(deckID, cards, order, session.Seed, stats), direction)
+				session.CurrentCardID = session.Queue[0].CardID
+				session.IsReversed = session.Queue[0].Reversed
 			}
 
 			sessionErr := l.repo.CreateSessionForUserDeck(ctx, session)
@@ -85,10 +150,10 @@ func (l *Logic) UpdateSessionState(ctx context.Context, update models.SessionUpd
 			g, errCtx = errgroup.WithContext(sessionContext)
 		)
 		g.Go(func() error {
-			return l.repo.SetAnswerForCard(errCtx, update.ID, update.CurrentCardID, update.IsAnsweredCorrect)
+			return l.repo.SetAnswerForCard(errCtx, update.ID, update.CurrentCardID, update.IsReversed, update.Grade, update.Latency)
 		})
 		g.Go(func() error {
-			return l.repo.UpdateCurrentCard(errCtx, update.ID, update.NewCardID, update.IsFront)
+			return l.repo.UpdateCurrentCard(errCtx, update.ID, update.NewCardID, update.NewReversed, update.IsFront)
 		})
 		if update.IsLastCard {
 			g.Go(func() error {
@@ -122,8 +187,506 @@ func (l *Logic) GetSessionByID(ctx context.Context, sessionID string) (models.De
 	return l.repo.GetSessionByID(ctx, sessionID)
 }
 
-func (l *Logic) SetCurrentCard(ctx context.Context, sessionID string, cardID string, isFront bool) error {
+func (l *Logic) SetCurrentCard(ctx context.Context, sessionID string, cardID string, isReversed, isFront bool) error {
 	log := l.logger.With().Str("method", "SetCurrentCard").Logger()
 	log.Info().Msgf("setting current card for session %s", sessionID)
-	return l.repo.UpdateCurrentCard(ctx, sessionID, cardID, isFront)
+	return l.repo.UpdateCurrentCard(ctx, sessionID, cardID, isReversed, isFront)
+}
+
+// StartReviewSession resumes the user's unfinished review session or starts a new one over every card due today,
+// interleaving the decks the cards come from. ErrNothingDue is returned when there is nothing to review.
+func (l *Logic) StartReviewSession(ctx context.Context, username string) (models.DeckSession, error) {
+	log := l.logger.With().Str("method", "StartReviewSession").Logger()
+	log.Info().Msgf("starting review session for username %s", username)
+
+	session, err := l.repo.GetActiveSessionForUserKind(ctx, username, models.ReviewSessionKind, nil)
+	if err == nil {
+		return session, nil
+	}
+	if !errors.Is(err, database.ErrNoResults) {
+		log.Error().Err(err).Msgf("while getting review session for user %s", username)
+		return models.DeckSession{}, err
+	}
+
+	due, err := l.deckController.GetDueCardsForUser(ctx, username, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting due cards for user %s", username)
+		return models.DeckSession{}, err
+	}
+	if len(due) == 0 {
+		return models.DeckSession{}, ErrNothingDue
+	}
+
+	queue := interleaveByDeck(due)
+	session = models.DeckSession{
+		ID:            uuid.NewString(),
+		Kind:          models.ReviewSessionKind,
+		Username:      username,
+		DeckName:      ReviewSessionName,
+		CurrentCardID: queue[0].CardID,
+		IsReversed:    queue[0].Reversed,
+		IsFront:       true,
+		CardAnswers:   make([]models.CardAnswer, 0),
+		Queue:         queue,
+	}
+
+	err = l.repo.CreateSessionForUserDeck(ctx, session)
+	if err != nil {
+		log.Error().Err(err).Msgf("while creating review session for user %s", username)
+		return models.DeckSession{}, err
+	}
+
+	return session, nil
+}
+
+// StartTagSession resumes the user's unfinished session over the tags or starts a new one over every card with all of
+// them, across every deck the user can reach. Decks are interleaved as in a review session. ErrNoTaggedCards is
+// returned when no card the user studies has the tags.
+func (l *Logic) StartTagSession(ctx context.Context, username string, tags []string) (models.DeckSession, error) {
+	log := l.logger.With().Str("method", "StartTagSession").Logger()
+	log.Info().Msgf("starting session over tags %v for username %s", tags, username)
+
+	tags = models.NormalizeTags(tags)
+	if len(tags) == 0 {
+		return models.DeckSession{}, decks.ErrEmptyTags
+	}
+
+	session, err := l.repo.GetActiveSessionForUserKind(ctx, username, models.TagSessionKind, tags)
+	if err == nil {
+		return session, nil
+	}
+	if !errors.Is(err, database.ErrNoResults) {
+		log.Error().Err(err).Msgf("while getting tag session for user %s", username)
+		return models.DeckSession{}, err
+	}
+
+	cards, err := l.deckController.GetTaggedCardsForUser(ctx, username, tags)
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting cards tagged %v for user %s", tags, username)
+		return models.DeckSession{}, err
+	}
+	cards, err = l.withoutHeldCards(ctx, username, cards)
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting held cards for user %s", username)
+		return models.DeckSession{}, err
+	}
+	if len(cards) == 0 {
+		return models.DeckSession{}, ErrNoTaggedCards
+	}
+
+	tagged := make([]models.DueCard, len(cards))
+	for i, card := range cards {
+		tagged[i] = models.DueCard{CardID: card.ID, DeckID: card.DeckID}
+	}
+	queue := interleaveByDeck(tagged)
+	session = models.DeckSession{
+		ID:            uuid.NewString(),
+		Kind:          models.TagSessionKind,
+		Username:      username,
+		DeckName:      TagSessionName(tags),
+		CurrentCardID: queue[0].CardID,
+		IsReversed:    queue[0].Reversed,
+		IsFront:       true,
+		CardAnswers:   make([]models.CardAnswer, 0),
+		Queue:         queue,
+		Tags:          tags,
+	}
+
+	err = l.repo.CreateSessionForUserDeck(ctx, session)
+	if err != nil {
+		log.Error().Err(err).Msgf("while creating tag session for user %s", username)
+		return models.DeckSession{}, err
+	}
+
+	return session, nil
+}
+
+// TagSessionName is shown in place of a deck name for sessions over tags, such as "#aws #networking".
+func TagSessionName(tags []string) string {
+	return "#" + strings.Join(tags, " #")
+}
+
+// withoutHeldCards drops the cards the user has suspended or buried.
+func (l *Logic) withoutHeldCards(ctx context.Context, username string, cards []models.Card) ([]models.Card, error) {
+	held, err := l.heldCards(ctx, username)
+	if err != nil || len(held) == 0 {
+		return cards, err
+	}
+
+	studied := make([]models.Card, 0, len(cards))
+	for _, card := range cards {
+		if !held[card.ID] {
+			studied = append(studied, card)
+		}
+	}
+	return studied, nil
+}
+
+// heldCards returns the IDs of the cards the user has suspended or buried.
+func (l *Logic) heldCards(ctx context.Context, username string) (map[string]bool, error) {
+	ids, err := l.repo.GetHeldCardIDs(ctx, username, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	held := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		held[id] = true
+	}
+	return held, nil
+}
+
+// interleaveByDeck takes one card from each deck in turn so that a review session alternates between decks.
+// Decks take turns in the order their most overdue card appears, and cards keep their due order within a deck.
+func interleaveByDeck(due []models.DueCard) []models.SessionCard {
+	var (
+		deckOrder []string
+		byDeck    = make(map[string][]models.SessionCard)
+	)
+	for _, card := range due {
+		if _, ok := byDeck[card.DeckID]; !ok {
+			deckOrder = append(deckOrder, card.DeckID)
+		}
+		byDeck[card.DeckID] = append(byDeck[card.DeckID], models.SessionCard{CardID: card.CardID, DeckID: card.DeckID, Reversed: card.Reversed})
+	}
+
+	queue := make([]models.SessionCard, 0, len(due))
+	for len(queue) < len(due) {
+		for _, deckID := range deckOrder {
+			if cards := byDeck[deckID]; len(cards) > 0 {
+				queue = append(queue, cards[0])
+				byDeck[deckID] = cards[1:]
+			}
+		}
+	}
+	return queue
+}
+
+// GetSessionSummary summarises a finished session of the user: how many cards were answered, how many were recalled,
+// how long it took, which cards were missed and how long each card took to answer compared to the user's average.
+// Sessions of other users are reported as not found.
+func (l *Logic) GetSessionSummary(ctx context.Context, sessionID, username string) (models.SessionSummary, error) {
+	log := l.logger.With().Str("method", "GetSessionSummary").Logger()
+	log.Info().Msgf("getting summary for session %s", sessionID)
+
+	session, err := l.repo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting session %s", sessionID)
+		return models.SessionSummary{}, err
+	}
+	if session.Username != username {
+		log.Error().Msgf("session %s does not belong to %s", sessionID, username)
+		return models.SessionSummary{}, database.ErrNoResults
+	}
+	if session.FinishedAt == nil {
+		return models.SessionSummary{}, ErrNotFinished
+	}
+
+	summary := session.Summary()
+	if len(session.CardAnswers) == 0 {
+		return summary, nil
+	}
+
+	// Cards studied in both directions are answered twice but only looked up once.
+	var (
+		cardIDs []string
+		seen    = make(map[string]bool, len(session.CardAnswers))
+	)
+	for _, answer := range session.CardAnswers {
+		if !seen[answer.CardID] {
+			seen[answer.CardID] = true
+			cardIDs = append(cardIDs, answer.CardID)
+		}
+	}
+
+	var (
+		cards              []models.Card
+		stats              []models.CardAnswerStats
+		g, errCtx          = errgroup.WithContext(ctx)
+		cardsErr, statsErr error
+	)
+	g.Go(func() error {
+		cards, cardsErr = l.repo.GetCardsByIDs(errCtx, cardIDs)
+		if errors.Is(cardsErr, database.ErrNoResults) {
+			return nil
+		}
+		return cardsErr
+	})
+	g.Go(func() error {
+		stats, statsErr = l.repo.GetCardAnswerStats(errCtx, username, cardIDs)
+		return statsErr
+	})
+	if err := g.Wait(); err != nil {
+		log.Error().Err(err).Msgf("while getting answered cards for session %s", sessionID)
+		return models.SessionSummary{}, err
+	}
+
+	cardsByID := make(map[string]models.Card, len(cards))
+	for _, card := range cards {
+		cardsByID[card.ID] = card
+	}
+	averages := make(map[string]models.AnswerLatency, len(stats))
+	for _, stat := range stats {
+		averages[stat.CardID] = stat.AverageLatency()
+	}
+
+	missed := make(map[string]bool)
+	for _, answer := range session.CardAnswers {
+		card, ok := cardsByID[answer.CardID]
+		if !ok {
+			continue
+		}
+		if !answer.Grade.IsCorrect() && !missed[answer.CardID] {
+			missed[answer.CardID] = true
+			summary.MissedCards = append(summary.MissedCards, card)
+		}
+		summary.AnsweredCards = append(summary.AnsweredCards, models.AnsweredCard{
+			Card:      card,
+			Reversed:  answer.Reversed,
+			IsCorrect: answer.Grade.IsCorrect(),
+			Latency:   answer.Latency,
+			Average:   averages[answer.CardID],
+		})
+	}
+
+	return summary, nil
+}
+
+// StartRetrySession starts a session over only the cards missed in a finished session of the user, in the order
+// they were answered, leaving out the cards the user has since suspended or buried. ErrNothingMissed is returned when
+// there is nothing left to retry.
+func (l *Logic) StartRetrySession(ctx context.Context, sessionID, username string) (models.DeckSession, error) {
+	log := l.logger.With().Str("method", "StartRetrySession").Logger()
+	log.Info().Msgf("starting retry of session %s for username %s", sessionID, username)
+
+	finished, err := l.repo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting session %s", sessionID)
+		return models.DeckSession{}, err
+	}
+	if finished.Username != username {
+		log.Error().Msgf("session %s does not belong to %s", sessionID, username)
+		return models.DeckSession{}, database.ErrNoResults
+	}
+	if finished.FinishedAt == nil {
+		return models.DeckSession{}, ErrNotFinished
+	}
+
+	held, err := l.heldCards(ctx, username)
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting held cards for user %s", username)
+		return models.DeckSession{}, err
+	}
+	var queue []models.SessionCard
+	for _, card := range finished.MissedCards() {
+		if !held[card.CardID] {
+			queue = append(queue, card)
+		}
+	}
+	if len(queue) == 0 {
+		return models.DeckSession{}, ErrNothingMissed
+	}
+
+	session := models.DeckSession{
+		ID:            uuid.NewString(),
+		Kind:          models.RetrySessionKind,
+		Username:      username,
+		DeckID:        finished.DeckID,
+		DeckName:      finished.DeckName,
+		CurrentCardID: queue[0].CardID,
+		IsReversed:    queue[0].Reversed,
+		IsFront:       true,
+		CardAnswers:   make([]models.CardAnswer, 0),
+		Queue:         queue,
+		RetryOf:       finished.ID,
+		Mode:          finished.Mode,
+	}
+
+	err = l.repo.CreateSessionForUserDeck(ctx, session)
+	if err != nil {
+		log.Error().Err(err).Msgf("while creating retry of session %s", sessionID)
+		return models.DeckSession{}, err
+	}
+
+	return session, nil
+}
+
+// GetSessionHistory returns summaries of the user's finished sessions started between from and to, newest first.
+// History is limited to a single deck when deckID is not empty.
+func (l *Logic) GetSessionHistory(ctx context.Context, username, deckID string, from time.Time, to *time.Time, limit, offset int) ([]models.SessionSummary, error) {
+	log := l.logger.With().Str("method", "GetSessionHistory").Logger()
+
+	if to != nil && to.Before(from) {
+		return nil, decks.ErrInvalidToBeforeFrom
+	}
+
+	sessions, err := l.repo.GetFinishedSessionsForUser(ctx, username, deckID, from, to, limit, offset)
+	if err != nil {
+		if errors.Is(err, database.ErrNoResults) {
+			return nil, nil
+		}
+		log.Error().Err(err).Msgf("while getting sessions for user %s", username)
+		return nil, err
+	}
+
+	history := make([]models.SessionSummary, 0, len(sessions))
+	for _, session := range sessions {
+		history = append(history, session.Summary())
+	}
+	return history, nil
+}
+
+// GetLearningStats returns the user's study on each of the given number of days up to and including the day of now.
+// Days run from midnight to midnight in now's location, and the days the user did not study are included.
+func (l *Logic) GetLearningStats(ctx context.Context, username string, now time.Time, days int) (models.LearningStats, error) {
+	log := l.logger.With().Str("method", "GetLearningStats").Logger()
+
+	if days <= 0 {
+		return models.LearningStats{}, ErrInvalidStatsRange
+	}
+
+	year, month, day := now.Date()
+	from := time.Date(year, month, day-days+1, 0, 0, 0, 0, now.Location())
+	study, err := l.repo.GetDailyStudy(ctx, username, from, now.Location().String())
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting daily study for user %s", username)
+		return models.LearningStats{}, err
+	}
+
+	byDay := make(map[string]*models.StudyDay, days)
+	stats := models.LearningStats{Days: make([]models.StudyDay, days)}
+	for i := range stats.Days {
+		stats.Days[i].Date = from.AddDate(0, 0, i)
+		byDay[stats.Days[i].Date.Format(models.StatsDayFormat)] = &stats.Days[i]
+	}
+	for _, reviews := range study.Reviews {
+		if d, ok := byDay[reviews.Day]; ok {
+			d.Reviews, d.Correct = reviews.Reviews, reviews.Correct
+		}
+	}
+	firstDay := from.Format(models.StatsDayFormat)
+	for _, learned := range study.Learned {
+		if d, ok := byDay[learned.Day]; ok {
+			d.Learned = learned.Learned
+		} else if learned.Day < firstDay {
+			stats.LearnedBefore += learned.Learned
+		}
+	}
+	for _, studied := range study.StudyTime {
+		if d, ok := byDay[studied.Day]; ok {
+			d.TimeStudied = time.Duration(studied.Milliseconds) * time.Millisecond
+		}
+	}
+	return stats, nil
+}
+
+// GetStudyPreferences returns the user's time zone and daily goal. Users without a record have no preferences, so they
+// study in UTC without a goal.
+func (l *Logic) GetStudyPreferences(ctx context.Context, username string) (models.StudyPreferences, error) {
+	log := l.logger.With().Str("method", "GetStudyPreferences").Logger()
+
+	user, err := l.repo.GetUserByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, database.ErrNoResults) {
+			return models.StudyPreferences{}, nil
+		}
+		log.Error().Err(err).Msgf("while getting user %s", username)
+		return models.StudyPreferences{}, err
+	}
+	return user.StudyPreferences, nil
+}
+
+// UpdateStudyPreferences saves the user's time zone and daily goal. The time zone must be an IANA name, such as
+// Europe/Paris, and a goal with a target must count cards or minutes. A target of 0 clears the goal.
+func (l *Logic) UpdateStudyPreferences(ctx context.Context, username string, preferences models.StudyPreferences) error {
+	log := l.logger.With().Str("method", "UpdateStudyPreferences").Logger()
+
+	// Local is the server's zone rather than the user's, and Mongo does not know it.
+	if preferences.TimeZone == "Local" {
+		return ErrInvalidTimeZone
+	}
+	if _, err := time.LoadLocation(preferences.TimeZone); err != nil {
+		return errors.Join(err, ErrInvalidTimeZone)
+	}
+
+	goal := preferences.DailyGoal
+	if goal.Target < 0 || goal.Target > models.MaxGoalTarget {
+		return ErrInvalidGoal
+	}
+	if goal.IsSet() && goal.Kind != models.GoalCards && goal.Kind != models.GoalMinutes {
+		return ErrInvalidGoal
+	}
+	if !goal.IsSet() {
+		preferences.DailyGoal = models.DailyGoal{}
+	}
+
+	err := l.repo.UpdateStudyPreferences(ctx, username, preferences)
+	if err != nil {
+		log.Error().Err(err).Msgf("while updating study preferences for user %s", username)
+		return err
+	}
+	return nil
+}
+
+// GetStudyProgress returns how much of their daily goal the user has done today and how many days in a row they have
+// studied. Days run from midnight to midnight in the user's time zone, and a day counts towards the streak when they
+// answered a card on it. Minutes studied are the time spent answering cards today, so a session that runs past
+// midnight counts towards both days.
+func (l *Logic) GetStudyProgress(ctx context.Context, username string, now time.Time) (models.StudyProgress, error) {
+	log := l.logger.With().Str("method", "GetStudyProgress").Logger()
+
+	preferences, err := l.GetStudyPreferences(ctx, username)
+	if err != nil {
+		return models.StudyProgress{}, err
+	}
+
+	loc := preferences.Location()
+	study, err := l.repo.GetDailyStudy(ctx, username, time.Time{}, loc.String())
+	if err != nil {
+		log.Error().Err(err).Msgf("while getting daily study for user %s", username)
+		return models.StudyProgress{}, err
+	}
+
+	today := now.In(loc).Format(models.StatsDayFormat)
+	progress := models.StudyProgress{Goal: preferences.DailyGoal}
+	days := make([]string, 0, len(study.Reviews))
+	for _, reviews := range study.Reviews {
+		if reviews.Reviews == 0 {
+			continue
+		}
+		days = append(days, reviews.Day)
+		if reviews.Day == today && progress.Goal.Kind == models.GoalCards {
+			progress.Done = reviews.Reviews
+		}
+	}
+	if progress.Goal.Kind == models.GoalMinutes {
+		for _, studied := range study.StudyTime {
+			if studied.Day == today {
+				progress.Done = int(time.Duration(studied.Milliseconds) * time.Millisecond / time.Minute)
+			}
+		}
+	}
+
+	progress.Streak = models.StreakOf(days, today)
+	return progress, nil
+}
+
+// ExpireIdleSessions abandons every unfinished session of any user that has been idle for longer than ttl, so it is
+// no longer resumed. It returns how many sessions were abandoned.
+func (l *Logic) ExpireIdleSessions(ctx context.Context, ttl time.Duration) (int64, error) {
+	log := l.logger.With().Str("method", "ExpireIdleSessions").Logger()
+
+	if ttl <= 0 {
+		return 0, ErrInvalidTTL
+	}
+
+	abandoned, err := l.repo.AbandonIdleSessions(ctx, time.Now().Add(-ttl))
+	if err != nil {
+		log.Error().Err(err).Msgf("while abandoning sessions idle for %s", ttl)
+		return 0, err
+	}
+	if abandoned > 0 {
+		log.Info().Msgf("abandoned %d sessions idle for %s", abandoned, ttl)
+	}
+	return abandoned, nil
 }
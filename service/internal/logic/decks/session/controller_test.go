@@ -0,0 +1,875 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rmarken/reptr/service/internal/database"
+	databaseMocks "github.com/rmarken/reptr/service/internal/database/mocks"
+	"github.com/rmarken/reptr/service/internal/logic/decks"
+	deckMocks "github.com/rmarken/reptr/service/internal/logic/decks/mocks"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestLogic_StartReviewSession(t *testing.T) {
+	var (
+		haveErr       = errors.New("db error")
+		username      = uuid.NewString()
+		activeSession = models.DeckSession{ID: uuid.NewString(), Kind: models.ReviewSessionKind, Username: username}
+	)
+
+	testCases := map[string]struct {
+		mockRepo  func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController)
+		wantQueue []models.SessionCard
+		wantID    string
+		wantErr   error
+	}{
+		"should resume the active review session": {
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), username, models.ReviewSessionKind, nil).Return(activeSession, nil)
+			},
+			wantID: activeSession.ID,
+		},
+		"should queue due cards alternating between decks": {
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), username, models.ReviewSessionKind, nil).Return(models.DeckSession{}, database.ErrNoResults)
+				decks.EXPECT().GetDueCardsForUser(gomock.Any(), username, gomock.Any()).Return([]models.DueCard{
+					{CardID: "a1", DeckID: "a"},
+					{CardID: "a2", DeckID: "a"},
+					{CardID: "b1", DeckID: "b"},
+					{CardID: "a3", DeckID: "a"},
+					{CardID: "c1", DeckID: "c"},
+				}, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantQueue: []models.SessionCard{
+				{CardID: "a1", DeckID: "a"},
+				{CardID: "b1", DeckID: "b"},
+				{CardID: "c1", DeckID: "c"},
+				{CardID: "a2", DeckID: "a"},
+				{CardID: "a3", DeckID: "a"},
+			},
+		},
+		"should return ErrNothingDue when no cards are due": {
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.DeckSession{}, database.ErrNoResults)
+				decks.EXPECT().GetDueCardsForUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: ErrNothingDue,
+		},
+		"should return err when session cannot be created": {
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.DeckSession{}, database.ErrNoResults)
+				decks.EXPECT().GetDueCardsForUser(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.DueCard{{CardID: "a1", DeckID: "a"}}, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			mockDecks := deckMocks.NewMockController(ctrl)
+			tc.mockRepo(mockRepo, mockDecks)
+
+			logic := Logic{repo: mockRepo, deckController: mockDecks, logger: zerolog.Nop()}
+
+			got, err := logic.StartReviewSession(context.Background(), username)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantID != "" {
+				assert.Equal(t, tc.wantID, got.ID)
+			}
+			if tc.wantQueue != nil {
+				assert.Equal(t, tc.wantQueue, got.Queue)
+				assert.Equal(t, models.ReviewSessionKind, got.Kind)
+				assert.Equal(t, tc.wantQueue[0].CardID, got.CurrentCardID)
+			}
+		})
+	}
+}
+
+func TestLogic_StartTagSession(t *testing.T) {
+	var (
+		haveErr       = errors.New("db error")
+		username      = uuid.NewString()
+		haveTags      = []string{"Networking", "#aws"}
+		wantTags      = []string{"aws", "networking"}
+		activeSession = models.DeckSession{ID: uuid.NewString(), Kind: models.TagSessionKind, Username: username, Tags: wantTags}
+	)
+
+	testCases := map[string]struct {
+		haveTags  []string
+		mockRepo  func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController)
+		wantQueue []models.SessionCard
+		wantID    string
+		wantErr   error
+	}{
+		"should resume the active session over the tags": {
+			haveTags: haveTags,
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), username, models.TagSessionKind, wantTags).Return(activeSession, nil)
+			},
+			wantID: activeSession.ID,
+		},
+		"should queue tagged cards alternating between decks": {
+			haveTags: haveTags,
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), username, models.TagSessionKind, wantTags).Return(models.DeckSession{}, database.ErrNoResults)
+				decks.EXPECT().GetTaggedCardsForUser(gomock.Any(), username, wantTags).Return([]models.Card{
+					{ID: "a1", DeckID: "a"},
+					{ID: "a2", DeckID: "a"},
+					{ID: "b1", DeckID: "b"},
+				}, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), username, gomock.Any()).Return(nil, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantQueue: []models.SessionCard{
+				{CardID: "a1", DeckID: "a"},
+				{CardID: "b1", DeckID: "b"},
+				{CardID: "a2", DeckID: "a"},
+			},
+		},
+		"should return ErrNoTaggedCards when no card has the tags": {
+			haveTags: haveTags,
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.DeckSession{}, database.ErrNoResults)
+				decks.EXPECT().GetTaggedCardsForUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: ErrNoTaggedCards,
+		},
+		"should leave out cards the user holds": {
+			haveTags: haveTags,
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.DeckSession{}, database.ErrNoResults)
+				decks.EXPECT().GetTaggedCardsForUser(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Card{
+					{ID: "a1", DeckID: "a"},
+					{ID: "a2", DeckID: "a"},
+				}, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), username, gomock.Any()).Return([]string{"a1"}, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantQueue: []models.SessionCard{
+				{CardID: "a2", DeckID: "a"},
+			},
+		},
+		"should return ErrNoTaggedCards when every tagged card is held": {
+			haveTags: haveTags,
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.DeckSession{}, database.ErrNoResults)
+				decks.EXPECT().GetTaggedCardsForUser(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Card{{ID: "a1", DeckID: "a"}}, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"a1"}, nil)
+			},
+			wantErr: ErrNoTaggedCards,
+		},
+		"should return ErrEmptyTags when no tags are given": {
+			haveTags: []string{" ", "#"},
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {},
+			wantErr:  decks.ErrEmptyTags,
+		},
+		"should return err when session cannot be created": {
+			haveTags: haveTags,
+			mockRepo: func(repo *databaseMocks.MockRepository, decks *deckMocks.MockController) {
+				repo.EXPECT().GetActiveSessionForUserKind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.DeckSession{}, database.ErrNoResults)
+				decks.EXPECT().GetTaggedCardsForUser(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Card{{ID: "a1", DeckID: "a"}}, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			mockDecks := deckMocks.NewMockController(ctrl)
+			tc.mockRepo(mockRepo, mockDecks)
+
+			logic := Logic{repo: mockRepo, deckController: mockDecks, logger: zerolog.Nop()}
+
+			got, err := logic.StartTagSession(context.Background(), username, tc.haveTags)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantID != "" {
+				assert.Equal(t, tc.wantID, got.ID)
+			}
+			if tc.wantQueue != nil {
+				assert.Equal(t, tc.wantQueue, got.Queue)
+				assert.Equal(t, models.TagSessionKind, got.Kind)
+				assert.Equal(t, wantTags, got.Tags)
+				assert.Equal(t, "#aws #networking", got.DeckName)
+			}
+		})
+	}
+}
+
+func TestLogic_GetSessionSummary(t *testing.T) {
+	var (
+		haveErr    = errors.New("db error")
+		username   = uuid.NewString()
+		sessionID  = uuid.NewString()
+		startedAt  = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+		finishedAt = startedAt.Add(5 * time.Minute)
+		finished   = models.DeckSession{
+			ID:         sessionID,
+			Username:   username,
+			DeckID:     "deck",
+			DeckName:   "Deck",
+			CreatedAt:  startedAt,
+			FinishedAt: &finishedAt,
+			CardAnswers: []models.CardAnswer{
+				{CardID: "card-1", Grade: models.GradeGood, Latency: models.AnswerLatency{Reveal: 2 * time.Second, Answer: 4 * time.Second}},
+				{CardID: "card-2", Grade: models.GradeAgain, Latency: models.AnswerLatency{Reveal: 6 * time.Second, Answer: 8 * time.Second}},
+				{CardID: "card-3", Grade: models.GradeHard},
+				{CardID: "card-4", Grade: models.GradeAgain},
+			},
+		}
+		cardIDs = []string{"card-1", "card-2", "card-3", "card-4"}
+		cards   = []models.Card{{ID: "card-1"}, {ID: "card-2"}, {ID: "card-3"}, {ID: "card-4"}}
+		stats   = []models.CardAnswerStats{
+			{CardID: "card-1", Answered: 2, Correct: 2, AnswersTimed: 2, TotalAnswerLatency: 10 * time.Second},
+		}
+	)
+
+	testCases := map[string]struct {
+		mockRepo           func(repo *databaseMocks.MockRepository)
+		wantTotal          int
+		wantCorrect        int
+		wantPercent        int
+		wantTimeTaken      time.Duration
+		wantAverageLatency models.AnswerLatency
+		wantMissed         []models.Card
+		wantAnswered       []models.AnsweredCard
+		wantErr            error
+	}{
+		"should summarise a finished session": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(finished, nil)
+				repo.EXPECT().GetCardsByIDs(gomock.Any(), cardIDs).Return(cards, nil)
+				repo.EXPECT().GetCardAnswerStats(gomock.Any(), username, cardIDs).Return(stats, nil)
+			},
+			wantTotal:          4,
+			wantCorrect:        2,
+			wantPercent:        50,
+			wantTimeTaken:      5 * time.Minute,
+			wantAverageLatency: models.AnswerLatency{Reveal: 4 * time.Second, Answer: 6 * time.Second},
+			wantMissed:         []models.Card{{ID: "card-2"}, {ID: "card-4"}},
+			wantAnswered: []models.AnsweredCard{
+				{Card: models.Card{ID: "card-1"}, IsCorrect: true, Latency: models.AnswerLatency{Reveal: 2 * time.Second, Answer: 4 * time.Second}, Average: models.AnswerLatency{Answer: 5 * time.Second}},
+				{Card: models.Card{ID: "card-2"}, Latency: models.AnswerLatency{Reveal: 6 * time.Second, Answer: 8 * time.Second}},
+				{Card: models.Card{ID: "card-3"}, IsCorrect: true},
+				{Card: models.Card{ID: "card-4"}},
+			},
+		},
+		"should list a card missed in both directions once": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				both := finished
+				both.CardAnswers = []models.CardAnswer{
+					{CardID: "card-1", Grade: models.GradeAgain},
+					{CardID: "card-1", Reversed: true, Grade: models.GradeAgain},
+				}
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(both, nil)
+				repo.EXPECT().GetCardsByIDs(gomock.Any(), []string{"card-1"}).Return([]models.Card{{ID: "card-1"}}, nil)
+				repo.EXPECT().GetCardAnswerStats(gomock.Any(), username, []string{"card-1"}).Return(nil, nil)
+			},
+			wantTotal:     2,
+			wantTimeTaken: 5 * time.Minute,
+			wantMissed:    []models.Card{{ID: "card-1"}},
+			wantAnswered: []models.AnsweredCard{
+				{Card: models.Card{ID: "card-1"}},
+				{Card: models.Card{ID: "card-1"}, Reversed: true},
+			},
+		},
+		"should not look up cards when nothing was answered": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				empty := finished
+				empty.CardAnswers = nil
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(empty, nil)
+			},
+			wantTimeTaken: 5 * time.Minute,
+		},
+		"should return ErrNoResults for a session of another user": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				other := finished
+				other.Username = uuid.NewString()
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(other, nil)
+			},
+			wantErr: database.ErrNoResults,
+		},
+		"should return ErrNotFinished for an active session": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				active := finished
+				active.FinishedAt = nil
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(active, nil)
+			},
+			wantErr: ErrNotFinished,
+		},
+		"should return err when answered cards cannot be fetched": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(finished, nil)
+				repo.EXPECT().GetCardsByIDs(gomock.Any(), gomock.Any()).Return(nil, haveErr)
+				repo.EXPECT().GetCardAnswerStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(stats, nil).AnyTimes()
+			},
+			wantErr: haveErr,
+		},
+		"should return err when answer stats cannot be fetched": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(finished, nil)
+				repo.EXPECT().GetCardsByIDs(gomock.Any(), gomock.Any()).Return(cards, nil).AnyTimes()
+				repo.EXPECT().GetCardAnswerStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			tc.mockRepo(mockRepo)
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+
+			got, err := logic.GetSessionSummary(context.Background(), sessionID, username)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.wantTotal, got.TotalCards)
+			assert.Equal(t, tc.wantCorrect, got.NumCorrect)
+			assert.Equal(t, tc.wantPercent, got.PercentCorrect())
+			assert.Equal(t, tc.wantTimeTaken, got.TimeTaken)
+			assert.Equal(t, tc.wantAverageLatency, got.AverageLatency)
+			assert.Equal(t, tc.wantMissed, got.MissedCards)
+			assert.Equal(t, tc.wantAnswered, got.AnsweredCards)
+		})
+	}
+}
+
+func TestLogic_StartRetrySession(t *testing.T) {
+	var (
+		haveErr    = errors.New("db error")
+		username   = uuid.NewString()
+		sessionID  = uuid.NewString()
+		finishedAt = time.Now()
+		finished   = models.DeckSession{
+			ID:         sessionID,
+			Username:   username,
+			DeckID:     "deck",
+			DeckName:   "Deck",
+			FinishedAt: &finishedAt,
+			CardAnswers: []models.CardAnswer{
+				{CardID: "card-1", Grade: models.GradeGood},
+				{CardID: "card-2", Grade: models.GradeAgain},
+				{CardID: "card-3", Grade: models.GradeEasy},
+				{CardID: "card-4", Grade: models.GradeAgain},
+			},
+		}
+	)
+
+	testCases := map[string]struct {
+		mockRepo  func(repo *databaseMocks.MockRepository)
+		wantQueue []models.SessionCard
+		wantErr   error
+	}{
+		"should queue only the missed cards": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(finished, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), username, gomock.Any()).Return(nil, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantQueue: []models.SessionCard{
+				{CardID: "card-2", DeckID: "deck"},
+				{CardID: "card-4", DeckID: "deck"},
+			},
+		},
+		"should keep the deck of each missed card of a review session": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				review := finished
+				review.Kind = models.ReviewSessionKind
+				review.DeckID = ""
+				review.Queue = []models.SessionCard{
+					{CardID: "card-1", DeckID: "a"},
+					{CardID: "card-2", DeckID: "b"},
+					{CardID: "card-3", DeckID: "a"},
+					{CardID: "card-4", DeckID: "c"},
+				}
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(review, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), username, gomock.Any()).Return(nil, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantQueue: []models.SessionCard{
+				{CardID: "card-2", DeckID: "b"},
+				{CardID: "card-4", DeckID: "c"},
+			},
+		},
+		"should leave out missed cards the user holds": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(finished, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), username, gomock.Any()).Return([]string{"card-4"}, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantQueue: []models.SessionCard{
+				{CardID: "card-2", DeckID: "deck"},
+			},
+		},
+		"should return ErrNothingMissed when every card was recalled": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				perfect := finished
+				perfect.CardAnswers = []models.CardAnswer{{CardID: "card-1", Grade: models.GradeHard}}
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(perfect, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), username, gomock.Any()).Return(nil, nil)
+			},
+			wantErr: ErrNothingMissed,
+		},
+		"should return ErrNotFinished for an active session": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				active := finished
+				active.FinishedAt = nil
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(active, nil)
+			},
+			wantErr: ErrNotFinished,
+		},
+		"should return ErrNoResults for a session of another user": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				other := finished
+				other.Username = uuid.NewString()
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(other, nil)
+			},
+			wantErr: database.ErrNoResults,
+		},
+		"should return err when session cannot be created": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(finished, nil)
+				repo.EXPECT().GetHeldCardIDs(gomock.Any(), username, gomock.Any()).Return(nil, nil)
+				repo.EXPECT().CreateSessionForUserDeck(gomock.Any(), gomock.Any()).Return(haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			tc.mockRepo(mockRepo)
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+
+			got, err := logic.StartRetrySession(context.Background(), sessionID, username)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantQueue != nil {
+				assert.Equal(t, tc.wantQueue, got.Queue)
+				assert.Equal(t, models.RetrySessionKind, got.Kind)
+				assert.Equal(t, sessionID, got.RetryOf)
+				assert.Equal(t, tc.wantQueue[0].CardID, got.CurrentCardID)
+			}
+		})
+	}
+}
+
+func TestLogic_GetSessionHistory(t *testing.T) {
+	var (
+		haveErr    = errors.New("db error")
+		username   = uuid.NewString()
+		startedAt  = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+		finishedAt = startedAt.Add(90 * time.Second)
+		finished   = models.DeckSession{
+			ID:         "session-1",
+			Username:   username,
+			DeckID:     "deck",
+			DeckName:   "Deck",
+			CreatedAt:  startedAt,
+			FinishedAt: &finishedAt,
+			CardAnswers: []models.CardAnswer{
+				{CardID: "card-1", Grade: models.GradeGood},
+				{CardID: "card-2", Grade: models.GradeAgain},
+			},
+		}
+		before = startedAt.Add(-time.Hour)
+	)
+
+	testCases := map[string]struct {
+		haveTo      *time.Time
+		mockRepo    func(repo *databaseMocks.MockRepository)
+		wantHistory []models.SessionSummary
+		wantErr     error
+	}{
+		"should summarise each session": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetFinishedSessionsForUser(gomock.Any(), username, "deck", startedAt, nil, 20, 0).Return([]models.DeckSession{finished}, nil)
+			},
+			wantHistory: []models.SessionSummary{{
+				SessionID:  "session-1",
+				DeckID:     "deck",
+				DeckName:   "Deck",
+				StartedAt:  startedAt,
+				FinishedAt: &finishedAt,
+				TotalCards: 2,
+				NumCorrect: 1,
+				TimeTaken:  90 * time.Second,
+			}},
+		},
+		"should return no history when there are no sessions": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetFinishedSessionsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, database.ErrNoResults)
+			},
+		},
+		"should return ErrInvalidToBeforeFrom when to is before from": {
+			haveTo:   &before,
+			mockRepo: func(repo *databaseMocks.MockRepository) {},
+			wantErr:  decks.ErrInvalidToBeforeFrom,
+		},
+		"should return err when sessions cannot be fetched": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetFinishedSessionsForUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			tc.mockRepo(mockRepo)
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+
+			got, err := logic.GetSessionHistory(context.Background(), username, "deck", startedAt, tc.haveTo, 20, 0)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantHistory, got)
+		})
+	}
+}
+
+func TestLogic_ExpireIdleSessions(t *testing.T) {
+	var (
+		haveErr = errors.New("db error")
+		ttl     = time.Hour
+	)
+
+	testCases := map[string]struct {
+		haveTTL       time.Duration
+		mockRepo      func(repo *databaseMocks.MockRepository)
+		wantAbandoned int64
+		wantErr       error
+	}{
+		"should abandon sessions idle for longer than the ttl": {
+			haveTTL: ttl,
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().AbandonIdleSessions(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, idleSince time.Time) (int64, error) {
+					assert.WithinDuration(t, time.Now().Add(-ttl), idleSince, time.Minute)
+					return 3, nil
+				})
+			},
+			wantAbandoned: 3,
+		},
+		"should return ErrInvalidTTL when the ttl is not positive": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {},
+			wantErr:  ErrInvalidTTL,
+		},
+		"should return err when sessions cannot be abandoned": {
+			haveTTL: ttl,
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().AbandonIdleSessions(gomock.Any(), gomock.Any()).Return(int64(0), haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			tc.mockRepo(mockRepo)
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+
+			got, err := logic.ExpireIdleSessions(context.Background(), tc.haveTTL)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantAbandoned, got)
+		})
+	}
+}
+
+func TestLogic_GetLearningStats(t *testing.T) {
+	var (
+		haveErr  = errors.New("db error")
+		username = uuid.NewString()
+		now      = time.Date(2024, time.March, 3, 18, 30, 0, 0, time.UTC)
+		from     = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	testCases := map[string]struct {
+		haveDays  int
+		mockRepo  func(repo *databaseMocks.MockRepository)
+		wantStats models.LearningStats
+		wantErr   error
+	}{
+		"should fill in each day including the days without study": {
+			haveDays: 3,
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetDailyStudy(gomock.Any(), username, from, "UTC").Return(models.DailyStudy{
+					Reviews:   []models.DailyReviews{{Day: "2024-03-01", Reviews: 4, Correct: 3}},
+					Learned:   []models.DailyLearned{{Day: "2024-02-20", Learned: 5}, {Day: "2024-03-03", Learned: 2}},
+					StudyTime: []models.DailyStudyTime{{Day: "2024-03-01", Milliseconds: 90000}},
+				}, nil)
+			},
+			wantStats: models.LearningStats{
+				Days: []models.StudyDay{
+					{Date: from, Reviews: 4, Correct: 3, TimeStudied: 90 * time.Second},
+					{Date: from.AddDate(0, 0, 1)},
+					{Date: from.AddDate(0, 0, 2), Learned: 2},
+				},
+				LearnedBefore: 5,
+			},
+		},
+		"should return ErrInvalidStatsRange when there are no days": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {},
+			wantErr:  ErrInvalidStatsRange,
+		},
+		"should return err when study cannot be fetched": {
+			haveDays: 3,
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetDailyStudy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.DailyStudy{}, haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			tc.mockRepo(mockRepo)
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+
+			got, err := logic.GetLearningStats(context.Background(), username, now, tc.haveDays)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStats, got)
+		})
+	}
+}
+
+func TestLogic_UpdateStudyPreferences(t *testing.T) {
+	var (
+		haveErr  = errors.New("db error")
+		username = uuid.NewString()
+	)
+
+	testCases := map[string]struct {
+		havePreferences models.StudyPreferences
+		mockRepo        func(repo *databaseMocks.MockRepository)
+		wantErr         error
+	}{
+		"should save a time zone and goal": {
+			havePreferences: models.StudyPreferences{TimeZone: "Europe/Paris", DailyGoal: models.DailyGoal{Kind: models.GoalMinutes, Target: 20}},
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().UpdateStudyPreferences(gomock.Any(), username, models.StudyPreferences{
+					TimeZone:  "Europe/Paris",
+					DailyGoal: models.DailyGoal{Kind: models.GoalMinutes, Target: 20},
+				}).Return(nil)
+			},
+		},
+		"should clear the goal when it has no target": {
+			havePreferences: models.StudyPreferences{DailyGoal: models.DailyGoal{Kind: models.GoalCards}},
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().UpdateStudyPreferences(gomock.Any(), username, models.StudyPreferences{}).Return(nil)
+			},
+		},
+		"should return ErrInvalidTimeZone when the time zone is unknown": {
+			havePreferences: models.StudyPreferences{TimeZone: "Mars/Olympus_Mons"},
+			mockRepo:        func(repo *databaseMocks.MockRepository) {},
+			wantErr:         ErrInvalidTimeZone,
+		},
+		"should return ErrInvalidTimeZone for the server's local time zone": {
+			havePreferences: models.StudyPreferences{TimeZone: "Local"},
+			mockRepo:        func(repo *databaseMocks.MockRepository) {},
+			wantErr:         ErrInvalidTimeZone,
+		},
+		"should return ErrInvalidGoal when the goal counts something else": {
+			havePreferences: models.StudyPreferences{DailyGoal: models.DailyGoal{Kind: "decks", Target: 2}},
+			mockRepo:        func(repo *databaseMocks.MockRepository) {},
+			wantErr:         ErrInvalidGoal,
+		},
+		"should return ErrInvalidGoal when the target is more than a day": {
+			havePreferences: models.StudyPreferences{DailyGoal: models.DailyGoal{Kind: models.GoalMinutes, Target: models.MaxGoalTarget + 1}},
+			mockRepo:        func(repo *databaseMocks.MockRepository) {},
+			wantErr:         ErrInvalidGoal,
+		},
+		"should return err when preferences cannot be saved": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().UpdateStudyPreferences(gomock.Any(), gomock.Any(), gomock.Any()).Return(haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			tc.mockRepo(mockRepo)
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+
+			err := logic.UpdateStudyPreferences(context.Background(), username, tc.havePreferences)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestLogic_GetStudyProgress(t *testing.T) {
+	var (
+		haveErr  = errors.New("db error")
+		username = uuid.NewString()
+		// It is already the 4th of March in Tokyo.
+		now   = time.Date(2024, time.March, 3, 18, 30, 0, 0, time.UTC)
+		tokyo = models.StudyPreferences{TimeZone: "Asia/Tokyo", DailyGoal: models.DailyGoal{Kind: models.GoalCards, Target: 20}}
+		study = models.DailyStudy{
+			Reviews: []models.DailyReviews{
+				{Day: "2024-03-04", Reviews: 5},
+				{Day: "2024-02-25", Reviews: 3},
+				{Day: "2024-03-03", Reviews: 8},
+				{Day: "2024-02-26", Reviews: 1},
+				{Day: "2024-02-27", Reviews: 2},
+				{Day: "2024-03-02", Reviews: 4},
+			},
+			StudyTime: []models.DailyStudyTime{{Day: "2024-03-04", Milliseconds: int64(12*time.Minute+30*time.Second) / int64(time.Millisecond)}},
+		}
+	)
+
+	testCases := map[string]struct {
+		mockRepo     func(repo *databaseMocks.MockRepository)
+		wantProgress models.StudyProgress
+		wantErr      error
+	}{
+		"should count days in the user's time zone": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetUserByUsername(gomock.Any(), username).Return(models.User{Username: username, StudyPreferences: tokyo}, nil)
+				repo.EXPECT().GetDailyStudy(gomock.Any(), username, time.Time{}, "Asia/Tokyo").Return(study, nil)
+			},
+			wantProgress: models.StudyProgress{
+				Goal:   tokyo.DailyGoal,
+				Done:   5,
+				Streak: models.Streak{Current: 3, Longest: 3},
+			},
+		},
+		"should count whole minutes towards a minutes goal": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetUserByUsername(gomock.Any(), username).Return(models.User{
+					Username:         username,
+					StudyPreferences: models.StudyPreferences{TimeZone: "Asia/Tokyo", DailyGoal: models.DailyGoal{Kind: models.GoalMinutes, Target: 15}},
+				}, nil)
+				repo.EXPECT().GetDailyStudy(gomock.Any(), username, time.Time{}, "Asia/Tokyo").Return(study, nil)
+			},
+			wantProgress: models.StudyProgress{
+				Goal:   models.DailyGoal{Kind: models.GoalMinutes, Target: 15},
+				Done:   12,
+				Streak: models.Streak{Current: 3, Longest: 3},
+			},
+		},
+		"should only count minutes studied today towards a minutes goal": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetUserByUsername(gomock.Any(), username).Return(models.User{
+					Username:         username,
+					StudyPreferences: models.StudyPreferences{TimeZone: "Asia/Tokyo", DailyGoal: models.DailyGoal{Kind: models.GoalMinutes, Target: 15}},
+				}, nil)
+				repo.EXPECT().GetDailyStudy(gomock.Any(), username, time.Time{}, "Asia/Tokyo").Return(models.DailyStudy{
+					Reviews: []models.DailyReviews{{Day: "2024-03-03", Reviews: 6}, {Day: "2024-03-04", Reviews: 2}},
+					// A session that ran past midnight in Tokyo.
+					StudyTime: []models.DailyStudyTime{
+						{Day: "2024-03-03", Milliseconds: int64(20 * time.Minute / time.Millisecond)},
+						{Day: "2024-03-04", Milliseconds: int64(4 * time.Minute / time.Millisecond)},
+					},
+				}, nil)
+			},
+			wantProgress: models.StudyProgress{
+				Goal:   models.DailyGoal{Kind: models.GoalMinutes, Target: 15},
+				Done:   4,
+				Streak: models.Streak{Current: 2, Longest: 2},
+			},
+		},
+		"should keep the streak going before the user has studied today": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetUserByUsername(gomock.Any(), username).Return(models.User{}, database.ErrNoResults)
+				repo.EXPECT().GetDailyStudy(gomock.Any(), username, time.Time{}, "UTC").Return(models.DailyStudy{
+					Reviews: []models.DailyReviews{{Day: "2024-03-01", Reviews: 2}, {Day: "2024-03-02", Reviews: 1}},
+				}, nil)
+			},
+			wantProgress: models.StudyProgress{Streak: models.Streak{Current: 2, Longest: 2}},
+		},
+		"should break the streak after a day without study": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetUserByUsername(gomock.Any(), username).Return(models.User{}, database.ErrNoResults)
+				repo.EXPECT().GetDailyStudy(gomock.Any(), username, time.Time{}, "UTC").Return(models.DailyStudy{
+					Reviews: []models.DailyReviews{{Day: "2024-02-29", Reviews: 2}, {Day: "2024-03-01", Reviews: 1}},
+				}, nil)
+			},
+			wantProgress: models.StudyProgress{Streak: models.Streak{Current: 0, Longest: 2}},
+		},
+		"should return err when the user cannot be fetched": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(models.User{}, haveErr)
+			},
+			wantErr: haveErr,
+		},
+		"should return err when study cannot be fetched": {
+			mockRepo: func(repo *databaseMocks.MockRepository) {
+				repo.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(models.User{}, database.ErrNoResults)
+				repo.EXPECT().GetDailyStudy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.DailyStudy{}, haveErr)
+			},
+			wantErr: haveErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := databaseMocks.NewMockRepository(ctrl)
+			tc.mockRepo(mockRepo)
+
+			logic := Logic{repo: mockRepo, logger: zerolog.Nop()}
+
+			got, err := logic.GetStudyProgress(context.Background(), username, now)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantProgress, got)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package session
+
+import "errors"
+
+var (
+	ErrNothingDue        = errors.New("no cards are due")
+	ErrNotFinished       = errors.New("session has not finished")
+	ErrNothingMissed     = errors.New("no cards were missed")
+	ErrInvalidTTL        = errors.New("session ttl must be positive")
+	ErrNoTaggedCards     = errors.New("no cards have these tags")
+	ErrInvalidStatsRange = errors.New("stats must cover at least one day")
+	ErrInvalidTimeZone   = errors.New("time zone is not known")
+	ErrInvalidGoal       = errors.New("daily goal must count cards or minutes, at most 1440 a day")
+)
@@ -0,0 +1,263 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/rmarken/reptr/service/internal/logic/decks/session (interfaces: Controller)
+//
+// Generated by this command:
+//
+//	mockgen -destination ./mocks/controller_mock.go -package session . Controller
+//
+
+// Package session is a generated GoMock package.
+package session
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/rmarken/reptr/service/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockController is a mock of Controller interface.
+type MockController struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerMockRecorder
+}
+
+// MockControllerMockRecorder is the mock recorder for MockController.
+type MockControllerMockRecorder struct {
+	mock *MockController
+}
+
+// NewMockController creates a new mock instance.
+func NewMockController(ctrl *gomock.Controller) *MockController {
+	mock := &MockController{ctrl: ctrl}
+	mock.recorder = &MockControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockController) EXPECT() *MockControllerMockRecorder {
+	return m.recorder
+}
+
+// ExpireIdleSessions mocks base method.
+func (m *MockController) ExpireIdleSessions(arg0 context.Context, arg1 time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireIdleSessions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireIdleSessions indicates an expected call of ExpireIdleSessions.
+func (mr *MockControllerMockRecorder) ExpireIdleSessions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireIdleSessions", reflect.TypeOf((*MockController)(nil).ExpireIdleSessions), arg0, arg1)
+}
+
+// GetActiveSessionForUserAndDeckID mocks base method.
+func (m *MockController) GetActiveSessionForUserAndDeckID(arg0 context.Context, arg1, arg2 string, arg3 []string, arg4 models.CardOrder, arg5 models.StudyDirection, arg6 models.AnswerMode) (models.DeckSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveSessionForUserAndDeckID", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(models.DeckSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveSessionForUserAndDeckID indicates an expected call of GetActiveSessionForUserAndDeckID.
+func (mr *MockControllerMockRecorder) GetActiveSessionForUserAndDeckID(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSessionForUserAndDeckID", reflect.TypeOf((*MockController)(nil).GetActiveSessionForUserAndDeckID), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// GetLearningStats mocks base method.
+func (m *MockController) GetLearningStats(arg0 context.Context, arg1 string, arg2 time.Time, arg3 int) (models.LearningStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLearningStats", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.LearningStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLearningStats indicates an expected call of GetLearningStats.
+func (mr *MockControllerMockRecorder) GetLearningStats(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLearningStats", reflect.TypeOf((*MockController)(nil).GetLearningStats), arg0, arg1, arg2, arg3)
+}
+
+// GetSessionByID mocks base method.
+func (m *MockController) GetSessionByID(arg0 context.Context, arg1 string) (models.DeckSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByID", arg0, arg1)
+	ret0, _ := ret[0].(models.DeckSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByID indicates an expected call of GetSessionByID.
+func (mr *MockControllerMockRecorder) GetSessionByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByID", reflect.TypeOf((*MockController)(nil).GetSessionByID), arg0, arg1)
+}
+
+// GetSessionHistory mocks base method.
+func (m *MockController) GetSessionHistory(arg0 context.Context, arg1, arg2 string, arg3 time.Time, arg4 *time.Time, arg5, arg6 int) ([]models.SessionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionHistory", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]models.SessionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionHistory indicates an expected call of GetSessionHistory.
+func (mr *MockControllerMockRecorder) GetSessionHistory(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionHistory", reflect.TypeOf((*MockController)(nil).GetSessionHistory), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// GetSessionSummary mocks base method.
+func (m *MockController) GetSessionSummary(arg0 context.Context, arg1, arg2 string) (models.SessionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionSummary", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.SessionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionSummary indicates an expected call of GetSessionSummary.
+func (mr *MockControllerMockRecorder) GetSessionSummary(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionSummary", reflect.TypeOf((*MockController)(nil).GetSessionSummary), arg0, arg1, arg2)
+}
+
+// GetStudyPreferences mocks base method.
+func (m *MockController) GetStudyPreferences(arg0 context.Context, arg1 string) (models.StudyPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudyPreferences", arg0, arg1)
+	ret0, _ := ret[0].(models.StudyPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudyPreferences indicates an expected call of GetStudyPreferences.
+func (mr *MockControllerMockRecorder) GetStudyPreferences(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudyPreferences", reflect.TypeOf((*MockController)(nil).GetStudyPreferences), arg0, arg1)
+}
+
+// GetStudyProgress mocks base method.
+func (m *MockController) GetStudyProgress(arg0 context.Context, arg1 string, arg2 time.Time) (models.StudyProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudyProgress", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.StudyProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudyProgress indicates an expected call of GetStudyProgress.
+func (mr *MockControllerMockRecorder) GetStudyProgress(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudyProgress", reflect.TypeOf((*MockController)(nil).GetStudyProgress), arg0, arg1, arg2)
+}
+
+// SetCurrentCard mocks base method.
+func (m *MockController) SetCurrentCard(arg0 context.Context, arg1, arg2 string, arg3, arg4 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCurrentCard", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCurrentCard indicates an expected call of SetCurrentCard.
+func (mr *MockControllerMockRecorder) SetCurrentCard(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentCard", reflect.TypeOf((*MockController)(nil).SetCurrentCard), arg0, arg1, arg2, arg3, arg4)
+}
+
+// StartRetrySession mocks base method.
+func (m *MockController) StartRetrySession(arg0 context.Context, arg1, arg2 string) (models.DeckSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRetrySession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.DeckSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRetrySession indicates an expected call of StartRetrySession.
+func (mr *MockControllerMockRecorder) StartRetrySession(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRetrySession", reflect.TypeOf((*MockController)(nil).StartRetrySession), arg0, arg1, arg2)
+}
+
+// StartReviewSession mocks base method.
+func (m *MockController) StartReviewSession(arg0 context.Context, arg1 string) (models.DeckSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReviewSession", arg0, arg1)
+	ret0, _ := ret[0].(models.DeckSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartReviewSession indicates an expected call of StartReviewSession.
+func (mr *MockControllerMockRecorder) StartReviewSession(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReviewSession", reflect.TypeOf((*MockController)(nil).StartReviewSession), arg0, arg1)
+}
+
+// StartTagSession mocks base method.
+func (m *MockController) StartTagSession(arg0 context.Context, arg1 string, arg2 []string) (models.DeckSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTagSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.DeckSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTagSession indicates an expected call of StartTagSession.
+func (mr *MockControllerMockRecorder) StartTagSession(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTagSession", reflect.TypeOf((*MockController)(nil).StartTagSession), arg0, arg1, arg2)
+}
+
+// UpdateCardOrientation mocks base method.
+func (m *MockController) UpdateCardOrientation(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCardOrientation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCardOrientation indicates an expected call of UpdateCardOrientation.
+func (mr *MockControllerMockRecorder) UpdateCardOrientation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCardOrientation", reflect.TypeOf((*MockController)(nil).UpdateCardOrientation), arg0, arg1, arg2)
+}
+
+// UpdateSessionState mocks base method.
+func (m *MockController) UpdateSessionState(arg0 context.Context, arg1 models.SessionUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSessionState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSessionState indicates an expected call of UpdateSessionState.
+func (mr *MockControllerMockRecorder) UpdateSessionState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSessionState", reflect.TypeOf((*MockController)(nil).UpdateSessionState), arg0, arg1)
+}
+
+// UpdateStudyPreferences mocks base method.
+func (m *MockController) UpdateStudyPreferences(arg0 context.Context, arg1 string, arg2 models.StudyPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStudyPreferences", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStudyPreferences indicates an expected call of UpdateStudyPreferences.
+func (mr *MockControllerMockRecorder) UpdateStudyPreferences(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStudyPreferences", reflect.TypeOf((*MockController)(nil).UpdateStudyPreferences), arg0, arg1, arg2)
+}
@@ -0,0 +1,72 @@
+package session
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"math/rand"
+	"sort"
+)
+
+// orderCards freezes the cards of the deck into the order they will be studied in. Cards start from the order they
+// were created in so that a shuffle with the same seed always produces the same sequence.
+func orderCards(deckID string, cards []models.Card, order models.CardOrder, seed int64, stats []models.CardAnswerStats) []models.SessionCard {
+	ordered := make([]models.Card, len(cards))
+	copy(ordered, cards)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].CreatedAt.Before(ordered[j].CreatedAt)
+	})
+
+	switch order {
+	case models.ShuffledCardOrder:
+		rand.New(rand.NewSource(seed)).Shuffle(len(ordered), func(i, j int) {
+			ordered[i], ordered[j] = ordered[j], ordered[i]
+		})
+	case models.NewestFirstCardOrder:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].CreatedAt.After(ordered[j].CreatedAt)
+		})
+	case models.WeakestFirstCardOrder:
+		byCard := make(map[string]models.CardAnswerStats, len(stats))
+		for _, s := range stats {
+			byCard[s.CardID] = s
+		}
+		// Least accurate cards come first; between equally accurate cards the one missed most often goes first,
+		// which puts cards that were always missed ahead of cards that were never answered.
+		sort.SliceStable(ordered, func(i, j int) bool {
+			a, b := byCard[ordered[i].ID], byCard[ordered[j].ID]
+			if a.Accuracy() != b.Accuracy() {
+				return a.Accuracy() < b.Accuracy()
+			}
+			return a.Missed() > b.Missed()
+		})
+	}
+
+	queue := make([]models.SessionCard, len(ordered))
+	for i, card := range ordered {
+		queue[i] = models.SessionCard{CardID: card.ID, DeckID: deckID}
+	}
+	return queue
+}
+
+// inDirection sets which side of each queued card is the prompt. Bidirectional sessions study every card front to
+// back first and then back to front, so the two directions of a card are never asked one after the other.
+func inDirection(queue []models.SessionCard, direction models.StudyDirection) []models.SessionCard {
+	switch direction {
+	case models.ReverseDirection:
+		reversed := make([]models.SessionCard, len(queue))
+		for i, card := range queue {
+			card.Reversed = true
+			reversed[i] = card
+		}
+		return reversed
+	case models.BidirectionalDirection:
+		both := make([]models.SessionCard, 0, 2*len(queue))
+		both = append(both, queue...)
+		for _, card := range queue {
+			card.Reversed = true
+			both = append(both, card)
+		}
+		return both
+	default:
+		return queue
+	}
+}
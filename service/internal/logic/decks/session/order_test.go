@@ -0,0 +1,113 @@
+package session
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOrderCards(t *testing.T) {
+	var (
+		created = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+		cards   = []models.Card{
+			{ID: "card-3", CreatedAt: created.Add(3 * time.Hour)},
+			{ID: "card-1", CreatedAt: created.Add(1 * time.Hour)},
+			{ID: "card-4", CreatedAt: created.Add(4 * time.Hour)},
+			{ID: "card-2", CreatedAt: created.Add(2 * time.Hour)},
+		}
+	)
+
+	testCases := map[string]struct {
+		haveOrder models.CardOrder
+		haveStats []models.CardAnswerStats
+		wantIDs   []string
+	}{
+		"default order studies cards as they were created": {
+			haveOrder: models.DefaultCardOrder,
+			wantIDs:   []string{"card-1", "card-2", "card-3", "card-4"},
+		},
+		"newest first studies the latest card first": {
+			haveOrder: models.NewestFirstCardOrder,
+			wantIDs:   []string{"card-4", "card-3", "card-2", "card-1"},
+		},
+		"weakest first studies the least accurate cards first": {
+			haveOrder: models.WeakestFirstCardOrder,
+			haveStats: []models.CardAnswerStats{
+				{CardID: "card-1", Answered: 4, Correct: 4},
+				{CardID: "card-2", Answered: 4, Correct: 1},
+				{CardID: "card-3", Answered: 2, Correct: 0},
+			},
+			wantIDs: []string{"card-3", "card-4", "card-2", "card-1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := orderCards("deck", cards, tc.haveOrder, 0, tc.haveStats)
+
+			gotIDs := make([]string, len(got))
+			for i, card := range got {
+				assert.Equal(t, "deck", card.DeckID)
+				gotIDs[i] = card.CardID
+			}
+			assert.Equal(t, tc.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestOrderCards_Shuffled(t *testing.T) {
+	cards := make([]models.Card, 20)
+	for i := range cards {
+		cards[i] = models.Card{ID: string(rune('a' + i))}
+	}
+
+	first := orderCards("deck", cards, models.ShuffledCardOrder, 42, nil)
+	assert.Equal(t, first, orderCards("deck", cards, models.ShuffledCardOrder, 42, nil), "same seed should give the same order")
+	assert.NotEqual(t, first, orderCards("deck", cards, models.ShuffledCardOrder, 7, nil), "another seed should give another order")
+	assert.ElementsMatch(t, orderCards("deck", cards, models.DefaultCardOrder, 0, nil), first)
+}
+
+func TestInDirection(t *testing.T) {
+	queue := []models.SessionCard{
+		{CardID: "card-1", DeckID: "deck"},
+		{CardID: "card-2", DeckID: "deck"},
+	}
+
+	testCases := map[string]struct {
+		haveDirection models.StudyDirection
+		wantQueue     []models.SessionCard
+	}{
+		"forward keeps the front as the prompt": {
+			haveDirection: models.ForwardDirection,
+			wantQueue:     queue,
+		},
+		"reverse prompts with the back of every card": {
+			haveDirection: models.ReverseDirection,
+			wantQueue: []models.SessionCard{
+				{CardID: "card-1", DeckID: "deck", Reversed: true},
+				{CardID: "card-2", DeckID: "deck", Reversed: true},
+			},
+		},
+		"both studies every card forwards and then in reverse": {
+			haveDirection: models.BidirectionalDirection,
+			wantQueue: []models.SessionCard{
+				{CardID: "card-1", DeckID: "deck"},
+				{CardID: "card-2", DeckID: "deck"},
+				{CardID: "card-1", DeckID: "deck", Reversed: true},
+				{CardID: "card-2", DeckID: "deck", Reversed: true},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.wantQueue, inDirection(queue, tc.haveDirection))
+			assert.False(t, queue[0].Reversed, "the given queue should not be changed")
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package grading
+
+import "github.com/rmarken/reptr/service/internal/models"
+
+// GradeChoices grades the options picked for a multiple choice card. Picking exactly the correct options is graded
+// Good; missing a correct option or picking a wrong one is graded Again.
+func GradeChoices(options []models.CardOption, selected []string) models.Grade {
+	picked := make(map[string]bool, len(selected))
+	for _, id := range selected {
+		picked[id] = true
+	}
+
+	for _, option := range options {
+		if option.IsCorrect != picked[option.ID] {
+			return models.GradeAgain
+		}
+		delete(picked, option.ID)
+	}
+	// Anything left was not one of the card's options.
+	if len(picked) > 0 {
+		return models.GradeAgain
+	}
+	return models.GradeGood
+}
@@ -0,0 +1,175 @@
+package grading
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode"
+)
+
+// MaxTypoRatio is the share of the expected answer's characters that can be mistyped for an answer to still count
+// as recalled. Short answers must therefore be typed exactly.
+const MaxTypoRatio = 0.2
+
+// maxDiffCells bounds the work done diffing two answers; longer answers are shown as replaced outright.
+const maxDiffCells = 250_000
+
+type (
+	// Op is how a segment of a diff differs between the typed and the expected answer.
+	Op int
+
+	// Segment is a run of characters in a diff that share an Op.
+	Segment struct {
+		Op   Op
+		Text string
+	}
+
+	// Result is how a typed answer compares to the expected answer.
+	Result struct {
+		Grade    models.Grade
+		Typed    string
+		Expected string
+		// Distance is the number of characters that have to be changed to turn the typed answer into the expected
+		// one, after both are normalized.
+		Distance int
+		// Diff turns the normalized typed answer into the normalized expected answer.
+		Diff []Segment
+	}
+)
+
+const (
+	// Equal characters were typed as expected.
+	Equal Op = iota
+	// Missing characters are in the expected answer but were not typed.
+	Missing
+	// Extra characters were typed but are not in the expected answer.
+	Extra
+)
+
+var folder = cases.Fold()
+
+// Normalize prepares an answer for comparison: case and Unicode compatibility forms are folded, accents are dropped,
+// punctuation is removed and runs of whitespace are collapsed into a single space.
+func Normalize(answer string) string {
+	decomposed := norm.NFKD.String(folder.String(answer))
+
+	var b strings.Builder
+	space := false
+	for _, r := range decomposed {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case unicode.IsSpace(r):
+			space = b.Len() > 0
+			continue
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			continue
+		}
+		if space {
+			b.WriteRune(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Grade compares a typed answer to the expected answer. Answers that match once normalized are graded Good, answers
+// within MaxTypoRatio of the expected answer are graded Hard and anything else is graded Again.
+func Grade(typed, expected string) Result {
+	t, e := []rune(Normalize(typed)), []rune(Normalize(expected))
+	result := Result{
+		Typed:    typed,
+		Expected: expected,
+		Distance: distance(t, e),
+		Diff:     diff(t, e),
+	}
+
+	switch {
+	case len(t) == 0:
+		result.Grade = models.GradeAgain
+	case result.Distance == 0:
+		result.Grade = models.GradeGood
+	case float64(result.Distance) <= MaxTypoRatio*float64(len(e)):
+		result.Grade = models.GradeHard
+	default:
+		result.Grade = models.GradeAgain
+	}
+	return result
+}
+
+// distance is the Levenshtein distance between a and b.
+func distance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// diff finds the characters typed and expected have in common using their longest common subsequence, and marks
+// the rest as extra or missing.
+func diff(typed, expected []rune) []Segment {
+	if len(typed)*len(expected) > maxDiffCells {
+		return appendSegment(appendSegment(nil, Extra, typed...), Missing, expected...)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of typed[i:] and expected[j:].
+	lcs := make([][]int, len(typed)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(expected)+1)
+	}
+	for i := len(typed) - 1; i >= 0; i-- {
+		for j := len(expected) - 1; j >= 0; j-- {
+			if typed[i] == expected[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var segments []Segment
+	i, j := 0, 0
+	for i < len(typed) && j < len(expected) {
+		switch {
+		case typed[i] == expected[j]:
+			segments = appendSegment(segments, Equal, typed[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			segments = appendSegment(segments, Extra, typed[i])
+			i++
+		default:
+			segments = appendSegment(segments, Missing, expected[j])
+			j++
+		}
+	}
+	segments = appendSegment(segments, Extra, typed[i:]...)
+	return appendSegment(segments, Missing, expected[j:]...)
+}
+
+// appendSegment adds the characters to the last segment when it has the same op, or starts a new segment otherwise.
+func appendSegment(segments []Segment, op Op, runes ...rune) []Segment {
+	if len(runes) == 0 {
+		return segments
+	}
+	if last := len(segments) - 1; last >= 0 && segments[last].Op == op {
+		segments[last].Text += string(runes)
+		return segments
+	}
+	return append(segments, Segment{Op: op, Text: string(runes)})
+}
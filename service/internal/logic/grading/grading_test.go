@@ -0,0 +1,169 @@
+package grading
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := map[string]struct {
+		have string
+		want string
+	}{
+		"case is folded": {
+			have: "Paris",
+			want: "paris",
+		},
+		"accents are dropped": {
+			have: "Crème Brûlée",
+			want: "creme brulee",
+		},
+		"punctuation is removed": {
+			have: "well, it's... fine!",
+			want: "well its fine",
+		},
+		"whitespace is collapsed and trimmed": {
+			have: "  the \t quick\n\nfox ",
+			want: "the quick fox",
+		},
+		"compatibility forms are folded": {
+			have: "ﬁve ①",
+			want: "five 1",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Normalize(tc.have))
+		})
+	}
+}
+
+func TestGrade(t *testing.T) {
+	testCases := map[string]struct {
+		typed        string
+		expected     string
+		wantGrade    models.Grade
+		wantDistance int
+		wantDiff     []Segment
+	}{
+		"exact answer is good": {
+			typed:     "Mitochondria",
+			expected:  "mitochondria",
+			wantGrade: models.GradeGood,
+			wantDiff:  []Segment{{Op: Equal, Text: "mitochondria"}},
+		},
+		"accents and punctuation do not count against the answer": {
+			typed:     "creme brulee",
+			expected:  "Crème brûlée!",
+			wantGrade: models.GradeGood,
+			wantDiff:  []Segment{{Op: Equal, Text: "creme brulee"}},
+		},
+		"small typo is hard": {
+			typed:        "mitocondria",
+			expected:     "mitochondria",
+			wantGrade:    models.GradeHard,
+			wantDistance: 1,
+			wantDiff: []Segment{
+				{Op: Equal, Text: "mitoc"},
+				{Op: Missing, Text: "h"},
+				{Op: Equal, Text: "ondria"},
+			},
+		},
+		"short answers must be exact": {
+			typed:        "cat",
+			expected:     "car",
+			wantGrade:    models.GradeAgain,
+			wantDistance: 1,
+			wantDiff: []Segment{
+				{Op: Equal, Text: "ca"},
+				{Op: Extra, Text: "t"},
+				{Op: Missing, Text: "r"},
+			},
+		},
+		"wrong answer is again": {
+			typed:        "nucleus",
+			expected:     "ribosome",
+			wantGrade:    models.GradeAgain,
+			wantDistance: 8,
+		},
+		"empty answer is again": {
+			typed:        "  ",
+			expected:     "ribosome",
+			wantGrade:    models.GradeAgain,
+			wantDistance: 8,
+			wantDiff:     []Segment{{Op: Missing, Text: "ribosome"}},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := Grade(tc.typed, tc.expected)
+
+			assert.Equal(t, tc.wantGrade, got.Grade)
+			assert.Equal(t, tc.wantDistance, got.Distance)
+			assert.Equal(t, tc.typed, got.Typed)
+			assert.Equal(t, tc.expected, got.Expected)
+			if tc.wantDiff != nil {
+				assert.Equal(t, tc.wantDiff, got.Diff)
+			}
+		})
+	}
+}
+
+func TestGrade_LongAnswersAreReplacedOutright(t *testing.T) {
+	long := make([]rune, 600)
+	for i := range long {
+		long[i] = 'a'
+	}
+	got := Grade(string(long)+"b", string(long)+"c")
+
+	assert.Equal(t, models.GradeHard, got.Grade)
+	assert.Equal(t, []Segment{{Op: Extra, Text: string(long) + "b"}, {Op: Missing, Text: string(long) + "c"}}, got.Diff)
+}
+
+func TestGradeChoices(t *testing.T) {
+	options := []models.CardOption{
+		{ID: "a", Text: "Mercury", IsCorrect: true},
+		{ID: "b", Text: "Venus"},
+		{ID: "c", Text: "Mars", IsCorrect: true},
+	}
+
+	testCases := map[string]struct {
+		selected []string
+		want     models.Grade
+	}{
+		"every correct option is good": {
+			selected: []string{"c", "a"},
+			want:     models.GradeGood,
+		},
+		"missing a correct option is again": {
+			selected: []string{"a"},
+			want:     models.GradeAgain,
+		},
+		"picking a wrong option is again": {
+			selected: []string{"a", "b", "c"},
+			want:     models.GradeAgain,
+		},
+		"picking nothing is again": {
+			want: models.GradeAgain,
+		},
+		"picking an unknown option is again": {
+			selected: []string{"a", "c", "z"},
+			want:     models.GradeAgain,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GradeChoices(options, tc.selected))
+		})
+	}
+}
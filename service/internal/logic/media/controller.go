@@ -0,0 +1,188 @@
+package media
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/rmarken/reptr/service/internal/database"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"io"
+	"net/http"
+	"time"
+)
+
+//go:generate mockgen -destination ./mocks/controller_mock.go -package media . Controller
+var _ Controller = &Logic{}
+
+// sniffLen is how much of an attachment is read to detect its content type.
+const sniffLen = 512
+
+type (
+	Controller interface {
+		Upload(ctx context.Context, username, filename string, content io.Reader) (models.Media, error)
+		Open(ctx context.Context, mediaID, username string) (models.Media, io.ReadCloser, error)
+		Delete(ctx context.Context, mediaID string) error
+	}
+
+	Logic struct {
+		logger zerolog.Logger
+		repo   database.Repository
+	}
+)
+
+func New(logger zerolog.Logger, repo database.Repository) *Logic {
+	log := logger.With().Str("module", "media logic").Logger()
+	return &Logic{
+		logger: log,
+		repo:   repo,
+	}
+}
+
+// Upload stores an attachment uploaded by the user. Its content type is detected from the content rather than
+// trusted from the upload, and must be one of the supported image or audio types. Content larger than
+// models.MaxMediaSize is rejected part way through, and nothing is kept of it.
+func (l *Logic) Upload(ctx context.Context, username, filename string, content io.Reader) (models.Media, error) {
+	logger := l.logger.With().Str("method", "Upload").Logger()
+	logger.Info().Msgf("uploading %s for %s", filename, username)
+
+	buffered := bufio.NewReaderSize(content, sniffLen)
+	head, err := buffered.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.Error().Err(err).Msg("while reading upload")
+		return models.Media{}, err
+	}
+	if len(head) == 0 {
+		return models.Media{}, ErrEmptyMedia
+	}
+
+	contentType := mediaType(http.DetectContentType(head))
+	if !models.IsMediaType(contentType) {
+		logger.Info().Msgf("rejecting %s with content type %s", filename, contentType)
+		return models.Media{}, ErrUnsupportedMediaType
+	}
+
+	media := models.Media{
+		ID:          uuid.NewString(),
+		Filename:    filename,
+		ContentType: contentType,
+		UploadedBy:  username,
+		UploadedAt:  time.Now().UTC(),
+	}
+	limited := &limitedReader{r: buffered, max: models.MaxMediaSize}
+	err = l.repo.UploadMedia(ctx, media, limited)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while uploading %s", filename)
+		return models.Media{}, err
+	}
+	media.Size = limited.read
+	return media, nil
+}
+
+// Open returns an attachment and a stream of its content, which the caller must close. Users can open what they
+// uploaded and what is on the cards of decks they built or can reach through a group; anything else is not found.
+func (l *Logic) Open(ctx context.Context, mediaID, username string) (models.Media, io.ReadCloser, error) {
+	logger := l.logger.With().Str("method", "Open").Logger()
+	logger.Info().Msgf("opening media %s for %s", mediaID, username)
+
+	media, content, err := l.repo.OpenMedia(ctx, mediaID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while opening media %s", mediaID)
+		return models.Media{}, nil, err
+	}
+	if media.UploadedBy == username {
+		return media, content, nil
+	}
+
+	ok, err := l.canReach(ctx, mediaID, username)
+	if err != nil || !ok {
+		content.Close()
+		if err != nil {
+			logger.Error().Err(err).Msgf("while checking %s can reach media %s", username, mediaID)
+			return models.Media{}, nil, err
+		}
+		logger.Error().Msgf("user %s cannot reach media %s", username, mediaID)
+		return models.Media{}, nil, database.ErrNoResults
+	}
+	return media, content, nil
+}
+
+// canReach reports whether the attachment is on a card of a deck the user built or can reach through a group.
+func (l *Logic) canReach(ctx context.Context, mediaID, username string) (bool, error) {
+	cards, err := l.repo.GetCardsByMediaID(ctx, mediaID)
+	if errors.Is(err, database.ErrNoResults) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	decks, err := l.repo.GetDecksForUser(ctx, username, time.Time{}, nil, 0, 0)
+	if err != nil && !errors.Is(err, database.ErrNoResults) {
+		return false, err
+	}
+	groups, err := l.repo.GetGroupsForUser(ctx, username, time.Time{}, nil, 0, 0)
+	if err != nil && !errors.Is(err, database.ErrNoResults) {
+		return false, err
+	}
+
+	reachable := make(map[string]bool)
+	for _, deck := range decks {
+		reachable[deck.ID] = true
+	}
+	for _, group := range groups {
+		for _, id := range group.DeckIDs {
+			reachable[id] = true
+		}
+	}
+	for _, card := range cards {
+		if reachable[card.DeckID] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Delete removes an attachment, such as one uploaded for a card that could not be created.
+func (l *Logic) Delete(ctx context.Context, mediaID string) error {
+	logger := l.logger.With().Str("method", "Delete").Logger()
+	logger.Info().Msgf("deleting media %s", mediaID)
+
+	err := l.repo.DeleteMedia(ctx, mediaID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while deleting media %s", mediaID)
+		return err
+	}
+	return nil
+}
+
+// mediaType maps sniffed content types onto the types attachments are served with.
+func mediaType(sniffed string) string {
+	switch sniffed {
+	case "application/ogg":
+		return "audio/ogg"
+	default:
+		return sniffed
+	}
+}
+
+// limitedReader reads at most max bytes, failing with ErrMediaTooLarge if there is more to read.
+type limitedReader struct {
+	r    io.Reader
+	max  int64
+	read int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	// Read one byte past the limit to find out whether there is more.
+	if left := l.max - l.read + 1; int64(len(p)) > left {
+		p = p[:left]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.max {
+		return 0, ErrMediaTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,235 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/rmarken/reptr/service/internal/database"
+	mocks "github.com/rmarken/reptr/service/internal/database/mocks"
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+var pngHeader = []byte("\x89PNG\x0D\x0A\x1A\x0A")
+
+func TestNew(t *testing.T) {
+	t.Run("should return instance of controller", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockRepository(ctrl)
+		c := New(zerolog.Nop(), repo)
+
+		assert.NotNil(t, c)
+	})
+}
+
+// readAll stands in for GridFS, which reads the whole upload and fails with the reader's error.
+func readAll(_ context.Context, _ models.Media, content io.Reader) error {
+	_, err := io.ReadAll(content)
+	return err
+}
+
+func TestLogic_Upload(t *testing.T) {
+	testCases := map[string]struct {
+		content         []byte
+		mockDB          func(mockDB *mocks.MockRepository)
+		wantContentType string
+		wantSize        int64
+		wantErr         error
+	}{
+		"should store an image with its sniffed content type": {
+			content: append(pngHeader, "image data"...),
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().UploadMedia(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, media models.Media, content io.Reader) error {
+						assert.Equal(t, "image/png", media.ContentType)
+						assert.Equal(t, "user", media.UploadedBy)
+						assert.Equal(t, "heart.png", media.Filename)
+						assert.NotEmpty(t, media.ID)
+						return readAll(ctx, media, content)
+					})
+			},
+			wantContentType: "image/png",
+			wantSize:        int64(len(pngHeader) + len("image data")),
+		},
+		"should store ogg as audio": {
+			content: []byte("OggS\x00rest of the audio"),
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().UploadMedia(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(readAll)
+			},
+			wantContentType: "audio/ogg",
+			wantSize:        int64(len("OggS\x00rest of the audio")),
+		},
+		"should reject content that is not an image or audio": {
+			content: []byte("<html><script>alert(1)</script></html>"),
+			wantErr: ErrUnsupportedMediaType,
+		},
+		"should reject empty content": {
+			wantErr: ErrEmptyMedia,
+		},
+		"should abort content larger than the limit": {
+			content: append(pngHeader, make([]byte, models.MaxMediaSize)...),
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().UploadMedia(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(readAll)
+			},
+			wantErr: ErrMediaTooLarge,
+		},
+		"should return error if storing fails": {
+			content: append(pngHeader, "image data"...),
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().UploadMedia(gomock.Any(), gomock.Any(), gomock.Any()).Return(database.ErrInsert)
+			},
+			wantErr: database.ErrInsert,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := mocks.NewMockRepository(ctrl)
+			if tc.mockDB != nil {
+				tc.mockDB(mockDB)
+			}
+
+			l := New(zerolog.Nop(), mockDB)
+			got, err := l.Upload(context.Background(), "user", "heart.png", bytes.NewReader(tc.content))
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantContentType, got.ContentType)
+			assert.Equal(t, tc.wantSize, got.Size)
+		})
+	}
+}
+
+func TestLogic_Open(t *testing.T) {
+	var (
+		username = "hello"
+		media    = models.Media{ID: "media-id", ContentType: "image/png", UploadedBy: "someone"}
+	)
+	testCases := map[string]struct {
+		mockDB    func(mockDB *mocks.MockRepository)
+		wantMedia models.Media
+		wantErr   error
+	}{
+		"should return the media its uploader opens": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().OpenMedia(gomock.Any(), "media-id").Return(
+					models.Media{ID: "media-id", ContentType: "image/png", UploadedBy: username}, io.NopCloser(strings.NewReader("content")), nil)
+			},
+			wantMedia: models.Media{ID: "media-id", ContentType: "image/png", UploadedBy: username},
+		},
+		"should return the media on a card of a deck the user built": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().OpenMedia(gomock.Any(), "media-id").Return(media, io.NopCloser(strings.NewReader("content")), nil)
+				mockDB.EXPECT().GetCardsByMediaID(gomock.Any(), "media-id").Return([]models.Card{{ID: "card-id", DeckID: "deck-id"}}, nil)
+				mockDB.EXPECT().GetDecksForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return([]models.GetDeckResults{{ID: "deck-id"}}, nil)
+				mockDB.EXPECT().GetGroupsForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return(nil, database.ErrNoResults)
+			},
+			wantMedia: media,
+		},
+		"should return the media on a card of a deck of the user's group": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().OpenMedia(gomock.Any(), "media-id").Return(media, io.NopCloser(strings.NewReader("content")), nil)
+				mockDB.EXPECT().GetCardsByMediaID(gomock.Any(), "media-id").Return([]models.Card{{ID: "card-id", DeckID: "deck-id"}}, nil)
+				mockDB.EXPECT().GetDecksForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return(nil, database.ErrNoResults)
+				mockDB.EXPECT().GetGroupsForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return([]models.HomePageGroup{{DeckIDs: []string{"deck-id"}}}, nil)
+			},
+			wantMedia: media,
+		},
+		"should return ErrNoResults when the media is on a card of a deck the user cannot reach": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().OpenMedia(gomock.Any(), "media-id").Return(media, io.NopCloser(strings.NewReader("content")), nil)
+				mockDB.EXPECT().GetCardsByMediaID(gomock.Any(), "media-id").Return([]models.Card{{ID: "card-id", DeckID: "deck-id"}}, nil)
+				mockDB.EXPECT().GetDecksForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return([]models.GetDeckResults{{ID: "other-deck"}}, nil)
+				mockDB.EXPECT().GetGroupsForUser(gomock.Any(), username, time.Time{}, nil, 0, 0).Return(nil, database.ErrNoResults)
+			},
+			wantErr: database.ErrNoResults,
+		},
+		"should return ErrNoResults when someone else's media is on no card": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().OpenMedia(gomock.Any(), "media-id").Return(media, io.NopCloser(strings.NewReader("content")), nil)
+				mockDB.EXPECT().GetCardsByMediaID(gomock.Any(), "media-id").Return(nil, database.ErrNoResults)
+			},
+			wantErr: database.ErrNoResults,
+		},
+		"should return error if finding the media's cards fails": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().OpenMedia(gomock.Any(), "media-id").Return(media, io.NopCloser(strings.NewReader("content")), nil)
+				mockDB.EXPECT().GetCardsByMediaID(gomock.Any(), "media-id").Return(nil, errors.Join(errors.New("boom"), database.ErrFind))
+			},
+			wantErr: database.ErrFind,
+		},
+		"should return ErrNoResults when the media does not exist": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().OpenMedia(gomock.Any(), "media-id").Return(models.Media{}, nil, database.ErrNoResults)
+			},
+			wantErr: database.ErrNoResults,
+		},
+		"should return error if opening fails": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().OpenMedia(gomock.Any(), "media-id").Return(models.Media{}, nil, errors.Join(errors.New("boom"), database.ErrFind))
+			},
+			wantErr: database.ErrFind,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := mocks.NewMockRepository(ctrl)
+			tc.mockDB(mockDB)
+
+			l := New(zerolog.Nop(), mockDB)
+			got, content, err := l.Open(context.Background(), "media-id", username)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantMedia, got)
+			if tc.wantErr == nil {
+				assert.NotNil(t, content)
+			}
+		})
+	}
+}
+
+func TestLogic_Delete(t *testing.T) {
+	testCases := map[string]struct {
+		mockDB  func(mockDB *mocks.MockRepository)
+		wantErr error
+	}{
+		"should delete the media": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().DeleteMedia(gomock.Any(), "media-id").Return(nil)
+			},
+		},
+		"should return error if deleting fails": {
+			mockDB: func(mockDB *mocks.MockRepository) {
+				mockDB.EXPECT().DeleteMedia(gomock.Any(), "media-id").Return(errors.Join(errors.New("boom"), database.ErrDelete))
+			},
+			wantErr: database.ErrDelete,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := mocks.NewMockRepository(ctrl)
+			tc.mockDB(mockDB)
+
+			l := New(zerolog.Nop(), mockDB)
+			err := l.Delete(context.Background(), "media-id")
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
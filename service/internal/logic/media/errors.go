@@ -0,0 +1,13 @@
+package media
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rmarken/reptr/service/internal/models"
+)
+
+var (
+	ErrUnsupportedMediaType = errors.New("attachments must be PNG, JPEG, GIF or WebP images, or MP3, WAV or Ogg audio")
+	ErrMediaTooLarge        = fmt.Errorf("attachments cannot be larger than %d MiB", models.MaxMediaSize>>20)
+	ErrEmptyMedia           = errors.New("attachment is empty")
+)
@@ -0,0 +1,135 @@
+package scheduler
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"math"
+	"time"
+)
+
+const (
+	fsrsDecay  = -0.5
+	fsrsFactor = 19.0 / 81.0
+
+	fsrsMinDifficulty = 1.0
+	fsrsMaxDifficulty = 10.0
+	fsrsMaxInterval   = 36500
+)
+
+// fsrsWeights are the default FSRS-4.5 model parameters.
+var fsrsWeights = [17]float64{
+	0.4872, 1.4003, 3.7145, 13.8206, 5.1618, 1.2298, 0.8975, 0.031,
+	1.6474, 0.1367, 1.0461, 2.1072, 0.0793, 0.3246, 1.587, 0.2272, 2.8755,
+}
+
+var _ Scheduler = FSRS{}
+
+// FSRS schedules cards with the Free Spaced Repetition Scheduler. It models how well a card is
+// remembered with a stability (days until recall drops to 90%) and a difficulty between 1 and 10,
+// and picks the interval at which the probability of recall falls to the target retention.
+type FSRS struct {
+	targetRetention float64
+	w               [17]float64
+}
+
+// NewFSRS returns an FSRS scheduler aiming for the given probability of recall. Retentions outside
+// of (0, 1) fall back to models.DefaultTargetRetention.
+func NewFSRS(targetRetention float64) FSRS {
+	if targetRetention <= 0 || targetRetention >= 1 {
+		targetRetention = models.DefaultTargetRetention
+	}
+	return FSRS{
+		targetRetention: targetRetention,
+		w:               fsrsWeights,
+	}
+}
+
+// Schedule rates the answer with the grade, which FSRS scores from 1 (Again) to 4 (Easy).
+// Unknown grades are treated as Again.
+func (f FSRS) Schedule(state models.ReviewState, grade models.Grade, now time.Time) models.ReviewState {
+	rating := grade
+	if !rating.IsValid() {
+		rating = models.GradeAgain
+	}
+
+	if state.Stability == 0 || state.LastReviewedAt == nil {
+		state.Retrievability = 0
+		state.Stability = f.initialStability(rating)
+		state.Difficulty = f.initialDifficulty(rating)
+	} else {
+		elapsedDays := math.Max(0, now.Sub(*state.LastReviewedAt).Hours()/24)
+		retrievability := f.Retrievability(state.Stability, elapsedDays)
+
+		if rating == models.GradeAgain {
+			state.Stability = f.forgetStability(state.Difficulty, state.Stability, retrievability)
+		} else {
+			state.Stability = f.recallStability(state.Difficulty, state.Stability, retrievability, rating)
+		}
+		state.Difficulty = f.nextDifficulty(state.Difficulty, rating)
+		state.Retrievability = retrievability
+	}
+
+	if rating == models.GradeAgain {
+		if state.Repetitions > 0 {
+			state.Lapses++
+		}
+		state.Repetitions = 0
+	} else {
+		state.Repetitions++
+	}
+
+	state.Interval = f.interval(state.Stability)
+	state.DueAt = now.Add(time.Duration(state.Interval) * day)
+	state.LastReviewedAt = &now
+	return state
+}
+
+// Retrievability is the probability that a card with the given stability is recalled after elapsedDays.
+func (f FSRS) Retrievability(stability, elapsedDays float64) float64 {
+	return math.Pow(1+fsrsFactor*elapsedDays/stability, fsrsDecay)
+}
+
+func (f FSRS) interval(stability float64) int {
+	days := stability / fsrsFactor * (math.Pow(f.targetRetention, 1/fsrsDecay) - 1)
+	return int(math.Min(fsrsMaxInterval, math.Max(1, math.Round(days))))
+}
+
+func (f FSRS) initialStability(rating models.Grade) float64 {
+	return math.Max(f.w[rating-1], 0.1)
+}
+
+func (f FSRS) initialDifficulty(rating models.Grade) float64 {
+	return clampDifficulty(f.w[4] - float64(rating-3)*f.w[5])
+}
+
+func (f FSRS) nextDifficulty(difficulty float64, rating models.Grade) float64 {
+	next := difficulty - f.w[6]*float64(rating-3)
+	// Mean reversion towards the difficulty of a card first answered as easy keeps difficulty from sticking at the bounds.
+	return clampDifficulty(f.w[7]*f.initialDifficulty(models.GradeEasy) + (1-f.w[7])*next)
+}
+
+func (f FSRS) recallStability(difficulty, stability, retrievability float64, rating models.Grade) float64 {
+	hardPenalty, easyBonus := 1.0, 1.0
+	if rating == models.GradeHard {
+		hardPenalty = f.w[15]
+	}
+	if rating == models.GradeEasy {
+		easyBonus = f.w[16]
+	}
+	return stability * (1 + math.Exp(f.w[8])*
+		(11-difficulty)*
+		math.Pow(stability, -f.w[9])*
+		(math.Exp((1-retrievability)*f.w[10])-1)*
+		hardPenalty*
+		easyBonus)
+}
+
+func (f FSRS) forgetStability(difficulty, stability, retrievability float64) float64 {
+	return f.w[11] *
+		math.Pow(difficulty, -f.w[12]) *
+		(math.Pow(stability+1, f.w[13]) - 1) *
+		math.Exp((1-retrievability)*f.w[14])
+}
+
+func clampDifficulty(difficulty float64) float64 {
+	return math.Min(fsrsMaxDifficulty, math.Max(fsrsMinDifficulty, difficulty))
+}
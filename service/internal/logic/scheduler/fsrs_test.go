@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFSRS_Schedule(t *testing.T) {
+	var (
+		now          = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+		fourDaysAgo  = now.Add(-4 * day)
+		learnedState = models.ReviewState{
+			Stability:      fsrsWeights[2],
+			Difficulty:     fsrsWeights[4],
+			Interval:       4,
+			Repetitions:    1,
+			LastReviewedAt: &fourDaysAgo,
+		}
+	)
+
+	testCases := map[string]struct {
+		haveRetention   float64
+		haveState       models.ReviewState
+		grade           models.Grade
+		wantStability   float64
+		wantDifficulty  float64
+		wantInterval    int
+		wantRepetitions int
+		wantLapses      int
+	}{
+		"new card answered easy starts with the easy stability": {
+			haveState:       NewReviewState("id", "user", "deck", "card", now),
+			grade:           models.GradeEasy,
+			wantStability:   13.8206,
+			wantDifficulty:  3.9320,
+			wantInterval:    14,
+			wantRepetitions: 1,
+		},
+		"new card answered good starts with the good stability": {
+			haveState:       NewReviewState("id", "user", "deck", "card", now),
+			grade:           models.GradeGood,
+			wantStability:   3.7145,
+			wantDifficulty:  5.1618,
+			wantInterval:    4,
+			wantRepetitions: 1,
+		},
+		"new card answered again starts with the again stability": {
+			haveState:      NewReviewState("id", "user", "deck", "card", now),
+			grade:          models.GradeAgain,
+			wantStability:  0.4872,
+			wantDifficulty: 7.6214,
+			wantInterval:   1,
+		},
+		"higher target retention shortens the interval": {
+			haveRetention:   0.97,
+			haveState:       NewReviewState("id", "user", "deck", "card", now),
+			grade:           models.GradeGood,
+			wantStability:   3.7145,
+			wantDifficulty:  5.1618,
+			wantInterval:    1,
+			wantRepetitions: 1,
+		},
+		"recalled card grows its stability": {
+			haveState:       learnedState,
+			grade:           models.GradeGood,
+			wantStability:   14.8081,
+			wantDifficulty:  5.1237,
+			wantInterval:    15,
+			wantRepetitions: 2,
+		},
+		"hard answer grows stability less than good": {
+			haveState:       learnedState,
+			grade:           models.GradeHard,
+			wantStability:   6.2350,
+			wantDifficulty:  5.9934,
+			wantInterval:    6,
+			wantRepetitions: 2,
+		},
+		"easy answer grows stability more than good": {
+			haveState:       learnedState,
+			grade:           models.GradeEasy,
+			wantStability:   35.6141,
+			wantDifficulty:  4.2540,
+			wantInterval:    36,
+			wantRepetitions: 2,
+		},
+		"forgotten card loses stability and counts a lapse": {
+			haveState:      learnedState,
+			grade:          models.GradeAgain,
+			wantStability:  1.4332,
+			wantDifficulty: 6.8630,
+			wantInterval:   1,
+			wantLapses:     1,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := NewFSRS(tc.haveRetention).Schedule(tc.haveState, tc.grade, now)
+
+			assert.InDelta(t, tc.wantStability, got.Stability, 0.001)
+			assert.InDelta(t, tc.wantDifficulty, got.Difficulty, 0.001)
+			assert.Equal(t, tc.wantInterval, got.Interval)
+			assert.Equal(t, tc.wantRepetitions, got.Repetitions)
+			assert.Equal(t, tc.wantLapses, got.Lapses)
+			assert.Equal(t, now.Add(time.Duration(tc.wantInterval)*day), got.DueAt)
+		})
+	}
+}
+
+func TestFSRS_Retrievability(t *testing.T) {
+	f := NewFSRS(0)
+	assert.InDelta(t, 1.0, f.Retrievability(10, 0), 0.0001)
+	assert.InDelta(t, models.DefaultTargetRetention, f.Retrievability(10, 10), 0.0001)
+}
+
+func TestSelector_ForDeck(t *testing.T) {
+	selector := NewSelector()
+	assert.Equal(t, NewSM2(), selector.ForDeck(models.SchedulerSettings{}))
+	assert.Equal(t, NewSM2(), selector.ForDeck(models.SchedulerSettings{Algorithm: models.SM2Scheduler}))
+	assert.Equal(t, NewFSRS(0.85), selector.ForDeck(models.SchedulerSettings{Algorithm: models.FSRSScheduler, TargetRetention: 0.85}))
+}
@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"time"
+)
+
+type (
+	// Scheduler decides when a user should next see a card based on how they answered it.
+	Scheduler interface {
+		// Schedule returns the state that results from answering the card described by state at the given time.
+		Schedule(state models.ReviewState, grade models.Grade, now time.Time) models.ReviewState
+	}
+)
+
+const day = 24 * time.Hour
+
+// NewReviewState returns the state of a card that a user has never reviewed. It is due immediately.
+func NewReviewState(id, username, deckID, cardID string, now time.Time) models.ReviewState {
+	return models.ReviewState{
+		ID:        id,
+		Username:  username,
+		DeckID:    deckID,
+		CardID:    cardID,
+		DueAt:     now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+type (
+	// Selector picks the Scheduler a deck is configured to be studied with.
+	Selector interface {
+		ForDeck(settings models.SchedulerSettings) Scheduler
+	}
+
+	deckSelector struct{}
+)
+
+// NewSelector returns a Selector that honours each deck's scheduler settings, defaulting to SM-2.
+func NewSelector() Selector {
+	return deckSelector{}
+}
+
+func (deckSelector) ForDeck(settings models.SchedulerSettings) Scheduler {
+	switch settings.Algorithm {
+	case models.FSRSScheduler:
+		return NewFSRS(settings.TargetRetention)
+	default:
+		return NewSM2()
+	}
+}
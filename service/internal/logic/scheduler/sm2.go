@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"math"
+	"time"
+)
+
+const (
+	sm2InitialEase = 2.5
+	sm2MinimumEase = 1.3
+
+	// sm2PassingQuality is the lowest quality on the 0-5 scale of SM-2 that counts as recalled.
+	sm2PassingQuality = 3
+)
+
+var _ Scheduler = SM2{}
+
+// SM2 schedules cards with the SuperMemo 2 algorithm.
+type SM2 struct{}
+
+// NewSM2 returns an SM-2 scheduler.
+func NewSM2() SM2 {
+	return SM2{}
+}
+
+func (s SM2) Schedule(state models.ReviewState, grade models.Grade, now time.Time) models.ReviewState {
+	quality := sm2Quality(grade)
+
+	if state.Ease == 0 {
+		state.Ease = sm2InitialEase
+	}
+
+	if quality >= sm2PassingQuality {
+		switch state.Repetitions {
+		case 0:
+			state.Interval = 1
+		case 1:
+			state.Interval = 6
+		default:
+			state.Interval = int(math.Round(float64(state.Interval) * state.Ease))
+		}
+		state.Repetitions++
+	} else {
+		if state.Repetitions > 0 {
+			state.Lapses++
+		}
+		state.Repetitions = 0
+		state.Interval = 1
+	}
+
+	missed := float64(5 - quality)
+	state.Ease = math.Max(sm2MinimumEase, state.Ease+(0.1-missed*(0.08+missed*0.02)))
+
+	state.DueAt = now.Add(time.Duration(state.Interval) * day)
+	state.LastReviewedAt = &now
+	return state
+}
+
+// sm2Quality maps a grade onto the 0-5 quality scale of SM-2.
+func sm2Quality(grade models.Grade) int {
+	switch grade {
+	case models.GradeEasy:
+		return 5
+	case models.GradeGood:
+		return 4
+	case models.GradeHard:
+		return 3
+	default:
+		return 1
+	}
+}
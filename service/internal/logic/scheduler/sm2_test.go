@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSM2_Schedule(t *testing.T) {
+	var (
+		now = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	testCases := map[string]struct {
+		haveState       models.ReviewState
+		grade           models.Grade
+		wantInterval    int
+		wantRepetitions int
+		wantLapses      int
+		wantEase        float64
+	}{
+		"first correct answer on a new card is due tomorrow": {
+			haveState:       NewReviewState("id", "user", "deck", "card", now),
+			grade:           models.GradeGood,
+			wantInterval:    1,
+			wantRepetitions: 1,
+			wantEase:        2.5,
+		},
+		"second correct answer is due in six days": {
+			haveState:       models.ReviewState{Ease: 2.5, Interval: 1, Repetitions: 1},
+			grade:           models.GradeGood,
+			wantInterval:    6,
+			wantRepetitions: 2,
+			wantEase:        2.5,
+		},
+		"later correct answers multiply the interval by the ease": {
+			haveState:       models.ReviewState{Ease: 2.5, Interval: 6, Repetitions: 2},
+			grade:           models.GradeGood,
+			wantInterval:    15,
+			wantRepetitions: 3,
+			wantEase:        2.5,
+		},
+		"hard answer advances the interval and lowers the ease": {
+			haveState:       models.ReviewState{Ease: 2.5, Interval: 6, Repetitions: 2},
+			grade:           models.GradeHard,
+			wantInterval:    15,
+			wantRepetitions: 3,
+			wantEase:        2.36,
+		},
+		"easy answer raises the ease": {
+			haveState:       models.ReviewState{Ease: 2.5, Interval: 6, Repetitions: 2},
+			grade:           models.GradeEasy,
+			wantInterval:    15,
+			wantRepetitions: 3,
+			wantEase:        2.6,
+		},
+		"incorrect answer resets repetitions and counts a lapse": {
+			haveState:       models.ReviewState{Ease: 2.5, Interval: 15, Repetitions: 3},
+			grade:           models.GradeAgain,
+			wantInterval:    1,
+			wantRepetitions: 0,
+			wantLapses:      1,
+			wantEase:        1.96,
+		},
+		"incorrect answer on a new card is not a lapse": {
+			haveState:       NewReviewState("id", "user", "deck", "card", now),
+			grade:           models.GradeAgain,
+			wantInterval:    1,
+			wantRepetitions: 0,
+			wantEase:        1.96,
+		},
+		"ease never drops below the minimum": {
+			haveState:       models.ReviewState{Ease: 1.4, Interval: 1, Repetitions: 0},
+			grade:           models.GradeAgain,
+			wantInterval:    1,
+			wantRepetitions: 0,
+			wantEase:        sm2MinimumEase,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := NewSM2().Schedule(tc.haveState, tc.grade, now)
+
+			assert.Equal(t, tc.wantInterval, got.Interval)
+			assert.Equal(t, tc.wantRepetitions, got.Repetitions)
+			assert.Equal(t, tc.wantLapses, got.Lapses)
+			assert.InDelta(t, tc.wantEase, got.Ease, 0.0001)
+			assert.Equal(t, now.Add(time.Duration(tc.wantInterval)*day), got.DueAt)
+			assert.Equal(t, now, *got.LastReviewedAt)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package models
+
+// AnswerMode is how the learner answers a card. Self-graded answers are revealed and graded by the learner, typed
+// answers are typed in and graded against the card.
+type AnswerMode string
+
+const (
+	SelfGradedMode AnswerMode = ""
+	TypedMode      AnswerMode = "typed"
+)
+
+func (m AnswerMode) String() string {
+	return string(m)
+}
+
+// Label is the text shown to the learner for the mode.
+func (m AnswerMode) Label() string {
+	switch m {
+	case TypedMode:
+		return "Typed Answers"
+	default:
+		return "Self Graded"
+	}
+}
+
+// IsValid reports whether the mode is one of the supported modes.
+func (m AnswerMode) IsValid() bool {
+	switch m {
+	case SelfGradedMode, TypedMode:
+		return true
+	default:
+		return false
+	}
+}
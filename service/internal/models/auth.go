@@ -27,4 +27,4 @@ func (ru RegistrationUser) IsZero() bool {
 }
 func (re RegistrationError) IsZero() bool {
 	return re.Name == "" && re.Code == "" && re.Description == "" && re.StatusCode == 0
-}
\ No newline at end of file
+}
@@ -13,16 +13,42 @@ type (
 		CreatedAt time.Time `bson:"created_at,omitempty"`
 		UpdatedAt time.Time `bson:"update_at,omitempty"`
 		CreatedBy string    `bson:"created_by,omitempty"`
+		// Tags slice a deck into topics that can be studied on their own.
+		Tags []string `bson:"tags,omitempty"`
+		// Options are the choices of a MultipleChoice card, one or more of which are correct.
+		Options []CardOption `bson:"options,omitempty"`
+		// Cloze cards are made from a note, one for each deletion index. NoteID links the cards of a note and Note is
+		// its source text; Front and Back are rendered from it with the card's deletion hidden and revealed.
+		NoteID     string `bson:"note_id,omitempty"`
+		Note       string `bson:"note,omitempty"`
+		ClozeIndex int    `bson:"cloze_index,omitempty"`
+		// FrontMedia and BackMedia are the images and audio attached to each side of the card.
+		FrontMedia []MediaRef `bson:"front_media,omitempty"`
+		BackMedia  []MediaRef `bson:"back_media,omitempty"`
+		// Occlusion is the diagram of an ImageOcclusion card, with the mask the card hides.
+		Occlusion *Occlusion `bson:"occlusion,omitempty"`
+	}
+
+	// CardOption is one of the choices of a MultipleChoice card.
+	CardOption struct {
+		ID        string `bson:"id"`
+		Text      string `bson:"text"`
+		IsCorrect bool   `bson:"is_correct"`
 	}
 
 	FrontOfCard struct {
-		DeckID       string `bson:"deck_id"`
-		CardID       string `bson:"card_id"`
-		Content      string `bson:"content"`
-		PreviousCard string `bson:"previous_card"`
-		NextCard     string `bson:"next_card"`
-		Upvotes      int    `bson:"upvotes"`
-		Downvotes    int    `bson:"downvotes"`
+		DeckID       string       `bson:"deck_id"`
+		CardID       string       `bson:"card_id"`
+		Reversed     bool         `bson:"reversed"`
+		Content      string       `bson:"content"`
+		Kind         Type         `bson:"type"`
+		Options      []CardOption `bson:"options,omitempty"`
+		Media        []MediaRef   `bson:"media,omitempty"`
+		Occlusion    *Occlusion   `bson:"occlusion,omitempty"`
+		PreviousCard string       `bson:"previous_card"`
+		NextCard     string       `bson:"next_card"`
+		Upvotes      int          `bson:"upvotes"`
+		Downvotes    int          `bson:"downvotes"`
 	}
 
 	IsUpvotedByUser   bool
@@ -31,17 +57,28 @@ type (
 	BackOfCard struct {
 		DeckID            string            `bson:"deck_id"`
 		CardID            string            `bson:"card_id"`
+		Reversed          bool              `bson:"reversed"`
 		Answer            string            `bson:"answer"`
+		Kind              Type              `bson:"type"`
+		Options           []CardOption      `bson:"options,omitempty"`
+		Note              string            `bson:"note,omitempty"`
+		ClozeIndex        int               `bson:"cloze_index,omitempty"`
+		Media             []MediaRef        `bson:"media,omitempty"`
+		Occlusion         *Occlusion        `bson:"occlusion,omitempty"`
 		NextCard          string            `bson:"next_card"`
 		PreviousCard      string            `bson:"previous_card"`
 		IsUpvotedByUser   IsUpvotedByUser   `bson:"is_upvoted_by_user"`
 		IsDownvotedByUser isDownvotedByUser `bson:"is_downvoted_by_user"`
+		Upvotes           int               `bson:"upvotes"`
+		Downvotes         int               `bson:"downvotes"`
 	}
 )
 
 const (
-	BasicCard = iota
+	BasicCard Type = iota
 	MultipleChoice
+	Cloze
+	ImageOcclusion
 )
 
 func (c Type) String() string {
@@ -50,6 +87,10 @@ func (c Type) String() string {
 		return "basic"
 	case MultipleChoice:
 		return "multiple choice"
+	case Cloze:
+		return "cloze"
+	case ImageOcclusion:
+		return "image occlusion"
 	}
 	return "unknown"
 }
@@ -79,3 +120,10 @@ func (i IsUpvotedByUser) NextVoteDirection() string {
 	}
 	return "downvote"
 }
+
+func (i isDownvotedByUser) NextDownvoteDirection() string {
+	if i {
+		return "remove_downvote"
+	}
+	return "downvote"
+}
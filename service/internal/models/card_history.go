@@ -0,0 +1,49 @@
+package models
+
+type (
+	// CardAnswerRecord is one answer a learner gave to a card, with the session it was given in.
+	CardAnswerRecord struct {
+		SessionID string     `bson:"session_id"`
+		DeckName  string     `bson:"deck_name"`
+		Answer    CardAnswer `bson:"answer"`
+	}
+
+	// CardHistory is every answer a learner has given to a card, newest first, with the card's votes.
+	CardHistory struct {
+		Card    Card
+		Votes   BackOfCard
+		Answers []CardAnswerRecord
+	}
+)
+
+// Correct is how many of the answers were correct.
+func (h CardHistory) Correct() int {
+	var correct int
+	for _, record := range h.Answers {
+		if record.Answer.IsCorrect {
+			correct++
+		}
+	}
+	return correct
+}
+
+// Accuracy is the share of answers that were correct. A card that has never been answered has an accuracy of 0.
+func (h CardHistory) Accuracy() float64 {
+	if len(h.Answers) == 0 {
+		return 0
+	}
+	return float64(h.Correct()) / float64(len(h.Answers))
+}
+
+// Streak is how many of the most recent answers in a row were correct. A single lucky answer shows as a streak of
+// one after a run of misses.
+func (h CardHistory) Streak() int {
+	var streak int
+	for _, record := range h.Answers {
+		if !record.Answer.IsCorrect {
+			break
+		}
+		streak++
+	}
+	return streak
+}
@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+type (
+	// HoldKind is how a learner keeps a card out of their own study sessions.
+	HoldKind string
+
+	// CardHold is a learner's own hold on a card. A suspended card is not studied again until it is unsuspended, and a
+	// buried card is skipped until BuriedUntil. Holds belong to the learner, so cards of shared decks are unchanged.
+	// A card the learner has failed too often is marked as a Leech, along with how many times they have failed it.
+	CardHold struct {
+		ID          string     `bson:"_id"`
+		Username    string     `bson:"username"`
+		DeckID      string     `bson:"deck_id"`
+		CardID      string     `bson:"card_id"`
+		Suspended   bool       `bson:"suspended"`
+		BuriedUntil *time.Time `bson:"buried_until,omitempty"`
+		Leech       bool       `bson:"leech,omitempty"`
+		Failures    int        `bson:"failures,omitempty"`
+		CreatedAt   time.Time  `bson:"created_at"`
+		UpdatedAt   time.Time  `bson:"updated_at"`
+	}
+
+	// HeldCard is a card with the learner's hold on it.
+	HeldCard struct {
+		Card Card
+		Hold CardHold
+	}
+
+	// LeechCount is how many learners a card is a leech for, and how often they have failed it between them.
+	LeechCount struct {
+		CardID   string `bson:"_id"`
+		Learners int    `bson:"learners"`
+		Failures int    `bson:"failures"`
+	}
+)
+
+const (
+	SuspendHold HoldKind = "suspend"
+	BuryHold    HoldKind = "bury"
+)
+
+// IsValid reports whether the hold is one a card can be put on.
+func (h HoldKind) IsValid() bool {
+	return h == SuspendHold || h == BuryHold
+}
+
+// IsHeld reports whether the card is kept out of study at the given time.
+func (h CardHold) IsHeld(now time.Time) bool {
+	return h.Suspended || (h.BuriedUntil != nil && h.BuriedUntil.After(now))
+}
+
+// BuriedUntil is when a card buried at the given time comes back: the start of the next day.
+func BuriedUntil(now time.Time) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, now.Location())
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"strings"
+)
+
+// IsChoice reports whether the card is answered by picking from its options. Multiple choice cards studied in
+// reverse prompt with the correct options instead, so they are answered like basic cards.
+func (f FrontOfCard) IsChoice() bool {
+	return f.Kind == MultipleChoice && !f.Reversed && len(f.Options) > 0
+}
+
+// IsChoice reports whether the card was answered by picking from its options.
+func (b BackOfCard) IsChoice() bool {
+	return b.Kind == MultipleChoice && !b.Reversed && len(b.Options) > 0
+}
+
+// ShuffleOptions returns the options in an order that is random for each session but stable within it, so a card
+// shows its options the same way however many times it is loaded in the session.
+func ShuffleOptions(options []CardOption, sessionID, cardID string) []CardOption {
+	h := fnv.New64a()
+	h.Write([]byte(sessionID))
+	h.Write([]byte(cardID))
+
+	shuffled := make([]CardOption, len(options))
+	copy(shuffled, options)
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// CorrectOptionsText joins the text of the correct options, which is used as the back of a multiple choice card.
+func CorrectOptionsText(options []CardOption) string {
+	var correct []string
+	for _, option := range options {
+		if option.IsCorrect {
+			correct = append(correct, option.Text)
+		}
+	}
+	return strings.Join(correct, ", ")
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// clozePattern matches a cloze deletion such as {{c1::mitochondria}}, optionally with a hint as in
+// {{c1::mitochondria::organelle}}.
+var clozePattern = regexp.MustCompile(`\{\{c(\d+)::(.*?)(?:::(.*?))?\}\}`)
+
+// ClozeSegment is a run of a cloze note. Deletions have an Index; the rest of the note has none.
+type ClozeSegment struct {
+	Text  string
+	Index int
+	Hint  string
+}
+
+// IsDeletion reports whether the segment is a cloze deletion rather than plain text.
+func (s ClozeSegment) IsDeletion() bool {
+	return s.Index > 0
+}
+
+// ParseCloze splits a cloze note into plain text and deletions.
+func ParseCloze(note string) []ClozeSegment {
+	var segments []ClozeSegment
+	last := 0
+	for _, match := range clozePattern.FindAllStringSubmatchIndex(note, -1) {
+		if match[0] > last {
+			segments = append(segments, ClozeSegment{Text: note[last:match[0]]})
+		}
+		index, err := strconv.Atoi(note[match[2]:match[3]])
+		if err != nil || index == 0 {
+			// Not a deletion we can make a card for, so it is kept as written.
+			segments = append(segments, ClozeSegment{Text: note[match[0]:match[1]]})
+		} else {
+			segment := ClozeSegment{Text: note[match[4]:match[5]], Index: index}
+			if match[6] >= 0 {
+				segment.Hint = note[match[6]:match[7]]
+			}
+			segments = append(segments, segment)
+		}
+		last = match[1]
+	}
+	if last < len(note) {
+		segments = append(segments, ClozeSegment{Text: note[last:]})
+	}
+	return segments
+}
+
+// ClozeIndexes returns the distinct deletion indexes of a cloze note in ascending order. A card is made for each.
+func ClozeIndexes(note string) []int {
+	seen := map[int]bool{}
+	var indexes []int
+	for _, segment := range ParseCloze(note) {
+		if segment.IsDeletion() && !seen[segment.Index] {
+			seen[segment.Index] = true
+			indexes = append(indexes, segment.Index)
+		}
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// ClozeAnswer joins the text of a note's deletions with the given index, which is what the card for that index
+// asks for.
+func ClozeAnswer(note string, index int) string {
+	var answers []string
+	for _, segment := range ParseCloze(note) {
+		if segment.Index == index {
+			answers = append(answers, segment.Text)
+		}
+	}
+	return strings.Join(answers, ", ")
+}
+
+// ClozeFront renders the note with the deletions of the given index hidden, showing their hint when they have one.
+// Every other deletion is shown as plain text.
+func ClozeFront(note string, index int) string {
+	var b strings.Builder
+	for _, segment := range ParseCloze(note) {
+		switch {
+		case segment.Index != index:
+			b.WriteString(segment.Text)
+		case segment.Hint != "":
+			b.WriteString("[" + segment.Hint + "]")
+		default:
+			b.WriteString("[...]")
+		}
+	}
+	return b.String()
+}
+
+// IsCloze reports whether the card is a deletion of a cloze note.
+func (b BackOfCard) IsCloze() bool {
+	return b.Kind == Cloze && b.Note != ""
+}
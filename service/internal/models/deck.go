@@ -6,13 +6,14 @@ import (
 
 type (
 	Deck struct {
-		ID           string    `bson:"_id"`
-		Name         string    `bson:"name"`
-		UserUpvote   []string  `bson:"user_upvotes"`
-		UserDownvote []string  `bson:"user_downvotes"`
-		CreatedAt    time.Time `bson:"created_at"`
-		CreatedBy    string    `bson:"created_by"`
-		UpdatedAt    time.Time `bson:"updated_at"`
+		ID                string    `bson:"_id"`
+		Name              string    `bson:"name"`
+		UserUpvote        []string  `bson:"user_upvotes"`
+		UserDownvote      []string  `bson:"user_downvotes"`
+		CreatedAt         time.Time `bson:"created_at"`
+		CreatedBy         string    `bson:"created_by"`
+		UpdatedAt         time.Time `bson:"updated_at"`
+		SchedulerSettings `bson:",inline"`
 	}
 
 	GetDeckResults struct {
@@ -30,32 +31,198 @@ type (
 		Cards          []Card
 	}
 
+	// SessionKind distinguishes sessions over a single deck from sessions that study a queue of cards.
+	SessionKind string
+
 	DeckSession struct {
-		ID            string       `bson:"_id"`
-		Username      string       `bson:"username"`
-		DeckID        string       `bson:"deck_id"`
-		DeckName      string       `bson:"deck_name"`
-		CurrentCardID string       `bson:"current_card_id"`
-		IsFront       bool         `bson:"is_front"`
-		FinishedAt    *time.Time   `bson:"finished_at"`
-		CardAnswers   []CardAnswer `bson:"card_answers"`
-		CreatedAt     time.Time    `bson:"created_at"`
-		UpdatedAt     time.Time    `bson:"updated_at"`
+		ID            string         `bson:"_id"`
+		Kind          SessionKind    `bson:"kind,omitempty"`
+		Username      string         `bson:"username"`
+		DeckID        string         `bson:"deck_id"`
+		DeckName      string         `bson:"deck_name"`
+		CurrentCardID string         `bson:"current_card_id"`
+		IsFront       bool           `bson:"is_front"`
+		IsReversed    bool           `bson:"is_reversed,omitempty"`
+		FinishedAt    *time.Time     `bson:"finished_at"`
+		AbandonedAt   *time.Time     `bson:"abandoned_at,omitempty"`
+		CardAnswers   []CardAnswer   `bson:"card_answers"`
+		Queue         []SessionCard  `bson:"queue,omitempty"`
+		RetryOf       string         `bson:"retry_of,omitempty"`
+		Order         CardOrder      `bson:"order,omitempty"`
+		Seed          int64          `bson:"seed,omitempty"`
+		Direction     StudyDirection `bson:"direction,omitempty"`
+		Mode          AnswerMode     `bson:"mode,omitempty"`
+		// Tags limits the session to cards with every one of these tags.
+		Tags         []string   `bson:"tags,omitempty"`
+		FrontShownAt *time.Time `bson:"front_shown_at,omitempty"`
+		BackShownAt  *time.Time `bson:"back_shown_at,omitempty"`
+		CreatedAt    time.Time  `bson:"created_at"`
+		UpdatedAt    time.Time  `bson:"updated_at"`
+	}
+
+	// SessionCard is a card queued for study in a session, along with the deck it belongs to.
+	SessionCard struct {
+		CardID   string `bson:"card_id"`
+		DeckID   string `bson:"deck_id"`
+		Reversed bool   `bson:"reversed,omitempty"`
 	}
 
 	CardAnswer struct {
-		CardID    string    `bson:"card_id"`
-		IsCorrect bool      `bson:"is_correct"`
-		CreatedAt time.Time `bson:"created_at"`
-		UpdatedAt time.Time `bson:"updated_at"`
+		CardID    string        `bson:"card_id"`
+		IsCorrect bool          `bson:"is_correct"`
+		Reversed  bool          `bson:"reversed,omitempty"`
+		Grade     Grade         `bson:"grade,omitempty"`
+		Latency   AnswerLatency `bson:",inline"`
+		CreatedAt time.Time     `bson:"created_at"`
+		UpdatedAt time.Time     `bson:"updated_at"`
 	}
 
 	SessionUpdate struct {
-		ID                string
-		CurrentCardID     string
-		NewCardID         string
-		IsFront           bool
-		IsAnsweredCorrect bool
-		IsLastCard        bool
+		ID            string
+		CurrentCardID string
+		NewCardID     string
+		IsReversed    bool
+		NewReversed   bool
+		IsFront       bool
+		Grade         Grade
+		Latency       AnswerLatency
+		IsLastCard    bool
 	}
 )
+
+const (
+	DeckSessionKind   SessionKind = ""
+	ReviewSessionKind SessionKind = "review"
+	RetrySessionKind  SessionKind = "retry"
+	TagSessionKind    SessionKind = "tag"
+)
+
+// HasQueue reports whether the session studies a fixed queue of cards rather than walking a deck.
+func (s DeckSession) HasQueue() bool {
+	return len(s.Queue) > 0
+}
+
+// DeckIDForCard returns the deck the card was studied from in this session.
+func (s DeckSession) DeckIDForCard(cardID string) string {
+	for _, card := range s.Queue {
+		if card.CardID == cardID {
+			return card.DeckID
+		}
+	}
+	return s.DeckID
+}
+
+// CurrentDeckID returns the deck of the card currently being studied.
+func (s DeckSession) CurrentDeckID() string {
+	return s.DeckIDForCard(s.CurrentCardID)
+}
+
+// studyItem identifies a card studied in one direction; bidirectional sessions study each card twice.
+type studyItem struct {
+	cardID   string
+	reversed bool
+}
+
+// NextInQueue returns the first queued card after the current card that has not been answered, skipping the cards
+// with the given IDs.
+func (s DeckSession) NextInQueue(skipCardIDs []string) (SessionCard, bool) {
+	answered := make(map[studyItem]bool, len(s.CardAnswers)+1)
+	for _, answer := range s.CardAnswers {
+		answered[studyItem{answer.CardID, answer.Reversed}] = true
+	}
+	answered[studyItem{s.CurrentCardID, s.IsReversed}] = true
+	skip := make(map[string]bool, len(skipCardIDs))
+	for _, id := range skipCardIDs {
+		skip[id] = true
+	}
+
+	for _, card := range s.Queue {
+		if !answered[studyItem{card.CardID, card.Reversed}] && !skip[card.CardID] {
+			return card, true
+		}
+	}
+	return SessionCard{}, false
+}
+
+// HasAnswered reports whether the card has been answered in the given direction in this session.
+func (s DeckSession) HasAnswered(cardID string, reversed bool) bool {
+	for _, answer := range s.CardAnswers {
+		if answer.CardID == cardID && answer.Reversed == reversed {
+			return true
+		}
+	}
+	return false
+}
+
+// MissedCards returns the answered cards that were not recalled, in the order they were answered.
+func (s DeckSession) MissedCards() []SessionCard {
+	var missed []SessionCard
+	for _, answer := range s.CardAnswers {
+		if answer.Grade.IsCorrect() {
+			continue
+		}
+		missed = append(missed, SessionCard{CardID: answer.CardID, DeckID: s.DeckIDForCard(answer.CardID), Reversed: answer.Reversed})
+	}
+	return missed
+}
+
+// Neighbours returns the queued cards before and after the given card studied in the given direction, if any.
+func (s DeckSession) Neighbours(cardID string, reversed bool) (previous, next SessionCard) {
+	for i, card := range s.Queue {
+		if card.CardID != cardID || card.Reversed != reversed {
+			continue
+		}
+		if i > 0 {
+			previous = s.Queue[i-1]
+		}
+		if i < len(s.Queue)-1 {
+			next = s.Queue[i+1]
+		}
+		break
+	}
+	return previous, next
+}
+
+// SessionSummary is the outcome of a finished study session.
+type SessionSummary struct {
+	SessionID   string
+	Kind        SessionKind
+	DeckID      string
+	DeckName    string
+	StartedAt   time.Time
+	FinishedAt  *time.Time
+	TotalCards  int
+	NumCorrect  int
+	TimeTaken   time.Duration
+	MissedCards []Card
+	// AverageLatency is the mean latency of the session's answers; AnsweredCards has the latency of each.
+	AverageLatency AnswerLatency
+	AnsweredCards  []AnsweredCard
+}
+
+// Summary scores the session's answers. Missed cards are left for the caller to look up.
+func (s DeckSession) Summary() SessionSummary {
+	summary := SessionSummary{
+		SessionID:  s.ID,
+		Kind:       s.Kind,
+		DeckID:     s.DeckID,
+		DeckName:   s.DeckName,
+		StartedAt:  s.CreatedAt,
+		FinishedAt: s.FinishedAt,
+		TotalCards: len(s.CardAnswers),
+	}
+	summary.NumCorrect = summary.TotalCards - len(s.MissedCards())
+	if s.FinishedAt != nil {
+		summary.TimeTaken = s.FinishedAt.Sub(s.CreatedAt)
+	}
+	summary.AverageLatency = s.AverageLatency()
+	return summary
+}
+
+// PercentCorrect is the share of answered cards that were recalled, rounded down.
+func (s SessionSummary) PercentCorrect() int {
+	if s.TotalCards == 0 {
+		return 0
+	}
+	return s.NumCorrect * 100 / s.TotalCards
+}
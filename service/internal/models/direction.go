@@ -0,0 +1,42 @@
+package models
+
+// StudyDirection is which side of a card is shown as the prompt. Forward shows the front and asks for the back,
+// reverse shows the back and asks for the front, and bidirectional studies every card both ways.
+type StudyDirection string
+
+const (
+	ForwardDirection       StudyDirection = ""
+	ReverseDirection       StudyDirection = "reverse"
+	BidirectionalDirection StudyDirection = "both"
+)
+
+func (d StudyDirection) String() string {
+	return string(d)
+}
+
+// Label is the text shown to the learner for the direction.
+func (d StudyDirection) Label() string {
+	switch d {
+	case ReverseDirection:
+		return "Reverse"
+	case BidirectionalDirection:
+		return "Both Directions"
+	default:
+		return "Forward"
+	}
+}
+
+// IsValid reports whether the direction is one of the supported directions.
+func (d StudyDirection) IsValid() bool {
+	switch d {
+	case ForwardDirection, ReverseDirection, BidirectionalDirection:
+		return true
+	default:
+		return false
+	}
+}
+
+// StudyDirections are the directions a learner can choose from when starting a session.
+func StudyDirections() []StudyDirection {
+	return []StudyDirection{ForwardDirection, ReverseDirection, BidirectionalDirection}
+}
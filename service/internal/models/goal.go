@@ -0,0 +1,119 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+type (
+	// GoalKind is what a daily goal counts.
+	GoalKind string
+
+	// DailyGoal is how much a learner means to study each day. A goal with no target is no goal.
+	DailyGoal struct {
+		Kind   GoalKind `bson:"kind,omitempty"`
+		Target int      `bson:"target,omitempty"`
+	}
+
+	// StudyPreferences are the study options a learner can choose. TimeZone is the IANA name of the zone their days
+	// start and end in; learners without one study in UTC.
+	StudyPreferences struct {
+		TimeZone  string    `bson:"time_zone,omitempty"`
+		DailyGoal DailyGoal `bson:"daily_goal,omitempty"`
+	}
+
+	// Streak is how many days in a row a learner has studied. Current runs up to today, or to yesterday while they
+	// have yet to study today; Longest is the most they have ever studied in a row.
+	Streak struct {
+		Current int
+		Longest int
+	}
+
+	// StudyProgress is how a learner's study is going today: how much of their goal they have done and their streak.
+	StudyProgress struct {
+		Goal   DailyGoal
+		Done   int
+		Streak Streak
+	}
+)
+
+const (
+	GoalCards   GoalKind = "cards"
+	GoalMinutes GoalKind = "minutes"
+
+	// MaxGoalTarget caps goals at a full day of minutes.
+	MaxGoalTarget = 24 * 60
+)
+
+func (k GoalKind) String() string {
+	switch k {
+	case GoalCards:
+		return "cards reviewed"
+	case GoalMinutes:
+		return "minutes studied"
+	}
+	return "unknown"
+}
+
+// IsSet reports whether the learner has a goal.
+func (g DailyGoal) IsSet() bool {
+	return g.Target > 0
+}
+
+// Location is the time zone the learner's days are counted in, UTC when they have not chosen one or it is unknown.
+func (p StudyPreferences) Location() *time.Location {
+	if p.TimeZone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(p.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// GoalMet reports whether the learner has done their goal for today.
+func (p StudyProgress) GoalMet() bool {
+	return p.Goal.IsSet() && p.Done >= p.Goal.Target
+}
+
+// PercentOfGoal is how much of today's goal is done, up to 100.
+func (p StudyProgress) PercentOfGoal() int {
+	if !p.Goal.IsSet() {
+		return 0
+	}
+	return min(100, p.Done*100/p.Goal.Target)
+}
+
+// StreakOf counts the runs of consecutive days studied. The current run is broken once a whole day passes without
+// study, so it still counts before the learner has studied today. Days are written in StatsDayFormat.
+func StreakOf(days []string, today string) Streak {
+	days = slices.Clone(days)
+	slices.Sort(days)
+	days = slices.Compact(days)
+
+	var (
+		s    Streak
+		run  int
+		last time.Time
+	)
+	for _, day := range days {
+		date, err := time.Parse(StatsDayFormat, day)
+		if err != nil {
+			continue
+		}
+		if run > 0 && date.Equal(last.AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+		last = date
+		s.Longest = max(s.Longest, run)
+	}
+
+	todayDate, err := time.Parse(StatsDayFormat, today)
+	if err == nil && run > 0 && !last.Before(todayDate.AddDate(0, 0, -1)) {
+		s.Current = run
+	}
+	return s
+}
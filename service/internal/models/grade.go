@@ -0,0 +1,101 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"strings"
+)
+
+// Grade is how well a learner recalled a card, from forgotten (Again) to effortless (Easy).
+type Grade int
+
+const (
+	UnknownGrade Grade = iota
+	GradeAgain
+	GradeHard
+	GradeGood
+	GradeEasy
+)
+
+func (g Grade) String() string {
+	switch g {
+	case GradeAgain:
+		return "again"
+	case GradeHard:
+		return "hard"
+	case GradeGood:
+		return "good"
+	case GradeEasy:
+		return "easy"
+	default:
+		return "unknown"
+	}
+}
+
+// Label is the text shown to the learner for the grade.
+func (g Grade) Label() string {
+	switch g {
+	case GradeAgain:
+		return "Again"
+	case GradeHard:
+		return "Hard"
+	case GradeGood:
+		return "Good"
+	case GradeEasy:
+		return "Easy"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsValid reports whether the grade is one of Again, Hard, Good or Easy.
+func (g Grade) IsValid() bool {
+	return g >= GradeAgain && g <= GradeEasy
+}
+
+// IsCorrect reports whether the card was recalled at all.
+func (g Grade) IsCorrect() bool {
+	return g >= GradeHard && g <= GradeEasy
+}
+
+// Grades are the grades a learner can give a card, in ascending order.
+func Grades() []Grade {
+	return []Grade{GradeAgain, GradeHard, GradeGood, GradeEasy}
+}
+
+func GradeFromString(grade string) Grade {
+	grade = strings.ToLower(grade)
+	switch grade {
+	case "again":
+		return GradeAgain
+	case "hard":
+		return GradeHard
+	case "good":
+		return GradeGood
+	case "easy":
+		return GradeEasy
+	default:
+		return UnknownGrade
+	}
+}
+
+// GradeFromCorrect maps a binary answer onto a grade.
+func GradeFromCorrect(isCorrect bool) Grade {
+	if isCorrect {
+		return GradeGood
+	}
+	return GradeAgain
+}
+
+// UnmarshalBSON decodes a card answer, deriving its grade from is_correct for answers recorded before grading existed.
+func (c *CardAnswer) UnmarshalBSON(data []byte) error {
+	type cardAnswer CardAnswer
+	var answer cardAnswer
+	if err := bson.Unmarshal(data, &answer); err != nil {
+		return err
+	}
+	if !answer.Grade.IsValid() {
+		answer.Grade = GradeFromCorrect(answer.IsCorrect)
+	}
+	*c = CardAnswer(answer)
+	return nil
+}
@@ -4,5 +4,8 @@ type (
 	HomePageData struct {
 		Groups []HomePageGroup
 		Decks  []GetDeckResults
+		// DueByDeck is the number of cards due today in each deck the user can study.
+		DueByDeck map[string]int
+		NumDue    int
 	}
 )
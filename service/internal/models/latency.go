@@ -0,0 +1,86 @@
+package models
+
+import "time"
+
+// AnswerLatency is how long a card was studied before it was answered. Answers recorded before latency was
+// captured have no latency.
+type AnswerLatency struct {
+	// Reveal is how long the front was shown before the back was revealed.
+	Reveal time.Duration `bson:"reveal_latency,omitempty"`
+	// Answer is how long the front was shown before the answer was graded.
+	Answer time.Duration `bson:"answer_latency,omitempty"`
+}
+
+// LatencyAt measures how long the session's current card has been studied when it is answered at now. Latency is
+// only measured from the server's record of when the front and back were shown.
+func (s DeckSession) LatencyAt(now time.Time) AnswerLatency {
+	if s.FrontShownAt == nil {
+		return AnswerLatency{}
+	}
+	latency := AnswerLatency{Answer: now.Sub(*s.FrontShownAt)}
+	if s.BackShownAt != nil && !s.BackShownAt.Before(*s.FrontShownAt) {
+		latency.Reveal = s.BackShownAt.Sub(*s.FrontShownAt)
+	}
+	return latency
+}
+
+// AverageLatency is the mean latency of the timed answers. Reveals and answers are averaged separately since an
+// answer may have been timed without its reveal.
+func (s CardAnswerStats) AverageLatency() AnswerLatency {
+	var latency AnswerLatency
+	if s.RevealsTimed > 0 {
+		latency.Reveal = s.TotalRevealLatency / time.Duration(s.RevealsTimed)
+	}
+	if s.AnswersTimed > 0 {
+		latency.Answer = s.TotalAnswerLatency / time.Duration(s.AnswersTimed)
+	}
+	return latency
+}
+
+// AverageLatency is the mean latency of the timed answers in the session.
+func (s DeckSession) AverageLatency() AnswerLatency {
+	var stats CardAnswerStats
+	for _, answer := range s.CardAnswers {
+		if answer.Latency.Reveal > 0 {
+			stats.RevealsTimed++
+			stats.TotalRevealLatency += answer.Latency.Reveal
+		}
+		if answer.Latency.Answer > 0 {
+			stats.AnswersTimed++
+			stats.TotalAnswerLatency += answer.Latency.Answer
+		}
+	}
+	return stats.AverageLatency()
+}
+
+type (
+	// AnsweredCard is a card answered in a session, with how long it took and how long the user takes on average.
+	AnsweredCard struct {
+		Card      Card
+		Reversed  bool
+		IsCorrect bool
+		Latency   AnswerLatency
+		Average   AnswerLatency
+	}
+
+	// CardStats is how every learner has answered a card.
+	CardStats struct {
+		Card  Card
+		Stats CardAnswerStats
+	}
+
+	// CardLeech is a card of a deck that is a leech for some of its learners.
+	CardLeech struct {
+		Card       Card
+		LeechCount LeechCount
+	}
+
+	// DeckStats is how every learner has answered each card of a deck, and which cards are leeches for the most
+	// learners.
+	DeckStats struct {
+		DeckID   string
+		DeckName string
+		Cards    []CardStats
+		Leeches  []CardLeech
+	}
+)
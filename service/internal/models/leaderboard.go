@@ -0,0 +1,146 @@
+package models
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+type (
+	// LeaderboardWindow is how far back a leaderboard counts study.
+	LeaderboardWindow string
+
+	// LeaderboardMetric is what a leaderboard ranks a group's members by.
+	LeaderboardMetric string
+
+	// LeaderboardEntry is a member's study of their group's decks over a leaderboard's window. StudyDays are the days
+	// they answered a card on in their TimeZone, and Streak is how many of those run up to their today, so it matches
+	// the streak on their home page.
+	LeaderboardEntry struct {
+		Username  string   `bson:"username"`
+		TimeZone  string   `bson:"time_zone,omitempty"`
+		Reviews   int      `bson:"reviews"`
+		Correct   int      `bson:"correct"`
+		StudyDays []string `bson:"study_days,omitempty"`
+		Streak    int      `bson:"streak"`
+	}
+
+	// Leaderboard is a rollup of the study of a group's members over a window. Rollups are cached, so ComputedAt is
+	// when the sessions were last counted.
+	Leaderboard struct {
+		GroupID    string             `bson:"group_id"`
+		Window     LeaderboardWindow  `bson:"window"`
+		ComputedAt time.Time          `bson:"computed_at"`
+		Entries    []LeaderboardEntry `bson:"entries"`
+	}
+)
+
+const (
+	WeeklyLeaderboard  LeaderboardWindow = "week"
+	MonthlyLeaderboard LeaderboardWindow = "month"
+	AllTimeLeaderboard LeaderboardWindow = "all"
+
+	RankByReviews  LeaderboardMetric = "reviews"
+	RankByAccuracy LeaderboardMetric = "accuracy"
+	RankByStreak   LeaderboardMetric = "streak"
+)
+
+// IsValid reports whether the window is one of the supported windows.
+func (w LeaderboardWindow) IsValid() bool {
+	switch w {
+	case WeeklyLeaderboard, MonthlyLeaderboard, AllTimeLeaderboard:
+		return true
+	default:
+		return false
+	}
+}
+
+// Label is the text shown to members for the window.
+func (w LeaderboardWindow) Label() string {
+	switch w {
+	case WeeklyLeaderboard:
+		return "This Week"
+	case MonthlyLeaderboard:
+		return "This Month"
+	default:
+		return "All Time"
+	}
+}
+
+// Start is the UTC midnight the window begins at: the last 7 or 30 days including today, or the zero time for all
+// time.
+func (w LeaderboardWindow) Start(now time.Time) time.Time {
+	year, month, day := now.UTC().Date()
+	switch w {
+	case WeeklyLeaderboard:
+		return time.Date(year, month, day-6, 0, 0, 0, 0, time.UTC)
+	case MonthlyLeaderboard:
+		return time.Date(year, month, day-29, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Time{}
+	}
+}
+
+// LeaderboardWindows are the windows a member can choose between.
+func LeaderboardWindows() []LeaderboardWindow {
+	return []LeaderboardWindow{WeeklyLeaderboard, MonthlyLeaderboard, AllTimeLeaderboard}
+}
+
+// IsValid reports whether the metric is one of the supported metrics.
+func (m LeaderboardMetric) IsValid() bool {
+	switch m {
+	case RankByReviews, RankByAccuracy, RankByStreak:
+		return true
+	default:
+		return false
+	}
+}
+
+// Label is the text shown to members for the metric.
+func (m LeaderboardMetric) Label() string {
+	switch m {
+	case RankByAccuracy:
+		return "Accuracy"
+	case RankByStreak:
+		return "Streak"
+	default:
+		return "Cards Reviewed"
+	}
+}
+
+// LeaderboardMetrics are the metrics a member can rank by.
+func LeaderboardMetrics() []LeaderboardMetric {
+	return []LeaderboardMetric{RankByReviews, RankByAccuracy, RankByStreak}
+}
+
+// PercentCorrect is the share of the member's answers that were correct, rounded down.
+func (e LeaderboardEntry) PercentCorrect() int {
+	if e.Reviews == 0 {
+		return 0
+	}
+	return e.Correct * 100 / e.Reviews
+}
+
+// RankBy orders the entries best first by the metric. Ties go to the member who reviewed more cards, then by
+// username so the order is stable.
+func (l *Leaderboard) RankBy(metric LeaderboardMetric) {
+	value := func(e LeaderboardEntry) int {
+		switch metric {
+		case RankByAccuracy:
+			return e.PercentCorrect()
+		case RankByStreak:
+			return e.Streak
+		default:
+			return e.Reviews
+		}
+	}
+	slices.SortStableFunc(l.Entries, func(a, b LeaderboardEntry) int {
+		if c := cmp.Compare(value(b), value(a)); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Reviews, a.Reviews); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Username, b.Username)
+	})
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+// MaxMediaSize is the largest attachment that can be uploaded, in bytes.
+const MaxMediaSize = 10 << 20
+
+type (
+	// Media is an image or audio file attached to cards. Its content is stored separately, in GridFS.
+	Media struct {
+		ID          string    `bson:"_id"`
+		Filename    string    `bson:"filename"`
+		ContentType string    `bson:"content_type"`
+		Size        int64     `bson:"size"`
+		UploadedBy  string    `bson:"uploaded_by"`
+		UploadedAt  time.Time `bson:"uploaded_at"`
+	}
+
+	// MediaRef is an attachment as referenced from a side of a card.
+	MediaRef struct {
+		ID          string `bson:"id"`
+		ContentType string `bson:"content_type"`
+	}
+)
+
+// mediaTypes are the content types attachments can have, as sniffed from their content.
+var mediaTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+	"audio/mpeg": true,
+	"audio/wave": true,
+	"audio/ogg":  true,
+}
+
+// IsMediaType reports whether attachments can have the content type.
+func IsMediaType(contentType string) bool {
+	return mediaTypes[contentType]
+}
+
+// Ref returns the reference cards keep to the attachment.
+func (m Media) Ref() MediaRef {
+	return MediaRef{ID: m.ID, ContentType: m.ContentType}
+}
+
+// IsAudio reports whether the attachment is played rather than shown.
+func (m MediaRef) IsAudio() bool {
+	return strings.HasPrefix(m.ContentType, "audio/")
+}
+
+// IsImage reports whether the attachment is shown as an image.
+func (m MediaRef) IsImage() bool {
+	return strings.HasPrefix(m.ContentType, "image/")
+}
+
+// URL is the path the attachment is served from.
+func (m MediaRef) URL() string {
+	return path.Join("/media/", m.ID)
+}
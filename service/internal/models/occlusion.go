@@ -0,0 +1,37 @@
+package models
+
+type (
+	// Occlusion is the diagram of an ImageOcclusion card. The cards made from one diagram share its image and masks;
+	// each hides the mask at its own Index.
+	Occlusion struct {
+		Image MediaRef `bson:"image"`
+		Masks []Region `bson:"masks"`
+		Index int      `bson:"index"`
+	}
+
+	// Region is a rectangle drawn over a label of a diagram. Its geometry is in percent of the image's width and
+	// height, so it lines up with the image at any size.
+	Region struct {
+		X      float64 `bson:"x"`
+		Y      float64 `bson:"y"`
+		Width  float64 `bson:"width"`
+		Height float64 `bson:"height"`
+		Label  string  `bson:"label"`
+	}
+)
+
+// IsValid reports whether the region has a label and lies within the image.
+func (r Region) IsValid() bool {
+	return r.Label != "" &&
+		r.Width > 0 && r.Height > 0 &&
+		r.X >= 0 && r.Y >= 0 &&
+		r.X+r.Width <= 100 && r.Y+r.Height <= 100
+}
+
+// Mask is the region hidden on the card's front.
+func (o Occlusion) Mask() Region {
+	if o.Index < 0 || o.Index >= len(o.Masks) {
+		return Region{}
+	}
+	return o.Masks[o.Index]
+}
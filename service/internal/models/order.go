@@ -0,0 +1,71 @@
+package models
+
+import "time"
+
+// CardOrder is the order the cards of a deck are studied in. The default order follows the deck's schedule, serving
+// the most overdue card first; every other order is frozen into the session's queue when the session starts.
+type CardOrder string
+
+const (
+	DefaultCardOrder      CardOrder = ""
+	ShuffledCardOrder     CardOrder = "shuffled"
+	NewestFirstCardOrder  CardOrder = "newest"
+	WeakestFirstCardOrder CardOrder = "weakest"
+)
+
+func (o CardOrder) String() string {
+	return string(o)
+}
+
+// Label is the text shown to the learner for the order.
+func (o CardOrder) Label() string {
+	switch o {
+	case ShuffledCardOrder:
+		return "Shuffled"
+	case NewestFirstCardOrder:
+		return "Newest First"
+	case WeakestFirstCardOrder:
+		return "Weakest First"
+	default:
+		return "Due First"
+	}
+}
+
+// IsValid reports whether the order is one of the supported orders.
+func (o CardOrder) IsValid() bool {
+	switch o {
+	case DefaultCardOrder, ShuffledCardOrder, NewestFirstCardOrder, WeakestFirstCardOrder:
+		return true
+	default:
+		return false
+	}
+}
+
+// CardOrders are the orders a learner can choose from when starting a session.
+func CardOrders() []CardOrder {
+	return []CardOrder{DefaultCardOrder, ShuffledCardOrder, NewestFirstCardOrder, WeakestFirstCardOrder}
+}
+
+// CardAnswerStats totals answers to a card across sessions, along with how long the timed answers took.
+type CardAnswerStats struct {
+	CardID             string        `bson:"_id"`
+	Answered           int           `bson:"answered"`
+	Correct            int           `bson:"correct"`
+	RevealsTimed       int           `bson:"reveals_timed"`
+	TotalRevealLatency time.Duration `bson:"total_reveal_latency"`
+	AnswersTimed       int           `bson:"answers_timed"`
+	TotalAnswerLatency time.Duration `bson:"total_answer_latency"`
+}
+
+// Accuracy is the share of answers that were correct. Cards that have never been answered have an accuracy of 0.
+func (s CardAnswerStats) Accuracy() float64 {
+	if s.Answered == 0 {
+		return 0
+	}
+	return float64(s.Correct) / float64(s.Answered)
+}
+
+// Missed is the number of answers that were incorrect.
+func (s CardAnswerStats) Missed() int {
+	return s.Answered - s.Correct
+}
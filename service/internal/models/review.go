@@ -0,0 +1,92 @@
+package models
+
+import "time"
+
+type (
+	// SchedulerAlgorithm names the spaced repetition algorithm a deck is studied with.
+	SchedulerAlgorithm string
+
+	// SchedulerSettings are the scheduling options a deck owner can choose.
+	SchedulerSettings struct {
+		Algorithm       SchedulerAlgorithm `bson:"scheduler,omitempty"`
+		TargetRetention float64            `bson:"target_retention,omitempty"`
+		// LeechThreshold is how many times a learner can fail a card before it is a leech for them, and SuspendLeeches
+		// suspends a card for the learner as it becomes one.
+		LeechThreshold int  `bson:"leech_threshold,omitempty"`
+		SuspendLeeches bool `bson:"suspend_leeches,omitempty"`
+	}
+
+	// DueCard is a card that is due for review by a user.
+	DueCard struct {
+		CardID   string    `bson:"card_id"`
+		DeckID   string    `bson:"deck_id"`
+		Reversed bool      `bson:"reversed"`
+		DueAt    time.Time `bson:"due_at"`
+	}
+
+	// ReviewState is the spaced repetition state of a single card for a single user.
+	ReviewState struct {
+		ID             string     `bson:"_id"`
+		Username       string     `bson:"username"`
+		DeckID         string     `bson:"deck_id"`
+		CardID         string     `bson:"card_id"`
+		Reversed       bool       `bson:"reversed,omitempty"`
+		Ease           float64    `bson:"ease"`
+		Interval       int        `bson:"interval"`
+		Repetitions    int        `bson:"repetitions"`
+		Lapses         int        `bson:"lapses"`
+		Stability      float64    `bson:"stability,omitempty"`
+		Difficulty     float64    `bson:"difficulty,omitempty"`
+		Retrievability float64    `bson:"retrievability,omitempty"`
+		DueAt          time.Time  `bson:"due_at"`
+		LastReviewedAt *time.Time `bson:"last_reviewed_at"`
+		CreatedAt      time.Time  `bson:"created_at"`
+		UpdatedAt      time.Time  `bson:"updated_at"`
+	}
+)
+
+const (
+	SM2Scheduler  SchedulerAlgorithm = "sm2"
+	FSRSScheduler SchedulerAlgorithm = "fsrs"
+
+	// DefaultTargetRetention is the probability of recall FSRS aims for when a deck does not set one.
+	DefaultTargetRetention = 0.9
+	// DefaultLeechThreshold is how many failures make a card a leech when a deck does not set a threshold.
+	DefaultLeechThreshold = 8
+)
+
+func (a SchedulerAlgorithm) String() string {
+	switch a {
+	case FSRSScheduler:
+		return "FSRS"
+	default:
+		return "SM-2"
+	}
+}
+
+// IsValid reports whether the algorithm is one reptr can schedule with.
+func (a SchedulerAlgorithm) IsValid() bool {
+	return a == SM2Scheduler || a == FSRSScheduler
+}
+
+// LeechThresholdOrDefault is how many failures make a card of the deck a leech.
+func (s SchedulerSettings) LeechThresholdOrDefault() int {
+	if s.LeechThreshold <= 0 {
+		return DefaultLeechThreshold
+	}
+	return s.LeechThreshold
+}
+
+// IsDue reports whether the card should be reviewed at the given time.
+func (r ReviewState) IsDue(at time.Time) bool {
+	return !r.DueAt.After(at)
+}
+
+// CountDueByDeck returns how many of the due cards are in each deck.
+func CountDueByDeck(due []DueCard) map[string]int {
+	counts := make(map[string]int)
+	for _, card := range due {
+		counts[card.DeckID]++
+	}
+	return counts
+}
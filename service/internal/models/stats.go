@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+const (
+	// StatsDayFormat is how the days study is grouped by are written, both by Mongo and by Go.
+	StatsDayFormat = time.DateOnly
+
+	// MaxAnswerGap is the most time counted as studied for a single answer. A learner who takes longer has stepped
+	// away, and the time they were gone is not study.
+	MaxAnswerGap = 5 * time.Minute
+)
+
+type (
+	// DailyReviews is how many cards a learner answered on a day, and how many of them correctly.
+	DailyReviews struct {
+		Day     string `bson:"_id"`
+		Reviews int    `bson:"reviews"`
+		Correct int    `bson:"correct"`
+	}
+
+	// DailyLearned is how many cards a learner first recalled on a day.
+	DailyLearned struct {
+		Day     string `bson:"_id"`
+		Learned int    `bson:"learned"`
+	}
+
+	// DailyStudyTime is how long a learner spent answering the cards they answered on a day.
+	DailyStudyTime struct {
+		Day          string `bson:"_id"`
+		Milliseconds int64  `bson:"milliseconds"`
+	}
+
+	// DailyStudy is a learner's study grouped by day. Reviews and StudyTime start from the day asked for; Learned goes
+	// back to the learner's first session, so cards learned before then can be counted too.
+	DailyStudy struct {
+		Reviews   []DailyReviews   `bson:"reviews"`
+		Learned   []DailyLearned   `bson:"learned"`
+		StudyTime []DailyStudyTime `bson:"study_time"`
+	}
+
+	// StudyDay is everything a learner studied on a day.
+	StudyDay struct {
+		Date        time.Time
+		Reviews     int
+		Correct     int
+		Learned     int
+		TimeStudied time.Duration
+	}
+
+	// LearningStats is a learner's study on each day of a range, including the days they did not study.
+	// LearnedBefore is how many cards they had learned before the range began.
+	LearningStats struct {
+		Days          []StudyDay
+		LearnedBefore int
+	}
+)
+
+// PercentCorrect is the share of the day's answers that were correct, rounded down.
+func (d StudyDay) PercentCorrect() int {
+	if d.Reviews == 0 {
+		return 0
+	}
+	return d.Correct * 100 / d.Reviews
+}
+
+// Totals adds up the study of every day of the range.
+func (s LearningStats) Totals() StudyDay {
+	var total StudyDay
+	for _, day := range s.Days {
+		total.Reviews += day.Reviews
+		total.Correct += day.Correct
+		total.Learned += day.Learned
+		total.TimeStudied += day.TimeStudied
+	}
+	return total
+}
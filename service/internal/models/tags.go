@@ -0,0 +1,74 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ParseTags splits tags written as a list separated by commas or spaces, such as "aws, networking".
+func ParseTags(s string) []string {
+	return NormalizeTags(strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	}))
+}
+
+// NormalizeTags lower cases tags and drops a leading '#', then sorts them without duplicates, so that the same tags
+// are always stored and compared the same way.
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	if len(normalized) == 0 {
+		return nil
+	}
+	return normalized
+}
+
+// HasTags reports whether the card has every one of the tags.
+func (c Card) HasTags(tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, cardTag := range c.Tags {
+			if cardTag == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// CardsWithTags returns the cards with every one of the tags.
+func CardsWithTags(cards []Card, tags []string) []Card {
+	if len(tags) == 0 {
+		return cards
+	}
+	tagged := make([]Card, 0, len(cards))
+	for _, card := range cards {
+		if card.HasTags(tags) {
+			tagged = append(tagged, card)
+		}
+	}
+	return tagged
+}
+
+// DeckTags returns the tags used by any of the cards, sorted.
+func DeckTags(cards []Card) []string {
+	var tags []string
+	for _, card := range cards {
+		tags = append(tags, card.Tags...)
+	}
+	return NormalizeTags(tags)
+}
@@ -15,7 +15,8 @@ type (
 		ExpiresAt   int    `json:"expiresAt"`
 	}
 	User struct {
-		Username       string   `bson:"_id"`
-		MemberOfGroups []string `bson:"member_of_groups"`
+		Username         string   `bson:"_id"`
+		MemberOfGroups   []string `bson:"member_of_groups"`
+		StudyPreferences `bson:",inline"`
 	}
 )
@@ -0,0 +1,202 @@
+// Package chart draws the charts reptr shows as SVG on the server, so pages need no charting script.
+package chart
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	width   = 720
+	height  = 200
+	padding = 36
+
+	// cellSize and cellGap lay out the days of a heatmap.
+	cellSize = 12
+	cellGap  = 3
+)
+
+var (
+	// heatColours shade heatmap cells from no activity to the most.
+	heatColours = []string{"#EBEDF0", "#C6E48B", "#7BC96F", "#239A3B", "#196127"}
+
+	lineColour = "#3F7FBF"
+	axisColour = "#848484"
+)
+
+type (
+	// Point is a value plotted against a label on the x-axis.
+	Point struct {
+		Label string
+		Value float64
+	}
+
+	// Series is what a line or bar chart plots. Name describes the chart to screen readers, Max is the top of the
+	// y-axis, the largest value when it is zero, and Unit names what the values count.
+	Series struct {
+		Name   string
+		Points []Point
+		Max    float64
+		Unit   string
+	}
+
+	// Cell is a day of a heatmap with how much was done on it.
+	Cell struct {
+		Day   time.Time
+		Value float64
+	}
+)
+
+// Heatmap lays the days out as a calendar, a column for each week, and shades each by its value. Days are titled with
+// their date and value, so hovering shows them.
+func Heatmap(cells []Cell, unit string) string {
+	if len(cells) == 0 {
+		return empty()
+	}
+
+	var highest float64
+	for _, cell := range cells {
+		highest = math.Max(highest, cell.Value)
+	}
+
+	offset := int(cells[0].Day.Weekday())
+	weeks := (offset + len(cells) + 6) / 7
+	w := padding + weeks*(cellSize+cellGap)
+	h := 7 * (cellSize + cellGap)
+
+	var b strings.Builder
+	open(&b, w, h, "Calendar of "+unit)
+	for i, cell := range cells {
+		slot := offset + i
+		x := padding + (slot/7)*(cellSize+cellGap)
+		y := (slot % 7) * (cellSize + cellGap)
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"><title>%s</title></rect>`,
+			x, y, cellSize, cellSize, heatColours[level(cell.Value, highest)], title(cell.Day.Format("Mon 2 Jan 2006"), cell.Value, unit))
+	}
+	for _, day := range []time.Weekday{time.Monday, time.Wednesday, time.Friday} {
+		y := int(day)*(cellSize+cellGap) + cellSize - 2
+		fmt.Fprintf(&b, `<text x="0" y="%d" font-size="10" fill="%s">%s</text>`, y, axisColour, day.String()[:3])
+	}
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+// Line joins the points with a line. Points are titled with their label and value, so hovering shows them.
+func Line(series Series) string {
+	if len(series.Points) == 0 {
+		return empty()
+	}
+
+	top := series.top()
+	var (
+		b      strings.Builder
+		points = make([]string, len(series.Points))
+	)
+	open(&b, width, height, series.Name)
+	axes(&b, series, top)
+	for i, point := range series.Points {
+		x, y := position(i, len(series.Points), point.Value, top)
+		points[i] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	fmt.Fprintf(&b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`, strings.Join(points, " "), lineColour)
+	for i, point := range series.Points {
+		x, y := position(i, len(series.Points), point.Value, top)
+		fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="3" fill="%s"><title>%s</title></circle>`,
+			x, y, lineColour, title(point.Label, point.Value, series.Unit))
+	}
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+// Bars draws a bar for each point. Bars are titled with their label and value, so hovering shows them.
+func Bars(series Series) string {
+	if len(series.Points) == 0 {
+		return empty()
+	}
+
+	top := series.top()
+	slot := float64(width-2*padding) / float64(len(series.Points))
+	barWidth := math.Max(slot*0.8, 1)
+
+	var b strings.Builder
+	open(&b, width, height, series.Name)
+	axes(&b, series, top)
+	for i, point := range series.Points {
+		barHeight := point.Value / top * float64(height-2*padding)
+		x := float64(padding) + float64(i)*slot + (slot-barWidth)/2
+		y := float64(height-padding) - barHeight
+		fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"><title>%s</title></rect>`,
+			x, y, barWidth, barHeight, lineColour, title(point.Label, point.Value, series.Unit))
+	}
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+// top is the value at the top of the y-axis. Series of nothing but zeroes still get an axis.
+func (s Series) top() float64 {
+	if s.Max > 0 {
+		return s.Max
+	}
+	top := 1.0
+	for _, point := range s.Points {
+		top = math.Max(top, point.Value)
+	}
+	return top
+}
+
+// position places the i-th of n points with the given value on a chart whose y-axis tops out at top.
+func position(i, n int, value, top float64) (x, y float64) {
+	x = float64(padding)
+	if n > 1 {
+		x += float64(i) * float64(width-2*padding) / float64(n-1)
+	}
+	y = float64(height-padding) - value/top*float64(height-2*padding)
+	return x, y
+}
+
+// axes draws the x and y axes, labelled with the first and last labels and the top of the y-axis.
+func axes(b *strings.Builder, series Series, top float64) {
+	fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s"/>`, padding, height-padding, width-padding, height-padding, axisColour)
+	fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s"/>`, padding, padding, padding, height-padding, axisColour)
+	fmt.Fprintf(b, `<text x="%d" y="%d" font-size="10" fill="%s">%s</text>`, 0, padding-6, axisColour, html.EscapeString(format(top)))
+	fmt.Fprintf(b, `<text x="%d" y="%d" font-size="10" fill="%s">0</text>`, 0, height-padding, axisColour)
+
+	first, last := series.Points[0].Label, series.Points[len(series.Points)-1].Label
+	fmt.Fprintf(b, `<text x="%d" y="%d" font-size="10" fill="%s">%s</text>`, padding, height-padding+16, axisColour, html.EscapeString(first))
+	fmt.Fprintf(b, `<text x="%d" y="%d" font-size="10" fill="%s" text-anchor="end">%s</text>`, width-padding, height-padding+16, axisColour, html.EscapeString(last))
+}
+
+// open starts an SVG that scales to the width of the page it is on.
+func open(b *strings.Builder, w, h int, label string) {
+	fmt.Fprintf(b, `<svg class="chart" xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" role="img" aria-label="%s">`, w, h, html.EscapeString(label))
+}
+
+// empty is drawn in place of a chart with nothing to show.
+func empty() string {
+	var b strings.Builder
+	open(&b, width, 40, "No study yet")
+	fmt.Fprintf(&b, `<text x="0" y="24" fill="%s">No study yet</text></svg>`, axisColour)
+	return b.String()
+}
+
+// level buckets a value into one of the heatmap's shades. Only days with no activity get the lightest.
+func level(value, highest float64) int {
+	if value <= 0 || highest <= 0 {
+		return 0
+	}
+	top := len(heatColours) - 1
+	return max(1, min(top, int(math.Ceil(value/highest*float64(top)))))
+}
+
+func title(label string, value float64, unit string) string {
+	return html.EscapeString(fmt.Sprintf("%s: %s %s", label, format(value), unit))
+}
+
+// format writes values to a tenth, dropping the decimal from whole numbers.
+func format(value float64) string {
+	return strconv.FormatFloat(math.Round(value*10)/10, 'f', -1, 64)
+}
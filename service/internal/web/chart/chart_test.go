@@ -0,0 +1,145 @@
+package chart
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHeatmap(t *testing.T) {
+	sunday := time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		cells        []Cell
+		wantContains []string
+		wantRects    int
+	}{
+		"should draw a cell for every day": {
+			cells: []Cell{
+				{Day: sunday, Value: 0},
+				{Day: sunday.AddDate(0, 0, 1), Value: 4},
+				{Day: sunday.AddDate(0, 0, 2), Value: 1},
+			},
+			wantContains: []string{
+				`<title>Sun 3 Mar 2024: 0 reviews</title>`,
+				`fill="#EBEDF0"><title>Sun 3 Mar 2024`,
+				`fill="#196127"><title>Mon 4 Mar 2024`,
+				`fill="#C6E48B"><title>Tue 5 Mar 2024`,
+			},
+			wantRects: 3,
+		},
+		"should start a new column each week": {
+			cells: []Cell{
+				{Day: sunday.AddDate(0, 0, -1), Value: 1},
+				{Day: sunday, Value: 1},
+			},
+			wantContains: []string{
+				`<rect x="36" y="90"`,
+				`<rect x="51" y="0"`,
+			},
+			wantRects: 2,
+		},
+		"should say there is nothing to show without days": {
+			wantContains: []string{"No study yet"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := Heatmap(tc.cells, "reviews")
+
+			for _, want := range tc.wantContains {
+				assert.Contains(t, got, want)
+			}
+			assert.Equal(t, tc.wantRects, strings.Count(got, "<rect"))
+		})
+	}
+}
+
+func TestLine(t *testing.T) {
+	testCases := map[string]struct {
+		series       Series
+		wantContains []string
+	}{
+		"should join the points across the chart": {
+			series: Series{
+				Name:   "Accuracy",
+				Points: []Point{{Label: "1 Mar", Value: 0}, {Label: "2 Mar", Value: 50}, {Label: "3 Mar", Value: 100}},
+				Max:    100,
+				Unit:   "%",
+			},
+			wantContains: []string{
+				`<polyline points="36.0,164.0 360.0,100.0 684.0,36.0"`,
+				`<title>2 Mar: 50 %</title>`,
+				`aria-label="Accuracy"`,
+			},
+		},
+		"should escape labels": {
+			series: Series{
+				Points: []Point{{Label: "<b>", Value: 1}},
+				Unit:   "cards",
+			},
+			wantContains: []string{`&lt;b&gt;: 1 cards`},
+		},
+		"should say there is nothing to show without points": {
+			wantContains: []string{"No study yet"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := Line(tc.series)
+
+			for _, want := range tc.wantContains {
+				assert.Contains(t, got, want)
+			}
+			assert.NotContains(t, got, "<b>")
+		})
+	}
+}
+
+func TestBars(t *testing.T) {
+	testCases := map[string]struct {
+		series       Series
+		wantContains []string
+		wantRects    int
+	}{
+		"should draw a bar for each point scaled to the largest": {
+			series: Series{
+				Points: []Point{{Label: "1 Mar", Value: 10}, {Label: "2 Mar", Value: 2.55}},
+				Unit:   "minutes",
+			},
+			wantContains: []string{
+				`height="128.0"`,
+				`<title>2 Mar: 2.6 minutes</title>`,
+			},
+			wantRects: 2,
+		},
+		"should keep an axis when every value is zero": {
+			series: Series{
+				Points: []Point{{Label: "1 Mar", Value: 0}},
+				Unit:   "minutes",
+			},
+			wantContains: []string{`height="0.0"`, `>1</text>`},
+			wantRects:    1,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := Bars(tc.series)
+
+			for _, want := range tc.wantContains {
+				assert.Contains(t, got, want)
+			}
+			assert.Equal(t, tc.wantRects, strings.Count(got, "<rect"))
+		})
+	}
+}
@@ -10,7 +10,14 @@ import "context"
 import "io"
 import "bytes"
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// choiceInputs is the number of options offered when creating a multiple choice card; options left empty are dropped.
+const choiceInputs = 4
 
 func CardInput(cardNum string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
@@ -32,7 +39,7 @@ func CardInput(cardNum string) templ.Component {
 		var templ_7745c5c3_Var2 string
 		templ_7745c5c3_Var2, templ_7745c5c3_Err = templ.JoinStringErrs("card-section-" + cardNum)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 6, Col: 40}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 13, Col: 40}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var2))
 		if templ_7745c5c3_Err != nil {
@@ -45,7 +52,7 @@ func CardInput(cardNum string) templ.Component {
 		var templ_7745c5c3_Var3 string
 		templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.JoinStringErrs("front-" + cardNum)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 8, Col: 36}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 15, Col: 36}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var3))
 		if templ_7745c5c3_Err != nil {
@@ -58,7 +65,7 @@ func CardInput(cardNum string) templ.Component {
 		var templ_7745c5c3_Var4 string
 		templ_7745c5c3_Var4, templ_7745c5c3_Err = templ.JoinStringErrs("front-" + cardNum)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 8, Col: 64}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 15, Col: 64}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
 		if templ_7745c5c3_Err != nil {
@@ -71,7 +78,7 @@ func CardInput(cardNum string) templ.Component {
 		var templ_7745c5c3_Var5 string
 		templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(fmt.Sprintf("Front of Card %s", cardNum))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 8, Col: 121}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 15, Col: 121}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
 		if templ_7745c5c3_Err != nil {
@@ -84,7 +91,7 @@ func CardInput(cardNum string) templ.Component {
 		var templ_7745c5c3_Var6 string
 		templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs("back-" + cardNum)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 11, Col: 35}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 18, Col: 35}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
 		if templ_7745c5c3_Err != nil {
@@ -97,7 +104,7 @@ func CardInput(cardNum string) templ.Component {
 		var templ_7745c5c3_Var7 string
 		templ_7745c5c3_Var7, templ_7745c5c3_Err = templ.JoinStringErrs("back-" + cardNum)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 11, Col: 62}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 18, Col: 62}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var7))
 		if templ_7745c5c3_Err != nil {
@@ -110,7 +117,7 @@ func CardInput(cardNum string) templ.Component {
 		var templ_7745c5c3_Var8 string
 		templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(fmt.Sprintf("Back of Card %s", cardNum))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 11, Col: 118}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 18, Col: 118}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
 		if templ_7745c5c3_Err != nil {
@@ -126,3 +133,238 @@ func CardInput(cardNum string) templ.Component {
 		return templ_7745c5c3_Err
 	})
 }
+
+// TagInput is where the tags of a card being created are written, separated by commas or spaces.
+func TagInput() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
+		if !templ_7745c5c3_IsBuffer {
+			templ_7745c5c3_Buffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templ_7745c5c3_Buffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var9 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var9 == nil {
+			templ_7745c5c3_Var9 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<section class=\"input-container\"><input type=\"text\" name=\"tags\" placeholder=\"Tags, such as aws, networking\"></section>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if !templ_7745c5c3_IsBuffer {
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteTo(templ_7745c5c3_W)
+		}
+		return templ_7745c5c3_Err
+	})
+}
+
+// TagEditInput is where the tags of a card being edited are rewritten, starting from the tags it has.
+func TagEditInput(tags []string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
+		if !templ_7745c5c3_IsBuffer {
+			templ_7745c5c3_Buffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templ_7745c5c3_Buffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var10 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var10 == nil {
+			templ_7745c5c3_Var10 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<section class=\"input-container\"><label for=\"tags-input\">Tags</label> <input type=\"text\" id=\"tags-input\" name=\"tags\" placeholder=\"Tags, such as aws, networking\" value=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var11 string
+		templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.JoinStringErrs(strings.Join(tags, ", "))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 34, Col: 125}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var11))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("\"></section>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if !templ_7745c5c3_IsBuffer {
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteTo(templ_7745c5c3_W)
+		}
+		return templ_7745c5c3_Err
+	})
+}
+
+// ChoiceCardInput is the form variant for creating a multiple choice card: a question and options, any of which can
+// be marked correct.
+func ChoiceCardInput(createURL string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
+		if !templ_7745c5c3_IsBuffer {
+			templ_7745c5c3_Buffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templ_7745c5c3_Buffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var12 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var12 == nil {
+			templ_7745c5c3_Var12 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<form id=\"create-choice-card-form\" hx-post=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var13 string
+		templ_7745c5c3_Var13, templ_7745c5c3_Err = templ.JoinStringErrs(createURL)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 41, Col: 55}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var13))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("\" hx-target=\"#card-section\"><input type=\"hidden\" name=\"card-type\" value=\"multiple_choice\"><section id=\"create-choice-card\" class=\"create-card-section\"><section class=\"input-container\"><textarea id=\"choice-card-front\" name=\"card-front\" rows=\"2\" placeholder=\"Question\"></textarea></section>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		for i := 0; i < choiceInputs; i++ {
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<section class=\"input-container choice-input\"><input type=\"text\" name=\"option\" placeholder=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var14 string
+			templ_7745c5c3_Var14, templ_7745c5c3_Err = templ.JoinStringErrs(fmt.Sprintf("Option %d", i+1))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 49, Col: 81}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var14))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("\"> <label><input type=\"checkbox\" name=\"correct\" value=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var15 string
+			templ_7745c5c3_Var15, templ_7745c5c3_Err = templ.JoinStringErrs(strconv.Itoa(i))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 51, Col: 67}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var15))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("\"> Correct</label></section>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = TagInput().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<button class=\"button\" type=\"submit\">Create Multiple Choice Card</button></section></form>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if !templ_7745c5c3_IsBuffer {
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteTo(templ_7745c5c3_W)
+		}
+		return templ_7745c5c3_Err
+	})
+}
+
+// ClozeCardInput is the form variant for creating cloze cards from a note, one for each deletion.
+func ClozeCardInput(createURL string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
+		if !templ_7745c5c3_IsBuffer {
+			templ_7745c5c3_Buffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templ_7745c5c3_Buffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var16 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var16 == nil {
+			templ_7745c5c3_Var16 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<form id=\"create-cloze-card-form\" hx-post=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var17 string
+		templ_7745c5c3_Var17, templ_7745c5c3_Err = templ.JoinStringErrs(createURL)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 64, Col: 54}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var17))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("\" hx-target=\"#card-section\"><input type=\"hidden\" name=\"card-type\" value=\"cloze\"><section id=\"create-cloze-card\" class=\"create-card-section\"><section class=\"input-container\"><textarea id=\"cloze-card-note\" name=\"card-front\" rows=\"3\" placeholder=\"The {{c1::mitochondria}} is the {{c2::powerhouse}} of the cell\"></textarea></section>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = TagInput().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<button class=\"button\" type=\"submit\">Create Cloze Cards</button></section></form>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if !templ_7745c5c3_IsBuffer {
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteTo(templ_7745c5c3_W)
+		}
+		return templ_7745c5c3_Err
+	})
+}
+
+// OcclusionCardInput is the form variant for creating image occlusion cards: a diagram with a labelled rectangle
+// drawn over each part to learn, one card for each. Rectangles are sent in percent of the image's size.
+func OcclusionCardInput(createURL string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
+		if !templ_7745c5c3_IsBuffer {
+			templ_7745c5c3_Buffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templ_7745c5c3_Buffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var18 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var18 == nil {
+			templ_7745c5c3_Var18 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<form id=\"create-occlusion-card-form\" hx-post=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var19 string
+		templ_7745c5c3_Var19, templ_7745c5c3_Err = templ.JoinStringErrs(createURL)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `service/internal/web/components/dumb/card_input.templ`, Line: 79, Col: 58}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var19))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("\" hx-target=\"#card-section\" hx-encoding=\"multipart/form-data\"><input type=\"hidden\" name=\"card-type\" value=\"image_occlusion\"><section id=\"create-occlusion-card\" class=\"create-card-section occlusion-editor\"><section class=\"input-container\"><input type=\"text\" name=\"card-front\" value=\"What is under the mask?\"> <input type=\"file\" name=\"occlusion-image\" accept=\"image/*\" class=\"occlusion-image-input\"></section><section class=\"occlusion-canvas\"><img class=\"occlusion-preview\" alt=\"\"></section><ol class=\"occlusion-masks\"></ol>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = TagInput().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString("<button class=\"button\" type=\"submit\">Create Image Occlusion Cards</button></section></form><script>\n\t\t(function () {\n\t\t\tif (window.reptrOcclusionEditor) {\n\t\t\t\treturn;\n\t\t\t}\n\t\t\twindow.reptrOcclusionEditor = true;\n\n\t\t\tconst percent = (value, size) => Math.min(100, Math.max(0, value / size * 100));\n\t\t\tconst place = (box, r) => {\n\t\t\t\tbox.style.left = r.x + \"%\";\n\t\t\t\tbox.style.top = r.y + \"%\";\n\t\t\t\tbox.style.width = r.width + \"%\";\n\t\t\t\tbox.style.height = r.height + \"%\";\n\t\t\t};\n\t\t\tconst hidden = (name, value) => {\n\t\t\t\tconst input = document.createElement(\"input\");\n\t\t\t\tinput.type = \"hidden\";\n\t\t\t\tinput.name = name;\n\t\t\t\tinput.value = value.toFixed(2);\n\t\t\t\treturn input;\n\t\t\t};\n\n\t\t\tdocument.addEventListener(\"change\", (event) => {\n\t\t\t\tif (!event.target.matches(\".occlusion-image-input\")) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tconst editor = event.target.closest(\".occlusion-editor\");\n\t\t\t\tconst file = event.target.files[0];\n\t\t\t\tconst preview = editor.querySelector(\".occlusion-preview\");\n\t\t\t\tif (file) {\n\t\t\t\t\tpreview.src = URL.createObjectURL(file);\n\t\t\t\t} else {\n\t\t\t\t\tpreview.removeAttribute(\"src\");\n\t\t\t\t}\n\t\t\t\teditor.querySelectorAll(\".occlusion-box\").forEach((box) => box.remove());\n\t\t\t\teditor.querySelector(\".occlusion-masks\").replaceChildren();\n\t\t\t});\n\n\t\t\tlet drawing = null;\n\t\t\tdocument.addEventListener(\"pointerdown\", (event) => {\n\t\t\t\tconst canvas = event.target.closest(\".occlusion-canvas\");\n\t\t\t\tif (!canvas || !canvas.querySelector(\".occlusion-preview\").hasAttribute(\"src\")) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tevent.preventDefault();\n\t\t\t\tconst bounds = canvas.getBoundingClientRect();\n\t\t\t\tconst box = document.createElement(\"div\");\n\t\t\t\tbox.className = \"occlusion-box\";\n\t\t\t\tcanvas.appendChild(box);\n\t\t\t\tdrawing = {\n\t\t\t\t\tcanvas, bounds, box,\n\t\t\t\t\tstartX: percent(event.clientX - bounds.left, bounds.width),\n\t\t\t\t\tstartY: percent(event.clientY - bounds.top, bounds.height),\n\t\t\t\t};\n\t\t\t});\n\n\t\t\tdocument.addEventListener(\"pointermove\", (event) => {\n\t\t\t\tif (!drawing) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tconst x = percent(event.clientX - drawing.bounds.left, drawing.bounds.width);\n\t\t\t\tconst y = percent(event.clientY - drawing.bounds.top, drawing.bounds.height);\n\t\t\t\tdrawing.rect = {\n\t\t\t\t\tx: Math.min(x, drawing.startX),\n\t\t\t\t\ty: Math.min(y, drawing.startY),\n\t\t\t\t\twidth: Math.abs(x - drawing.startX),\n\t\t\t\t\theight: Math.abs(y - drawing.startY),\n\t\t\t\t};\n\t\t\t\tplace(drawing.box, drawing.rect);\n\t\t\t});\n\n\t\t\tdocument.addEventListener(\"pointerup\", () => {\n\t\t\t\tif (!drawing) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tconst { canvas, box, rect } = drawing;\n\t\t\t\tdrawing = null;\n\t\t\t\t// A click rather than a drag draws nothing.\n\t\t\t\tif (!rect || rect.width < 1 || rect.height < 1) {\n\t\t\t\t\tbox.remove();\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst item = document.createElement(\"li\");\n\t\t\t\tconst label = document.createElement(\"input\");\n\t\t\t\tlabel.type = \"text\";\n\t\t\t\tlabel.name = \"mask-label\";\n\t\t\t\tlabel.placeholder = \"Label under this mask\";\n\t\t\t\tconst remove = document.createElement(\"button\");\n\t\t\t\tremove.type = \"button\";\n\t\t\t\tremove.textContent = \"Remove\";\n\t\t\t\tremove.addEventListener(\"click\", () => {\n\t\t\t\t\titem.remove();\n\t\t\t\t\tbox.remove();\n\t\t\t\t});\n\t\t\t\t// Masks are sent to two decimal places; rounding could push one past the image's edge, so its size is\n\t\t\t\t// cut back to fit.\n\t\t\t\tconst x = Math.round(rect.x * 100);\n\t\t\t\tconst y = Math.round(rect.y * 100);\n\t\t\t\tconst width = Math.min(Math.round(rect.width * 100), 10000 - x);\n\t\t\t\tconst height = Math.min(Math.round(rect.height * 100), 10000 - y);\n\t\t\t\titem.append(\n\t\t\t\t\thidden(\"mask-x\", x / 100), hidden(\"mask-y\", y / 100),\n\t\t\t\t\thidden(\"mask-width\", width / 100), hidden(\"mask-height\", height / 100),\n\t\t\t\t\tlabel, remove,\n\t\t\t\t);\n\t\t\t\tcanvas.closest(\".occlusion-editor\").querySelector(\".occlusion-masks\").appendChild(item);\n\t\t\t\tlabel.focus();\n\t\t\t});\n\t\t})();\n\t</script>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if !templ_7745c5c3_IsBuffer {
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteTo(templ_7745c5c3_W)
+		}
+		return templ_7745c5c3_Err
+	})
+}
@@ -1,30 +1,102 @@
 package dumb
 
-import "time"
+import (
+	"github.com/rmarken/reptr/service/internal/models"
+	"github.com/rmarken/reptr/service/internal/web/markdown"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type (
 	CardFront struct {
-		SessionID      string
-		DeckID         string
-		CardType       string
-		CardID         string
-		Front          string
-		Upvotes        string
-		Downvotes      string
-		PreviousCardID string
-		NextCardID     string
+		SessionID        string
+		DeckID           string
+		CardType         string
+		CardID           string
+		Reversed         bool
+		Front            string
+		Upvotes          string
+		Downvotes        string
+		PreviousCardID   string
+		PreviousDeckID   string
+		PreviousReversed bool
+		NextCardID       string
+		NextDeckID       string
+		NextReversed     bool
+		// Typed cards are answered by typing the answer rather than revealing the back.
+		Typed bool
+		// Choices are the options of a multiple choice card, which is answered by picking from them instead.
+		Choices []Choice
+		// Media are the images and audio attached to the side of the card being shown.
+		Media []models.MediaRef
+		// Occlusion is the diagram of an image occlusion card, shown with the card's mask.
+		Occlusion *models.Occlusion
+	}
+
+	// Choice is an option that can be picked to answer a multiple choice card.
+	Choice struct {
+		ID   string
+		Text string
+	}
+
+	// ChoiceResult is an option of a multiple choice card once answered, styled by Class.
+	ChoiceResult struct {
+		Text     string
+		Class    string
+		Selected bool
 	}
 
 	CardBack struct {
-		SessionID      string
-		DeckID         string
-		CardID         string
-		BackContent    string
-		PreviousCardID string
-		NextCardID     string
-		IsUpvoted      bool
-		IsDownvoted    bool
-		VoteButtonData VoteButtonsData
+		SessionID        string
+		DeckID           string
+		CardID           string
+		Reversed         bool
+		BackContent      string
+		PreviousCardID   string
+		PreviousDeckID   string
+		PreviousReversed bool
+		NextCardID       string
+		NextDeckID       string
+		NextReversed     bool
+		IsUpvoted        bool
+		IsDownvoted      bool
+		VoteButtonData   VoteButtonsData
+		// Choices are the options of a multiple choice card, with the correct ones marked.
+		Choices []ChoiceResult
+		// Cloze is the note of a cloze card in Markdown, with the card's deletion marked.
+		Cloze string
+		// Media are the images and audio attached to the side of the card being shown.
+		Media []models.MediaRef
+		// Occlusion is the diagram of an image occlusion card, shown with the card's mask.
+		Occlusion *models.Occlusion
+	}
+
+	// TypedAnswerResult is how a typed answer compared to the expected answer.
+	TypedAnswerResult struct {
+		Reversed    bool
+		Typed       string
+		Expected    string
+		Grade       models.Grade
+		Diff        []DiffSegment
+		ContinueURL string
+		IsFinished  bool
+	}
+
+	// ChoiceAnswerResult is how the options picked for a multiple choice card compared to the correct ones.
+	ChoiceAnswerResult struct {
+		Grade       models.Grade
+		Choices     []ChoiceResult
+		ContinueURL string
+		IsFinished  bool
+	}
+
+	// DiffSegment is a run of a typed answer's diff, styled by Class.
+	DiffSegment struct {
+		Text  string
+		Class string
 	}
 
 	// VoteButtonsData is data for the VoteButtons component
@@ -41,6 +113,7 @@ type (
 		NumUpvotes   int
 		NumDownvotes int
 		NumCards     int
+		NumDue       int
 		CreatedAt    time.Time
 		UpdatedAt    time.Time
 	}
@@ -59,3 +132,141 @@ func (c CardBack) DownvoteClass() string {
 	}
 	return ""
 }
+
+// FrontOfCardURL is the path to the prompt side of a card studied in the given session and direction.
+func FrontOfCardURL(deckID, cardID, sessionID string, reversed bool) string {
+	return cardURL("/page/front-of-card", deckID, cardID, sessionID, reversed)
+}
+
+// BackOfCardURL is the path to the answer side of a card studied in the given session and direction.
+func BackOfCardURL(deckID, cardID, sessionID string, reversed bool) string {
+	return cardURL("/page/back-of-card", deckID, cardID, sessionID, reversed)
+}
+
+func cardURL(base, deckID, cardID, sessionID string, reversed bool) string {
+	u := path.Join(base, deckID, cardID)
+	query := url.Values{}
+	if sessionID != "" {
+		query.Set("session_id", sessionID)
+	}
+	if reversed {
+		query.Set("reversed", "true")
+	}
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+func (c CardFront) PreviousURL() string {
+	return FrontOfCardURL(orDeck(c.PreviousDeckID, c.DeckID), c.PreviousCardID, c.SessionID, c.PreviousReversed)
+}
+
+func (c CardFront) NextURL() string {
+	return FrontOfCardURL(orDeck(c.NextDeckID, c.DeckID), c.NextCardID, c.SessionID, c.NextReversed)
+}
+
+// TypedAnswerURL is the path typed answers to the session's current card are submitted to.
+func (c CardFront) TypedAnswerURL() string {
+	return path.Join("/page/typed-answer/", c.SessionID)
+}
+
+// ChoiceAnswerURL is the path the options picked for the session's current card are submitted to.
+func (c CardFront) ChoiceAnswerURL() string {
+	return path.Join("/page/choice-answer/", c.SessionID)
+}
+
+func (c CardFront) BackURL() string {
+	return BackOfCardURL(c.DeckID, c.CardID, c.SessionID, c.Reversed)
+}
+
+func (c CardBack) PreviousURL() string {
+	return FrontOfCardURL(orDeck(c.PreviousDeckID, c.DeckID), c.PreviousCardID, c.SessionID, c.PreviousReversed)
+}
+
+func (c CardBack) FrontURL() string {
+	return FrontOfCardURL(c.DeckID, c.CardID, c.SessionID, c.Reversed)
+}
+
+// orDeck returns deckID, or fallback when the neighbouring card is in the same deck.
+func orDeck(deckID, fallback string) string {
+	if deckID == "" {
+		return fallback
+	}
+	return deckID
+}
+
+// Choices returns the options of a multiple choice card in the order they are shown in the session, or nil when the
+// card is not answered by picking options.
+func Choices(sessionID string, card models.FrontOfCard) []Choice {
+	if !card.IsChoice() {
+		return nil
+	}
+	options := models.ShuffleOptions(card.Options, sessionID, card.CardID)
+	choices := make([]Choice, len(options))
+	for i, option := range options {
+		choices[i] = Choice{ID: option.ID, Text: option.Text}
+	}
+	return choices
+}
+
+// AnswerChoices returns the options of a multiple choice card with the correct ones marked, or nil when the card
+// was not answered by picking options.
+func AnswerChoices(sessionID string, card models.BackOfCard) []ChoiceResult {
+	if !card.IsChoice() {
+		return nil
+	}
+	return ChoiceResults(sessionID, card.CardID, card.Options, nil)
+}
+
+// ChoiceResults marks which of a multiple choice card's options are correct and which of the selected options were
+// wrong, in the order they are shown in the session.
+func ChoiceResults(sessionID, cardID string, options []models.CardOption, selected []string) []ChoiceResult {
+	picked := make(map[string]bool, len(selected))
+	for _, id := range selected {
+		picked[id] = true
+	}
+
+	options = models.ShuffleOptions(options, sessionID, cardID)
+	results := make([]ChoiceResult, len(options))
+	for i, option := range options {
+		results[i] = ChoiceResult{Text: option.Text, Selected: picked[option.ID]}
+		switch {
+		case option.IsCorrect:
+			results[i].Class = "choice-correct"
+		case picked[option.ID]:
+			results[i].Class = "choice-wrong"
+		}
+	}
+	return results
+}
+
+// occlusionMaskClass styles the mask of an image occlusion card as hidden or revealed.
+func occlusionMaskClass(revealed bool) string {
+	if revealed {
+		return "occlusion-revealed"
+	}
+	return "occlusion-mask"
+}
+
+// svgNumber formats a coordinate for an SVG attribute.
+func svgNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// ClozeNote returns the note of a cloze card with the card's deletion marked as the answer, or "" when the card is
+// not a cloze card.
+func ClozeNote(card models.BackOfCard) string {
+	if !card.IsCloze() {
+		return ""
+	}
+	var b strings.Builder
+	for _, segment := range models.ParseCloze(card.Note) {
+		if segment.Index == card.ClozeIndex {
+			b.WriteString(markdown.Mark(segment.Text))
+		} else {
+			b.WriteString(markdown.Unmarked(segment.Text))
+		}
+	}
+	return b.String()
+}
@@ -13,6 +13,20 @@ type (
 		Username string
 		Groups   []HomeGroupData
 		Decks    []dumb.Deck
+		NumDue   int
+		// Progress is nil when the user's study progress could not be loaded.
+		Progress *StudyProgressData
+	}
+
+	StudyProgressData struct {
+		CurrentStreak int
+		LongestStreak int
+		HasGoal       bool
+		GoalMet       bool
+		Done          int
+		Target        int
+		Unit          string
+		Percent       int
 	}
 
 	HomeGroupData struct {
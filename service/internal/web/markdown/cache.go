@@ -0,0 +1,58 @@
+package markdown
+
+import (
+	"container/list"
+	"sync"
+)
+
+type (
+	// cache is a least recently used cache of rendered content, keyed by the hash of its source.
+	cache struct {
+		mu      sync.Mutex
+		max     int
+		order   *list.List
+		entries map[[32]byte]*list.Element
+	}
+
+	cacheEntry struct {
+		key  [32]byte
+		html string
+	}
+)
+
+func newCache(max int) *cache {
+	return &cache{
+		max:     max,
+		order:   list.New(),
+		entries: make(map[[32]byte]*list.Element),
+	}
+}
+
+func (c *cache) get(key [32]byte) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(cacheEntry).html, true
+}
+
+// add caches the rendered content, evicting the least recently used when the cache is full.
+func (c *cache) add(key [32]byte, html string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(cacheEntry{key: key, html: html})
+	if c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(cacheEntry).key)
+	}
+}
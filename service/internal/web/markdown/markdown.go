@@ -0,0 +1,107 @@
+package markdown
+
+import (
+	"bytes"
+	"crypto/sha256"
+	chromaHTML "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/extension"
+	goldmarkHTML "github.com/yuin/goldmark/renderer/html"
+	"html"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// highlightStyle is the chroma style code is coloured with.
+	highlightStyle = "github"
+	// highlightClassPrefix namespaces the classes highlighted code is marked up with, so the sanitizer can let
+	// them through without letting card content use the page's own classes.
+	highlightClassPrefix = "hl-"
+	// maxCachedRenders bounds how many rendered sides of cards are kept.
+	maxCachedRenders = 4096
+	// markOpen and markClose are private use characters that stand in for the ends of marked text while it is
+	// rendered, so the text keeps its Markdown.
+	markOpen  = "\uE000"
+	markClose = "\uE001"
+)
+
+var (
+	highlightFormat = []chromaHTML.Option{
+		chromaHTML.WithClasses(true),
+		chromaHTML.ClassPrefix(highlightClassPrefix),
+	}
+
+	// renderer renders GitHub flavoured Markdown. Line breaks are kept as written, as they were when cards were shown
+	// as plain text. Fenced code is highlighted for the language it is marked with, or the language it looks like.
+	renderer = goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			highlighting.NewHighlighting(
+				highlighting.WithStyle(highlightStyle),
+				highlighting.WithGuessLanguage(true),
+				highlighting.WithFormatOptions(highlightFormat...),
+			),
+		),
+		goldmark.WithRendererOptions(goldmarkHTML.WithHardWraps()),
+	)
+	// policy keeps the elements Markdown produces and drops anything that could run script or restyle the page.
+	policy = sanitizer()
+
+	// rendered caches rendered content by its hash. A card's content only changes when the card is edited, so each
+	// revision of a card is highlighted once rather than on every flip.
+	rendered = newCache(maxCachedRenders)
+)
+
+func sanitizer() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^`+highlightClassPrefix+`[a-zA-Z0-9]+$`)).OnElements("pre", "code", "span")
+	return p
+}
+
+// Render converts card content written in Markdown to sanitized HTML. The source is stored as written, so cards are
+// rendered as they are shown; renders are cached by content.
+func Render(source string) string {
+	key := sha256.Sum256([]byte(source))
+	if out, ok := rendered.get(key); ok {
+		return out
+	}
+
+	var b bytes.Buffer
+	err := renderer.Convert([]byte(source), &b)
+	if err != nil {
+		return "<p>" + html.EscapeString(source) + "</p>"
+	}
+	out := policy.Sanitize(b.String())
+	rendered.add(key, out)
+	return out
+}
+
+// Mark wraps text so that RenderMarked shows it in a mark element.
+func Mark(text string) string {
+	return markOpen + Unmarked(text) + markClose
+}
+
+// Unmarked drops any marks from text, so card content cannot open or close one itself.
+func Unmarked(text string) string {
+	return strings.NewReplacer(markOpen, "", markClose, "").Replace(text)
+}
+
+// RenderMarked is Render for content with text wrapped by Mark, which is shown in a mark element.
+func RenderMarked(source string) string {
+	return strings.NewReplacer(markOpen, "<mark>", markClose, "</mark>").Replace(Render(source))
+}
+
+// HighlightCSS is the stylesheet for the classes highlighted code is marked up with.
+var HighlightCSS = sync.OnceValues(func() ([]byte, error) {
+	var b bytes.Buffer
+	err := chromaHTML.New(highlightFormat...).WriteCSS(&b, styles.Get(highlightStyle))
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+})
@@ -0,0 +1,117 @@
+package markdown
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	testCases := map[string]struct {
+		source string
+		want   string
+	}{
+		"should render emphasis": {
+			source: "the *mitochondria* is the **powerhouse**",
+			want:   "<p>the <em>mitochondria</em> is the <strong>powerhouse</strong></p>\n",
+		},
+		"should keep line breaks": {
+			source: "first\nsecond",
+			want:   "<p>first<br>\nsecond</p>\n",
+		},
+		"should render lists": {
+			source: "- one\n- two",
+			want:   "<ul>\n<li>one</li>\n<li>two</li>\n</ul>\n",
+		},
+		"should render tables": {
+			source: "| a | b |\n| - | - |\n| 1 | 2 |",
+			want:   "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td>2</td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		"should render code blocks without a recognisable language as plain text": {
+			source: "```\nfmt.Println(\"<hi>\")\n```",
+			want:   "<pre class=\"hl-chroma\"><code><span class=\"hl-line\"><span class=\"hl-cl\">fmt.Println(&#34;&lt;hi&gt;&#34;)\n</span></span></code></pre>",
+		},
+		"should highlight code blocks in the hinted language": {
+			source: "```go\nx := 1\n```",
+			want:   "<pre class=\"hl-chroma\"><code><span class=\"hl-line\"><span class=\"hl-cl\"><span class=\"hl-nx\">x</span> <span class=\"hl-o\">:=</span> <span class=\"hl-mi\">1</span>\n</span></span></code></pre>",
+		},
+		"should highlight code blocks in the language they look like": {
+			source: "```\n#!/bin/bash\necho hi\n```",
+			want:   "<pre class=\"hl-chroma\"><code><span class=\"hl-line\"><span class=\"hl-cl\"><span class=\"hl-cp\">#!/bin/bash\n</span></span></span><span class=\"hl-line\"><span class=\"hl-cl\"><span class=\"hl-cp\"></span><span class=\"hl-nb\">echo</span> hi\n</span></span></code></pre>",
+		},
+		"should drop raw HTML": {
+			source: "<script>alert(1)</script>",
+			want:   "\n",
+		},
+		"should drop script links": {
+			source: "[click](javascript:alert(1))",
+			want:   "<p>click</p>\n",
+		},
+		"should render attached images": {
+			source: "![diagram](/media/media-id)",
+			want:   "<p><img src=\"/media/media-id\" alt=\"diagram\"></p>\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Render(tc.source))
+		})
+	}
+}
+
+func TestRenderMarked(t *testing.T) {
+	testCases := map[string]struct {
+		source string
+		want   string
+	}{
+		"should mark the text and keep its Markdown": {
+			source: "the " + Mark("*mitochondria*") + " is the **powerhouse**",
+			want:   "<p>the <mark><em>mitochondria</em></mark> is the <strong>powerhouse</strong></p>\n",
+		},
+		"should sanitize the marked text": {
+			source: Mark("<script>alert(1)</script>[click](javascript:alert(1))"),
+			want:   "<p><mark>alert(1)click</mark></p>\n",
+		},
+		"should not let content open a mark itself": {
+			source: Mark("a" + markOpen + "b" + markClose + "c"),
+			want:   "<p><mark>abc</mark></p>\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, RenderMarked(tc.source))
+		})
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	t.Run("should keep highlighting classes and drop any others", func(t *testing.T) {
+		got := policy.Sanitize(`<span class="button">x</span><span class="hl-k">y</span><p class="hl-k">z</p>`)
+
+		assert.Equal(t, `<span>x</span><span class="hl-k">y</span><p>z</p>`, got)
+	})
+}
+
+func TestCache(t *testing.T) {
+	t.Run("should evict the least recently used render", func(t *testing.T) {
+		c := newCache(2)
+		c.add([32]byte{1}, "one")
+		c.add([32]byte{2}, "two")
+		_, _ = c.get([32]byte{1})
+		c.add([32]byte{3}, "three")
+
+		got, ok := c.get([32]byte{1})
+		assert.True(t, ok)
+		assert.Equal(t, "one", got)
+		_, ok = c.get([32]byte{2})
+		assert.False(t, ok)
+		got, ok = c.get([32]byte{3})
+		assert.True(t, ok)
+		assert.Equal(t, "three", got)
+	})
+}